@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -85,6 +86,41 @@ func (c *Client) GetAPIEvents(ctx context.Context, i *GetAPIEventsFilterInput) (
 	return eventsResponse, err
 }
 
+// AllAPIEvents returns an iterator over all events matching i, fetching them
+// a page at a time by following the next page links (see Paginate). If
+// i.PageNumber is set, iteration starts at that page.
+func (c *Client) AllAPIEvents(ctx context.Context, i *GetAPIEventsFilterInput) iter.Seq2[*Event, error] {
+	requestOptions := CreateRequestOptions()
+	requestOptions.Params = i.formatEventFilters()
+
+	var next string
+	started := false
+	return Paginate(ctx, func(ctx context.Context) ([]*Event, bool, error) {
+		var (
+			resp *http.Response
+			err  error
+		)
+		if !started {
+			started = true
+			resp, err = c.Get(ctx, "/events", requestOptions)
+		} else {
+			// NOTE: The next link includes the filters already.
+			resp, err = c.SimpleGet(ctx, next)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		defer resp.Body.Close()
+
+		var page GetAPIEventsResponse
+		if err := decodeAPIEventsPage(&page, resp.Body); err != nil {
+			return nil, false, err
+		}
+		next = page.Links.Next
+		return page.Events, next != "", nil
+	})
+}
+
 // GetAPIEventInput is used as input to the GetAPIEvent function.
 type GetAPIEventInput struct {
 	// EventID is the ID of the event and is required.
@@ -119,7 +155,28 @@ func (c *Client) GetAPIEvent(ctx context.Context, i *GetAPIEventInput) (*Event,
 func (c *Client) interpretAPIEventsPage(ctx context.Context, answer *GetAPIEventsResponse, pageNum int, received *http.Response) error {
 	// before we pull the status info out of the response body, fetch
 	// pagination info from it:
-	pages, body, err := getEventsPages(received.Body)
+	if err := decodeAPIEventsPage(answer, received.Body); err != nil {
+		return err
+	}
+
+	if pageNum == 0 {
+		if pages := answer.Links; pages.Next != "" {
+			// NOTE: pages.Next URL includes filters already
+			resp, err := c.SimpleGet(ctx, pages.Next)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			return c.interpretAPIEventsPage(ctx, answer, pageNum, resp)
+		}
+	}
+	return nil
+}
+
+// decodeAPIEventsPage appends the events in a single page of results to
+// answer and records the page's pagination links.
+func decodeAPIEventsPage(answer *GetAPIEventsResponse, received io.Reader) error {
+	pages, body, err := getEventsPages(received)
 	if err != nil {
 		return err
 	}
@@ -136,18 +193,6 @@ func (c *Client) interpretAPIEventsPage(ctx context.Context, answer *GetAPIEvent
 		}
 		answer.Events = append(answer.Events, typed)
 	}
-
-	if pageNum == 0 {
-		if pages.Next != "" {
-			// NOTE: pages.Next URL includes filters already
-			resp, err := c.SimpleGet(ctx, pages.Next)
-			if err != nil {
-				return err
-			}
-			defer resp.Body.Close()
-			return c.interpretAPIEventsPage(ctx, answer, pageNum, resp)
-		}
-	}
 	return nil
 }
 
@@ -25,7 +25,7 @@ type CreateInput struct {
 }
 
 // Create creates a new virtual key for AI service authentication.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*VirtualKeyWithToken, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*VirtualKeyWithToken, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
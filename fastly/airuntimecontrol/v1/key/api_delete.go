@@ -15,7 +15,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes an existing virtual key.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.KeyID == nil {
 		return fastly.ErrMissingKeyID
 	}
@@ -16,7 +16,7 @@ type GetInput struct {
 }
 
 // Get retrieves information on a specific virtual key.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*VirtualKeyListItem, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*VirtualKeyListItem, error) {
 	if i.KeyID == nil {
 		return nil, fastly.ErrMissingKeyID
 	}
@@ -30,7 +30,7 @@ type ListInput struct {
 
 // List retrieves all virtual keys for a customer, with optional filtering and
 // pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*VirtualKeys, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*VirtualKeys, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Model != nil && *i.Model != "" {
 		requestOptions.Params["model"] = *i.Model
@@ -19,7 +19,7 @@ type RotateInput struct {
 }
 
 // Rotate rotates an existing virtual key, generating a new access token.
-func Rotate(ctx context.Context, c *fastly.Client, i *RotateInput) (*VirtualKeyWithToken, error) {
+func Rotate(ctx context.Context, c fastly.Requester, i *RotateInput) (*VirtualKeyWithToken, error) {
 	if i.KeyID == nil {
 		return nil, fastly.ErrMissingKeyID
 	}
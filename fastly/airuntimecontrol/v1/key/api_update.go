@@ -25,7 +25,7 @@ type UpdateInput struct {
 }
 
 // Update updates an existing virtual key.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*VirtualKey, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*VirtualKey, error) {
 	if i.KeyID == nil {
 		return nil, fastly.ErrMissingKeyID
 	}
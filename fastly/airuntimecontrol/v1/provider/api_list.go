@@ -10,7 +10,7 @@ import (
 
 // List returns the list of AI providers supported by ARC, with each provider's
 // available models nested within.
-func List(ctx context.Context, c *fastly.Client) (*Providers, error) {
+func List(ctx context.Context, c fastly.Requester) (*Providers, error) {
 	path := fastly.ToSafeURL("ai-runtime-control", "v1", "providers")
 
 	resp, err := c.Get(ctx, path, fastly.CreateRequestOptions())
@@ -16,7 +16,7 @@ type ListModelsInput struct {
 }
 
 // ListModels returns the list of models available for a specific provider.
-func ListModels(ctx context.Context, c *fastly.Client, i *ListModelsInput) (*Models, error) {
+func ListModels(ctx context.Context, c fastly.Requester, i *ListModelsInput) (*Models, error) {
 	if i.ProviderID == nil {
 		return nil, fastly.ErrMissingProviderID
 	}
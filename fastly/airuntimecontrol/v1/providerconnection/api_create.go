@@ -23,7 +23,7 @@ type CreateInput struct {
 
 // Create creates a model provider connection with authentication information
 // and allowed models.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*ProviderConnection, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*ProviderConnection, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
@@ -15,7 +15,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes an existing provider connection.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.ID == nil {
 		return fastly.ErrMissingID
 	}
@@ -16,7 +16,7 @@ type GetInput struct {
 }
 
 // Get retrieves a specific provider connection for a customer.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*ProviderConnection, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*ProviderConnection, error) {
 	if i.ID == nil {
 		return nil, fastly.ErrMissingID
 	}
@@ -22,7 +22,7 @@ type ListInput struct {
 
 // List retrieves all configured provider connections for a customer, with
 // optional pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*ProviderConnections, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*ProviderConnections, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Cursor != nil && *i.Cursor != "" {
 		requestOptions.Params["cursor"] = *i.Cursor
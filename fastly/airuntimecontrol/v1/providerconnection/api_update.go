@@ -22,7 +22,7 @@ type UpdateInput struct {
 }
 
 // Update updates an existing model provider connection.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*ProviderConnection, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*ProviderConnection, error) {
 	if i.ID == nil {
 		return nil, fastly.ErrMissingID
 	}
@@ -33,7 +33,7 @@ type ListInput struct {
 
 // List retrieves session logs including request/response data and metadata,
 // with optional filtering and pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Sessions, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Sessions, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Key != nil && *i.Key != "" {
 		requestOptions.Params["key"] = *i.Key
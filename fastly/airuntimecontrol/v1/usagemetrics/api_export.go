@@ -10,7 +10,7 @@ import (
 
 // Export returns usage metrics as a CSV file download. It accepts the same
 // filter parameters as List and returns the raw CSV bytes.
-func Export(ctx context.Context, c *fastly.Client, i *ListInput) ([]byte, error) {
+func Export(ctx context.Context, c fastly.Requester, i *ListInput) ([]byte, error) {
 	requestOptions := i.requestOptions()
 	requestOptions.Headers["Accept"] = "text/csv"
 
@@ -64,7 +64,7 @@ func (i *ListInput) requestOptions() fastly.RequestOptions {
 
 // List returns usage metrics for AI services, with optional filtering and
 // pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*UsageMetrics, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*UsageMetrics, error) {
 	path := fastly.ToSafeURL("ai-runtime-control", "v1", "usage-metrics")
 
 	resp, err := c.Get(ctx, path, i.requestOptions())
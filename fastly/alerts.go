@@ -3,6 +3,7 @@ package fastly
 import (
 	"context"
 	"encoding/json"
+	"iter"
 	"net/http"
 	"strconv"
 	"time"
@@ -102,6 +103,21 @@ func (c *Client) ListAlertDefinitions(ctx context.Context, i *ListAlertDefinitio
 	return adr, nil
 }
 
+// AllAlertDefinitions returns an iterator over all alert definitions matching
+// i, fetching them a page at a time (see Paginate). i.Cursor, if set, is the
+// starting point; i itself is not modified.
+func (c *Client) AllAlertDefinitions(ctx context.Context, i *ListAlertDefinitionsInput) iter.Seq2[*AlertDefinition, error] {
+	input := *i
+	return PaginateValues(ctx, func(ctx context.Context) ([]AlertDefinition, bool, error) {
+		adr, err := c.ListAlertDefinitions(ctx, &input)
+		if err != nil {
+			return nil, false, err
+		}
+		input.Cursor = &adr.Meta.NextCursor
+		return adr.Data, adr.Meta.NextCursor != "", nil
+	})
+}
+
 // CreateAlertDefinitionInput is used as input to the CreateAlertDefinition function.
 type CreateAlertDefinitionInput struct {
 	// Description is additional text included in an alert notification (limit 4096).
@@ -329,3 +345,18 @@ func (c *Client) ListAlertHistory(ctx context.Context, i *ListAlertHistoryInput)
 
 	return ahr, nil
 }
+
+// AllAlertHistory returns an iterator over all alert history records matching
+// i, fetching them a page at a time (see Paginate). i.Cursor, if set, is the
+// starting point; i itself is not modified.
+func (c *Client) AllAlertHistory(ctx context.Context, i *ListAlertHistoryInput) iter.Seq2[*AlertHistory, error] {
+	input := *i
+	return PaginateValues(ctx, func(ctx context.Context) ([]AlertHistory, bool, error) {
+		ahr, err := c.ListAlertHistory(ctx, &input)
+		if err != nil {
+			return nil, false, err
+		}
+		input.Cursor = &ahr.Meta.NextCursor
+		return ahr.Data, ahr.Meta.NextCursor != "", nil
+	})
+}
@@ -0,0 +1,501 @@
+// Code generated by internal/cmd/apigen; DO NOT EDIT.
+
+package fastly
+
+import (
+	"context"
+	"crypto/ed25519"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// API is the set of operations provided by Client. Code that accepts an API
+// rather than a *Client can be tested with a mock implementation, such as
+// fastlytest.MockAPI, instead of an HTTP server.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to remain source compatible.
+type API interface {
+	ActivateVCL(ctx context.Context, i *ActivateVCLInput) (*VCL, error)
+	ActivateVersion(ctx context.Context, i *ActivateVersionInput) (*Version, error)
+	AllAPIEvents(ctx context.Context, i *GetAPIEventsFilterInput) iter.Seq2[*Event, error]
+	AllAlertDefinitions(ctx context.Context, i *ListAlertDefinitionsInput) iter.Seq2[*AlertDefinition, error]
+	AllAlertHistory(ctx context.Context, i *ListAlertHistoryInput) iter.Seq2[*AlertHistory, error]
+	AllDatacenters(ctx context.Context) ([]Datacenter, error)
+	AllIPs(ctx context.Context) (IPAddrs, IPAddrs, error)
+	AllKVStoreKeys(ctx context.Context, i *ListKVStoreKeysInput) iter.Seq2[string, error]
+	AllKVStores(ctx context.Context, i *ListKVStoresInput) iter.Seq2[*KVStore, error]
+	AllServices(ctx context.Context) iter.Seq2[*Service, error]
+	ApplyServiceConfig(ctx context.Context, i *ApplyServiceConfigInput) (*Version, error)
+	BatchDeleteTokens(ctx context.Context, i *BatchDeleteTokensInput) error
+	BatchModifyACLEntries(ctx context.Context, i *BatchModifyACLEntriesInput) error
+	BatchModifyConfigStoreItems(ctx context.Context, i *BatchModifyConfigStoreItemsInput) error
+	BatchModifyDictionaryItems(ctx context.Context, i *BatchModifyDictionaryItemsInput) error
+	BatchModifyKVStoreKey(ctx context.Context, i *BatchModifyKVStoreKeyInput) error
+	CheckVersionReferences(ctx context.Context, i *CheckVersionReferencesInput) (*ReferenceReport, error)
+	CleanupStaleDrafts(ctx context.Context, i *CleanupStaleDraftsInput) ([]*Version, error)
+	CloneVersion(ctx context.Context, i *CloneVersionInput) (*Version, error)
+	CopyServiceConfig(ctx context.Context, i *CopyServiceConfigInput) (*CopyServiceConfigResult, error)
+	CreateACL(ctx context.Context, i *CreateACLInput) (*ACL, error)
+	CreateACLEntry(ctx context.Context, i *CreateACLEntryInput) (*ACLEntry, error)
+	CreateAlertDefinition(ctx context.Context, i *CreateAlertDefinitionInput) (*AlertDefinition, error)
+	CreateAutomationToken(ctx context.Context, i *CreateAutomationTokenInput) (*AutomationToken, error)
+	CreateBackend(ctx context.Context, i *CreateBackendInput) (*Backend, error)
+	CreateBigQuery(ctx context.Context, i *CreateBigQueryInput) (*BigQuery, error)
+	CreateBlobStorage(ctx context.Context, i *CreateBlobStorageInput) (*BlobStorage, error)
+	CreateBulkCertificate(ctx context.Context, i *CreateBulkCertificateInput) (*BulkCertificate, error)
+	CreateCacheSetting(ctx context.Context, i *CreateCacheSettingInput) (*CacheSetting, error)
+	CreateClientKey(ctx context.Context) (*ClientKey, error)
+	CreateCloudfiles(ctx context.Context, i *CreateCloudfilesInput) (*Cloudfiles, error)
+	CreateCondition(ctx context.Context, i *CreateConditionInput) (*Condition, error)
+	CreateConfigStore(ctx context.Context, i *CreateConfigStoreInput) (*ConfigStore, error)
+	CreateConfigStoreItem(ctx context.Context, i *CreateConfigStoreItemInput) (*ConfigStoreItem, error)
+	CreateCustomTLSCertificate(ctx context.Context, i *CreateCustomTLSCertificateInput) (*CustomTLSCertificate, error)
+	CreateDatadog(ctx context.Context, i *CreateDatadogInput) (*Datadog, error)
+	CreateDictionary(ctx context.Context, i *CreateDictionaryInput) (*Dictionary, error)
+	CreateDictionaryItem(ctx context.Context, i *CreateDictionaryItemInput) (*DictionaryItem, error)
+	CreateDictionaryItems(ctx context.Context, i []CreateDictionaryItemInput) ([]DictionaryItem, error)
+	CreateDigitalOcean(ctx context.Context, i *CreateDigitalOceanInput) (*DigitalOcean, error)
+	CreateDirector(ctx context.Context, i *CreateDirectorInput) (*Director, error)
+	CreateDirectorBackend(ctx context.Context, i *CreateDirectorBackendInput) (*DirectorBackend, error)
+	CreateDomain(ctx context.Context, i *CreateDomainInput) (*Domain, error)
+	CreateERL(ctx context.Context, i *CreateERLInput) (*ERL, error)
+	CreateElasticsearch(ctx context.Context, i *CreateElasticsearchInput) (*Elasticsearch, error)
+	CreateFTP(ctx context.Context, i *CreateFTPInput) (*FTP, error)
+	CreateGCS(ctx context.Context, i *CreateGCSInput) (*GCS, error)
+	CreateGrafanaCloudLogs(ctx context.Context, i *CreateGrafanaCloudLogsInput) (*GrafanaCloudLogs, error)
+	CreateGzip(ctx context.Context, i *CreateGzipInput) (*Gzip, error)
+	CreateHTTPS(ctx context.Context, i *CreateHTTPSInput) (*HTTPS, error)
+	CreateHeader(ctx context.Context, i *CreateHeaderInput) (*Header, error)
+	CreateHealthCheck(ctx context.Context, i *CreateHealthCheckInput) (*HealthCheck, error)
+	CreateHeroku(ctx context.Context, i *CreateHerokuInput) (*Heroku, error)
+	CreateHoneycomb(ctx context.Context, i *CreateHoneycombInput) (*Honeycomb, error)
+	CreateIntegration(ctx context.Context, i *CreateIntegrationInput) (*CreateIntegrationResponse, error)
+	CreateKVStore(ctx context.Context, i *CreateKVStoreInput) (*KVStore, error)
+	CreateKafka(ctx context.Context, i *CreateKafkaInput) (*Kafka, error)
+	CreateKinesis(ctx context.Context, i *CreateKinesisInput) (*Kinesis, error)
+	CreateLogentries(ctx context.Context, i *CreateLogentriesInput) (*Logentries, error)
+	CreateLoggly(ctx context.Context, i *CreateLogglyInput) (*Loggly, error)
+	CreateLogshuttle(ctx context.Context, i *CreateLogshuttleInput) (*Logshuttle, error)
+	CreateMailinglistConfirmation(ctx context.Context, i *CreateMailinglistConfirmationInput) error
+	CreateManagedLogging(ctx context.Context, i *CreateManagedLoggingInput) (*ManagedLogging, error)
+	CreateNewRelic(ctx context.Context, i *CreateNewRelicInput) (*NewRelic, error)
+	CreateNewRelicOTLP(ctx context.Context, i *CreateNewRelicOTLPInput) (*NewRelicOTLP, error)
+	CreateObservabilityCustomDashboard(ctx context.Context, i *CreateObservabilityCustomDashboardInput) (*ObservabilityCustomDashboard, error)
+	CreateOpenstack(ctx context.Context, i *CreateOpenstackInput) (*Openstack, error)
+	CreatePapertrail(ctx context.Context, i *CreatePapertrailInput) (*Papertrail, error)
+	CreatePool(ctx context.Context, i *CreatePoolInput) (*Pool, error)
+	CreatePrivateKey(ctx context.Context, i *CreatePrivateKeyInput) (*PrivateKey, error)
+	CreatePubsub(ctx context.Context, i *CreatePubsubInput) (*Pubsub, error)
+	CreateRequestSetting(ctx context.Context, i *CreateRequestSettingInput) (*RequestSetting, error)
+	CreateResource(ctx context.Context, i *CreateResourceInput) (*Resource, error)
+	CreateResponseObject(ctx context.Context, i *CreateResponseObjectInput) (*ResponseObject, error)
+	CreateS3(ctx context.Context, i *CreateS3Input) (*S3, error)
+	CreateSFTP(ctx context.Context, i *CreateSFTPInput) (*SFTP, error)
+	CreateScalyr(ctx context.Context, i *CreateScalyrInput) (*Scalyr, error)
+	CreateSecret(ctx context.Context, i *CreateSecretInput) (*Secret, error)
+	CreateSecretStore(ctx context.Context, i *CreateSecretStoreInput) (*SecretStore, error)
+	CreateServer(ctx context.Context, i *CreateServerInput) (*Server, error)
+	CreateService(ctx context.Context, i *CreateServiceInput) (*Service, error)
+	CreateServiceAuthorization(ctx context.Context, i *CreateServiceAuthorizationInput) (*ServiceAuthorization, error)
+	CreateSnippet(ctx context.Context, i *CreateSnippetInput) (*Snippet, error)
+	CreateSplunk(ctx context.Context, i *CreateSplunkInput) (*Splunk, error)
+	CreateSumologic(ctx context.Context, i *CreateSumologicInput) (*Sumologic, error)
+	CreateSyslog(ctx context.Context, i *CreateSyslogInput) (*Syslog, error)
+	CreateTLSActivation(ctx context.Context, i *CreateTLSActivationInput) (*TLSActivation, error)
+	CreateTLSMutualAuthentication(ctx context.Context, i *CreateTLSMutualAuthenticationInput) (*TLSMutualAuthentication, error)
+	CreateTLSSubscription(ctx context.Context, i *CreateTLSSubscriptionInput) (*TLSSubscription, error)
+	CreateToken(ctx context.Context, i *CreateTokenInput) (*Token, error)
+	CreateUser(ctx context.Context, i *CreateUserInput) (*User, error)
+	CreateVCL(ctx context.Context, i *CreateVCLInput) (*VCL, error)
+	CreateVersion(ctx context.Context, i *CreateVersionInput) (*Version, error)
+	DeactivateVersion(ctx context.Context, i *DeactivateVersionInput) (*Version, error)
+	Delete(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	DeleteACL(ctx context.Context, i *DeleteACLInput) error
+	DeleteACLEntry(ctx context.Context, i *DeleteACLEntryInput) error
+	DeleteAlertDefinition(ctx context.Context, i *DeleteAlertDefinitionInput) error
+	DeleteAutomationToken(ctx context.Context, i *DeleteAutomationTokenInput) error
+	DeleteBackend(ctx context.Context, i *DeleteBackendInput) error
+	DeleteBigQuery(ctx context.Context, i *DeleteBigQueryInput) error
+	DeleteBlobStorage(ctx context.Context, i *DeleteBlobStorageInput) error
+	DeleteBulkCertificate(ctx context.Context, i *DeleteBulkCertificateInput) error
+	DeleteCacheSetting(ctx context.Context, i *DeleteCacheSettingInput) error
+	DeleteCloudfiles(ctx context.Context, i *DeleteCloudfilesInput) error
+	DeleteCondition(ctx context.Context, i *DeleteConditionInput) error
+	DeleteConfigStore(ctx context.Context, i *DeleteConfigStoreInput) error
+	DeleteConfigStoreItem(ctx context.Context, i *DeleteConfigStoreItemInput) error
+	DeleteCustomTLSCertificate(ctx context.Context, i *DeleteCustomTLSCertificateInput) error
+	DeleteDatadog(ctx context.Context, i *DeleteDatadogInput) error
+	DeleteDictionary(ctx context.Context, i *DeleteDictionaryInput) error
+	DeleteDictionaryItem(ctx context.Context, i *DeleteDictionaryItemInput) error
+	DeleteDigitalOcean(ctx context.Context, i *DeleteDigitalOceanInput) error
+	DeleteDirector(ctx context.Context, i *DeleteDirectorInput) error
+	DeleteDirectorBackend(ctx context.Context, i *DeleteDirectorBackendInput) error
+	DeleteDomain(ctx context.Context, i *DeleteDomainInput) error
+	DeleteERL(ctx context.Context, i *DeleteERLInput) error
+	DeleteElasticsearch(ctx context.Context, i *DeleteElasticsearchInput) error
+	DeleteFTP(ctx context.Context, i *DeleteFTPInput) error
+	DeleteGCS(ctx context.Context, i *DeleteGCSInput) error
+	DeleteGrafanaCloudLogs(ctx context.Context, i *DeleteGrafanaCloudLogsInput) error
+	DeleteGzip(ctx context.Context, i *DeleteGzipInput) error
+	DeleteHTTPS(ctx context.Context, i *DeleteHTTPSInput) error
+	DeleteHeader(ctx context.Context, i *DeleteHeaderInput) error
+	DeleteHealthCheck(ctx context.Context, i *DeleteHealthCheckInput) error
+	DeleteHeroku(ctx context.Context, i *DeleteHerokuInput) error
+	DeleteHoneycomb(ctx context.Context, i *DeleteHoneycombInput) error
+	DeleteIntegration(ctx context.Context, i *DeleteIntegrationInput) error
+	DeleteJSONAPI(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	DeleteJSONAPIBulk(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	DeleteKVStore(ctx context.Context, i *DeleteKVStoreInput) error
+	DeleteKVStoreKey(ctx context.Context, i *DeleteKVStoreKeyInput) error
+	DeleteKafka(ctx context.Context, i *DeleteKafkaInput) error
+	DeleteKinesis(ctx context.Context, i *DeleteKinesisInput) error
+	DeleteLogentries(ctx context.Context, i *DeleteLogentriesInput) error
+	DeleteLoggly(ctx context.Context, i *DeleteLogglyInput) error
+	DeleteLogshuttle(ctx context.Context, i *DeleteLogshuttleInput) error
+	DeleteManagedLogging(ctx context.Context, i *DeleteManagedLoggingInput) error
+	DeleteNewRelic(ctx context.Context, i *DeleteNewRelicInput) error
+	DeleteNewRelicOTLP(ctx context.Context, i *DeleteNewRelicOTLPInput) error
+	DeleteObservabilityCustomDashboard(ctx context.Context, i *DeleteObservabilityCustomDashboardInput) error
+	DeleteOpenstack(ctx context.Context, i *DeleteOpenstackInput) error
+	DeletePapertrail(ctx context.Context, i *DeletePapertrailInput) error
+	DeletePool(ctx context.Context, i *DeletePoolInput) error
+	DeletePrivateKey(ctx context.Context, i *DeletePrivateKeyInput) error
+	DeletePubsub(ctx context.Context, i *DeletePubsubInput) error
+	DeleteRequestSetting(ctx context.Context, i *DeleteRequestSettingInput) error
+	DeleteResource(ctx context.Context, i *DeleteResourceInput) error
+	DeleteResponseObject(ctx context.Context, i *DeleteResponseObjectInput) error
+	DeleteS3(ctx context.Context, i *DeleteS3Input) error
+	DeleteSFTP(ctx context.Context, i *DeleteSFTPInput) error
+	DeleteScalyr(ctx context.Context, i *DeleteScalyrInput) error
+	DeleteSecret(ctx context.Context, i *DeleteSecretInput) error
+	DeleteSecretStore(ctx context.Context, i *DeleteSecretStoreInput) error
+	DeleteServer(ctx context.Context, i *DeleteServerInput) error
+	DeleteService(ctx context.Context, i *DeleteServiceInput) error
+	DeleteServiceAuthorization(ctx context.Context, i *DeleteServiceAuthorizationInput) error
+	DeleteSnippet(ctx context.Context, i *DeleteSnippetInput) error
+	DeleteSplunk(ctx context.Context, i *DeleteSplunkInput) error
+	DeleteSumologic(ctx context.Context, i *DeleteSumologicInput) error
+	DeleteSyslog(ctx context.Context, i *DeleteSyslogInput) error
+	DeleteTLSActivation(ctx context.Context, i *DeleteTLSActivationInput) error
+	DeleteTLSMutualAuthentication(ctx context.Context, i *DeleteTLSMutualAuthenticationInput) error
+	DeleteTLSSubscription(ctx context.Context, i *DeleteTLSSubscriptionInput) error
+	DeleteToken(ctx context.Context, i *DeleteTokenInput) error
+	DeleteTokenSelf(ctx context.Context) error
+	DeleteUser(ctx context.Context, i *DeleteUserInput) error
+	DeleteVCL(ctx context.Context, i *DeleteVCLInput) error
+	DiffVersions(ctx context.Context, i *DiffVersionsInput) (*VersionDiff, error)
+	DisableHTTP3(ctx context.Context, i *DisableHTTP3Input) error
+	EdgeCheck(ctx context.Context, i *EdgeCheckInput) ([]*EdgeCheck, error)
+	EnableHTTP3(ctx context.Context, i *EnableHTTP3Input) (*HTTP3, error)
+	ExportServiceVersion(ctx context.Context, i *ExportServiceVersionInput) (*ServiceVersionSnapshot, error)
+	ForEachService(ctx context.Context, i *ForEachServiceInput) (*ForEachServiceReport, error)
+	Get(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	GetACL(ctx context.Context, i *GetACLInput) (*ACL, error)
+	GetACLEntries(ctx context.Context, i *GetACLEntriesInput) *ListPaginator[ACLEntry]
+	GetACLEntry(ctx context.Context, i *GetACLEntryInput) (*ACLEntry, error)
+	GetAPIEvent(ctx context.Context, i *GetAPIEventInput) (*Event, error)
+	GetAPIEvents(ctx context.Context, i *GetAPIEventsFilterInput) (GetAPIEventsResponse, error)
+	GetAggregateJSON(ctx context.Context, i *GetAggregateInput, dst any) error
+	GetAlertDefinition(ctx context.Context, i *GetAlertDefinitionInput) (*AlertDefinition, error)
+	GetAutomationToken(ctx context.Context, i *GetAutomationTokenInput) (*AutomationToken, error)
+	GetAutomationTokens(ctx context.Context, i *GetAutomationTokensInput) *ListPaginator[AutomationTokenPaginator]
+	GetBackend(ctx context.Context, i *GetBackendInput) (*Backend, error)
+	GetBigQuery(ctx context.Context, i *GetBigQueryInput) (*BigQuery, error)
+	GetBilling(ctx context.Context, i *GetBillingInput) (*Billing, error)
+	GetBlobStorage(ctx context.Context, i *GetBlobStorageInput) (*BlobStorage, error)
+	GetBulkCertificate(ctx context.Context, i *GetBulkCertificateInput) (*BulkCertificate, error)
+	GetCacheSetting(ctx context.Context, i *GetCacheSettingInput) (*CacheSetting, error)
+	GetCloudfiles(ctx context.Context, i *GetCloudfilesInput) (*Cloudfiles, error)
+	GetCondition(ctx context.Context, i *GetConditionInput) (*Condition, error)
+	GetConfigStore(ctx context.Context, i *GetConfigStoreInput) (*ConfigStore, error)
+	GetConfigStoreItem(ctx context.Context, i *GetConfigStoreItemInput) (*ConfigStoreItem, error)
+	GetConfigStoreMetadata(ctx context.Context, i *GetConfigStoreMetadataInput) (*ConfigStoreMetadata, error)
+	GetCurrentUser(ctx context.Context) (*User, error)
+	GetCustomTLSCertificate(ctx context.Context, i *GetCustomTLSCertificateInput) (*CustomTLSCertificate, error)
+	GetCustomTLSConfiguration(ctx context.Context, i *GetCustomTLSConfigurationInput) (*CustomTLSConfiguration, error)
+	GetDatadog(ctx context.Context, i *GetDatadogInput) (*Datadog, error)
+	GetDictionary(ctx context.Context, i *GetDictionaryInput) (*Dictionary, error)
+	GetDictionaryInfo(ctx context.Context, i *GetDictionaryInfoInput) (*DictionaryInfo, error)
+	GetDictionaryItem(ctx context.Context, i *GetDictionaryItemInput) (*DictionaryItem, error)
+	GetDictionaryItems(ctx context.Context, i *GetDictionaryItemsInput) *ListPaginator[DictionaryItem]
+	GetDiff(ctx context.Context, i *GetDiffInput) (*Diff, error)
+	GetDigitalOcean(ctx context.Context, i *GetDigitalOceanInput) (*DigitalOcean, error)
+	GetDirector(ctx context.Context, i *GetDirectorInput) (*Director, error)
+	GetDirectorBackend(ctx context.Context, i *GetDirectorBackendInput) (*DirectorBackend, error)
+	GetDomain(ctx context.Context, i *GetDomainInput) (*Domain, error)
+	GetDomainMetricsForService(ctx context.Context, i *GetDomainMetricsInput) (*DomainInspector, error)
+	GetDomainMetricsForServiceJSON(ctx context.Context, i *GetDomainMetricsInput, dst any) error
+	GetDynamicSnippet(ctx context.Context, i *GetDynamicSnippetInput) (*DynamicSnippet, error)
+	GetERL(ctx context.Context, i *GetERLInput) (*ERL, error)
+	GetElasticsearch(ctx context.Context, i *GetElasticsearchInput) (*Elasticsearch, error)
+	GetFTP(ctx context.Context, i *GetFTPInput) (*FTP, error)
+	GetGCS(ctx context.Context, i *GetGCSInput) (*GCS, error)
+	GetGeneratedVCL(ctx context.Context, i *GetGeneratedVCLInput) (*VCL, error)
+	GetGrafanaCloudLogs(ctx context.Context, i *GetGrafanaCloudLogsInput) (*GrafanaCloudLogs, error)
+	GetGzip(ctx context.Context, i *GetGzipInput) (*Gzip, error)
+	GetHTTP3(ctx context.Context, i *GetHTTP3Input) (*HTTP3, error)
+	GetHTTPS(ctx context.Context, i *GetHTTPSInput) (*HTTPS, error)
+	GetHeader(ctx context.Context, i *GetHeaderInput) (*Header, error)
+	GetHealthCheck(ctx context.Context, i *GetHealthCheckInput) (*HealthCheck, error)
+	GetHeroku(ctx context.Context, i *GetHerokuInput) (*Heroku, error)
+	GetHoneycomb(ctx context.Context, i *GetHoneycombInput) (*Honeycomb, error)
+	GetImageOptimizerDefaultSettings(ctx context.Context, i *GetImageOptimizerDefaultSettingsInput) (*ImageOptimizerDefaultSettings, error)
+	GetIntegration(ctx context.Context, i *GetIntegrationInput) (*Integration, error)
+	GetIntegrationTypes(ctx context.Context) (*[]IntegrationType, error)
+	GetJSON(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	GetKVStore(ctx context.Context, i *GetKVStoreInput) (*KVStore, error)
+	GetKVStoreItem(ctx context.Context, i *GetKVStoreItemInput) (GetKVStoreItemOutput, error)
+	GetKVStoreKey(ctx context.Context, i *GetKVStoreKeyInput) (string, error)
+	GetKafka(ctx context.Context, i *GetKafkaInput) (*Kafka, error)
+	GetKinesis(ctx context.Context, i *GetKinesisInput) (*Kinesis, error)
+	GetLogInsights(ctx context.Context, i *GetLogInsightsInput) (*LogInsightsResponse, error)
+	GetLogRecords(ctx context.Context, i *GetLogRecordsInput) (*LogRecordsResponse, error)
+	GetLogentries(ctx context.Context, i *GetLogentriesInput) (*Logentries, error)
+	GetLoggingEndpointErrors(ctx context.Context, i *LoggingEndpointErrorsInput) (*LoggingEndpointErrorsResponse, error)
+	GetLoggly(ctx context.Context, i *GetLogglyInput) (*Loggly, error)
+	GetLogshuttle(ctx context.Context, i *GetLogshuttleInput) (*Logshuttle, error)
+	GetNewRelic(ctx context.Context, i *GetNewRelicInput) (*NewRelic, error)
+	GetNewRelicOTLP(ctx context.Context, i *GetNewRelicOTLPInput) (*NewRelicOTLP, error)
+	GetObservabilityCustomDashboard(ctx context.Context, i *GetObservabilityCustomDashboardInput) (*ObservabilityCustomDashboard, error)
+	GetOpenstack(ctx context.Context, i *GetOpenstackInput) (*Openstack, error)
+	GetOriginMetricsForService(ctx context.Context, i *GetOriginMetricsInput) (*OriginInspector, error)
+	GetOriginMetricsForServiceJSON(ctx context.Context, i *GetOriginMetricsInput, dst any) error
+	GetPackage(ctx context.Context, i *GetPackageInput) (*Package, error)
+	GetPapertrail(ctx context.Context, i *GetPapertrailInput) (*Papertrail, error)
+	GetPool(ctx context.Context, i *GetPoolInput) (*Pool, error)
+	GetPrivateKey(ctx context.Context, i *GetPrivateKeyInput) (*PrivateKey, error)
+	GetPubsub(ctx context.Context, i *GetPubsubInput) (*Pubsub, error)
+	GetRegions(ctx context.Context) (*RegionsResponse, error)
+	GetRequestSetting(ctx context.Context, i *GetRequestSettingInput) (*RequestSetting, error)
+	GetResource(ctx context.Context, i *GetResourceInput) (*Resource, error)
+	GetResponseObject(ctx context.Context, i *GetResponseObjectInput) (*ResponseObject, error)
+	GetS3(ctx context.Context, i *GetS3Input) (*S3, error)
+	GetSFTP(ctx context.Context, i *GetSFTPInput) (*SFTP, error)
+	GetScalyr(ctx context.Context, i *GetScalyrInput) (*Scalyr, error)
+	GetSecret(ctx context.Context, i *GetSecretInput) (*Secret, error)
+	GetSecretStore(ctx context.Context, i *GetSecretStoreInput) (*SecretStore, error)
+	GetServer(ctx context.Context, i *GetServerInput) (*Server, error)
+	GetService(ctx context.Context, i *GetServiceInput) (*Service, error)
+	GetServiceAuthorization(ctx context.Context, i *GetServiceAuthorizationInput) (*ServiceAuthorization, error)
+	GetServiceConfig(ctx context.Context, i *GetServiceConfigInput) (*ServiceConfig, error)
+	GetServiceDetails(ctx context.Context, i *GetServiceDetailsInput) (*ServiceDetail, error)
+	GetServices(ctx context.Context, i *GetServicesInput) *ListPaginator[Service]
+	GetSettings(ctx context.Context, i *GetSettingsInput) (*Settings, error)
+	GetSigningKey(ctx context.Context) (ed25519.PublicKey, error)
+	GetSnippet(ctx context.Context, i *GetSnippetInput) (*Snippet, error)
+	GetSplunk(ctx context.Context, i *GetSplunkInput) (*Splunk, error)
+	GetStats(ctx context.Context, i *GetStatsInput) (*StatsResponse, error)
+	GetStatsField(ctx context.Context, i *GetStatsInput) (*StatsFieldResponse, error)
+	GetStatsJSON(ctx context.Context, i *GetStatsInput, dst any) error
+	GetSumologic(ctx context.Context, i *GetSumologicInput) (*Sumologic, error)
+	GetSyslog(ctx context.Context, i *GetSyslogInput) (*Syslog, error)
+	GetTLSActivation(ctx context.Context, i *GetTLSActivationInput) (*TLSActivation, error)
+	GetTLSMutualAuthentication(ctx context.Context, i *GetTLSMutualAuthenticationInput) (*TLSMutualAuthentication, error)
+	GetTLSSubscription(ctx context.Context, i *GetTLSSubscriptionInput) (*TLSSubscription, error)
+	GetTokenSelf(ctx context.Context) (*Token, error)
+	GetUsage(ctx context.Context, i *GetUsageInput) (*UsageResponse, error)
+	GetUsageByService(ctx context.Context, i *GetUsageInput) (*UsageByServiceResponse, error)
+	GetUser(ctx context.Context, i *GetUserInput) (*User, error)
+	GetVCL(ctx context.Context, i *GetVCLInput) (*VCL, error)
+	GetVersion(ctx context.Context, i *GetVersionInput) (*Version, error)
+	GetVersionInventory(ctx context.Context, i *GetVersionInventoryInput) (*VersionInventory, error)
+	GetWebhookSigningKey(ctx context.Context, i *GetWebhookSigningKeyInput) (*WebhookSigningKeyResponse, error)
+	Head(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	IPs(ctx context.Context) (IPAddrs, error)
+	IPsV6(ctx context.Context) (IPAddrs, error)
+	InsertKVStoreKey(ctx context.Context, i *InsertKVStoreKeyInput) error
+	LatestVersion(ctx context.Context, i *LatestVersionInput) (*Version, error)
+	ListACLEntries(ctx context.Context, i *ListACLEntriesInput) ([]*ACLEntry, error)
+	ListACLs(ctx context.Context, i *ListACLsInput) ([]*ACL, error)
+	ListAlertDefinitions(ctx context.Context, i *ListAlertDefinitionsInput) (*AlertDefinitionsResponse, error)
+	ListAlertHistory(ctx context.Context, i *ListAlertHistoryInput) (*AlertHistoryResponse, error)
+	ListAutomationTokens(ctx context.Context) ([]*AutomationToken, error)
+	ListBackends(ctx context.Context, i *ListBackendsInput) ([]*Backend, error)
+	ListBigQueries(ctx context.Context, i *ListBigQueriesInput) ([]*BigQuery, error)
+	ListBlobStorages(ctx context.Context, i *ListBlobStoragesInput) ([]*BlobStorage, error)
+	ListBulkCertificates(ctx context.Context, i *ListBulkCertificatesInput) ([]*BulkCertificate, error)
+	ListCacheSettings(ctx context.Context, i *ListCacheSettingsInput) ([]*CacheSetting, error)
+	ListCloudfiles(ctx context.Context, i *ListCloudfilesInput) ([]*Cloudfiles, error)
+	ListConditions(ctx context.Context, i *ListConditionsInput) ([]*Condition, error)
+	ListConfigStoreItems(ctx context.Context, i *ListConfigStoreItemsInput) ([]*ConfigStoreItem, error)
+	ListConfigStoreServices(ctx context.Context, i *ListConfigStoreServicesInput) ([]*Service, error)
+	ListConfigStores(ctx context.Context, i *ListConfigStoresInput) ([]*ConfigStore, error)
+	ListCustomTLSCertificates(ctx context.Context, i *ListCustomTLSCertificatesInput) ([]*CustomTLSCertificate, error)
+	ListCustomTLSConfigurations(ctx context.Context, i *ListCustomTLSConfigurationsInput) ([]*CustomTLSConfiguration, error)
+	ListCustomerTokens(ctx context.Context, i *ListCustomerTokensInput) ([]*Token, error)
+	ListCustomerUsers(ctx context.Context, i *ListCustomerUsersInput) ([]*User, error)
+	ListDatadog(ctx context.Context, i *ListDatadogInput) ([]*Datadog, error)
+	ListDictionaries(ctx context.Context, i *ListDictionariesInput) ([]*Dictionary, error)
+	ListDictionaryItems(ctx context.Context, i *ListDictionaryItemsInput) ([]*DictionaryItem, error)
+	ListDigitalOceans(ctx context.Context, i *ListDigitalOceansInput) ([]*DigitalOcean, error)
+	ListDirectors(ctx context.Context, i *ListDirectorsInput) ([]*Director, error)
+	ListDomains(ctx context.Context, i *ListDomainsInput) ([]*Domain, error)
+	ListERLs(ctx context.Context, i *ListERLsInput) ([]*ERL, error)
+	ListElasticsearch(ctx context.Context, i *ListElasticsearchInput) ([]*Elasticsearch, error)
+	ListFTPs(ctx context.Context, i *ListFTPsInput) ([]*FTP, error)
+	ListGCSs(ctx context.Context, i *ListGCSsInput) ([]*GCS, error)
+	ListGrafanaCloudLogs(ctx context.Context, i *ListGrafanaCloudLogsInput) ([]*GrafanaCloudLogs, error)
+	ListGzips(ctx context.Context, i *ListGzipsInput) ([]*Gzip, error)
+	ListHTTPS(ctx context.Context, i *ListHTTPSInput) ([]*HTTPS, error)
+	ListHeaders(ctx context.Context, i *ListHeadersInput) ([]*Header, error)
+	ListHealthChecks(ctx context.Context, i *ListHealthChecksInput) ([]*HealthCheck, error)
+	ListHerokus(ctx context.Context, i *ListHerokusInput) ([]*Heroku, error)
+	ListHoneycombs(ctx context.Context, i *ListHoneycombsInput) ([]*Honeycomb, error)
+	ListKVStoreKeys(ctx context.Context, i *ListKVStoreKeysInput) (*ListKVStoreKeysResponse, error)
+	ListKVStores(ctx context.Context, i *ListKVStoresInput) (*ListKVStoresResponse, error)
+	ListKafkas(ctx context.Context, i *ListKafkasInput) ([]*Kafka, error)
+	ListKinesis(ctx context.Context, i *ListKinesisInput) ([]*Kinesis, error)
+	ListLogentries(ctx context.Context, i *ListLogentriesInput) ([]*Logentries, error)
+	ListLoggly(ctx context.Context, i *ListLogglyInput) ([]*Loggly, error)
+	ListLogshuttles(ctx context.Context, i *ListLogshuttlesInput) ([]*Logshuttle, error)
+	ListNewRelic(ctx context.Context, i *ListNewRelicInput) ([]*NewRelic, error)
+	ListNewRelicOTLP(ctx context.Context, i *ListNewRelicOTLPInput) ([]*NewRelicOTLP, error)
+	ListObservabilityCustomDashboards(ctx context.Context, i *ListObservabilityCustomDashboardsInput) (*ListDashboardsResponse, error)
+	ListOpenstack(ctx context.Context, i *ListOpenstackInput) ([]*Openstack, error)
+	ListPapertrails(ctx context.Context, i *ListPapertrailsInput) ([]*Papertrail, error)
+	ListPools(ctx context.Context, i *ListPoolsInput) ([]*Pool, error)
+	ListPrivateKeys(ctx context.Context, i *ListPrivateKeysInput) ([]*PrivateKey, error)
+	ListPubsubs(ctx context.Context, i *ListPubsubsInput) ([]*Pubsub, error)
+	ListRequestSettings(ctx context.Context, i *ListRequestSettingsInput) ([]*RequestSetting, error)
+	ListResources(ctx context.Context, i *ListResourcesInput) ([]*Resource, error)
+	ListResponseObjects(ctx context.Context, i *ListResponseObjectsInput) ([]*ResponseObject, error)
+	ListS3s(ctx context.Context, i *ListS3sInput) ([]*S3, error)
+	ListSFTPs(ctx context.Context, i *ListSFTPsInput) ([]*SFTP, error)
+	ListScalyrs(ctx context.Context, i *ListScalyrsInput) ([]*Scalyr, error)
+	ListSecretStores(ctx context.Context, i *ListSecretStoresInput) (*SecretStores, error)
+	ListSecrets(ctx context.Context, i *ListSecretsInput) (*Secrets, error)
+	ListServers(ctx context.Context, i *ListServersInput) ([]*Server, error)
+	ListServiceAuthorizations(ctx context.Context, i *ListServiceAuthorizationsInput) (*ServiceAuthorizations, error)
+	ListServiceDomains(ctx context.Context, i *ListServiceDomainInput) (ServiceDomainsList, error)
+	ListServices(ctx context.Context, i *ListServicesInput) ([]*Service, error)
+	ListSnippets(ctx context.Context, i *ListSnippetsInput) ([]*Snippet, error)
+	ListSplunks(ctx context.Context, i *ListSplunksInput) ([]*Splunk, error)
+	ListSumologics(ctx context.Context, i *ListSumologicsInput) ([]*Sumologic, error)
+	ListSyslogs(ctx context.Context, i *ListSyslogsInput) ([]*Syslog, error)
+	ListTLSActivations(ctx context.Context, i *ListTLSActivationsInput) ([]*TLSActivation, error)
+	ListTLSDomains(ctx context.Context, i *ListTLSDomainsInput) ([]*TLSDomain, error)
+	ListTLSMutualAuthentication(ctx context.Context, i *ListTLSMutualAuthenticationsInput) ([]*TLSMutualAuthentication, error)
+	ListTLSSubscriptions(ctx context.Context, i *ListTLSSubscriptionsInput) ([]*TLSSubscription, error)
+	ListTokens(ctx context.Context, p1_0 *ListTokensInput) ([]*Token, error)
+	ListVCLs(ctx context.Context, i *ListVCLsInput) ([]*VCL, error)
+	ListVersions(ctx context.Context, i *ListVersionsInput) ([]*Version, error)
+	LockVersion(ctx context.Context, i *LockVersionInput) (*Version, error)
+	NewListKVStoreKeysPaginator(ctx context.Context, i *ListKVStoreKeysInput) PaginatorKVStoreEntries
+	NewListKVStoresPaginator(ctx context.Context, i *ListKVStoresInput) *ListKVStoresPaginator
+	Patch(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	PatchForm(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PatchJSON(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PatchJSONAPI(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PlanServiceConfig(ctx context.Context, i *PlanServiceConfigInput) (*ServiceConfigPlan, error)
+	Post(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	PostForm(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PostJSON(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PostJSONAPI(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PostJSONAPIBulk(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	Purge(ctx context.Context, i *PurgeInput) (*Purge, error)
+	PurgeAll(ctx context.Context, i *PurgeAllInput) (*Purge, error)
+	PurgeKey(ctx context.Context, i *PurgeKeyInput) (*Purge, error)
+	PurgeKeys(ctx context.Context, i *PurgeKeysInput) (map[string]string, error)
+	Put(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	PutForm(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PutFormFile(ctx context.Context, urlPath string, filePath string, fieldName string, ro RequestOptions) (*http.Response, error)
+	PutFormFileFromReader(ctx context.Context, urlPath string, fileName string, fileBytes io.Reader, fieldName string, ro RequestOptions) (*http.Response, error)
+	PutJSON(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PutJSONAPI(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	RateLimitRemaining() int
+	RateLimitReset() time.Time
+	RawRequest(ctx context.Context, verb string, p string, ro RequestOptions) (*http.Request, error)
+	Request(ctx context.Context, verb string, p string, ro RequestOptions) (*http.Response, error)
+	RequestForm(ctx context.Context, verb string, p string, i any, ro RequestOptions) (*http.Response, error)
+	RequestFormFile(ctx context.Context, verb string, urlPath string, filePath string, fieldName string, ro RequestOptions) (*http.Response, error)
+	RequestFormFileFromReader(ctx context.Context, verb string, urlPath string, fileName string, fileBytes io.Reader, fieldName string, ro RequestOptions) (*http.Response, error)
+	RequestJSON(ctx context.Context, verb string, p string, i any, ro RequestOptions) (*http.Response, error)
+	RequestJSONAPI(ctx context.Context, verb string, p string, i any, ro RequestOptions) (*http.Response, error)
+	RequestJSONAPIBulk(ctx context.Context, verb string, p string, i any, ro RequestOptions) (*http.Response, error)
+	ResetUserPassword(ctx context.Context, i *ResetUserPasswordInput) error
+	RestoreServiceVersion(ctx context.Context, i *RestoreServiceVersionInput) (*Version, error)
+	ReuseOrCloneVersion(ctx context.Context, i *ReuseOrCloneVersionInput) (*Version, error)
+	RotateWebhookSigningKey(ctx context.Context, i *RotateWebhookSigningKeyInput) (*WebhookSigningKeyResponse, error)
+	SearchIntegrations(ctx context.Context, i *SearchIntegrationsInput) (*SearchIntegrationsResponse, error)
+	SearchService(ctx context.Context, i *SearchServiceInput) (*Service, error)
+	SimpleGet(ctx context.Context, target string) (*http.Response, error)
+	TestAlertDefinition(ctx context.Context, i *TestAlertDefinitionInput) error
+	TokenExpiresWithin(ctx context.Context, d time.Duration) (bool, *Token, error)
+	UpdateACL(ctx context.Context, i *UpdateACLInput) (*ACL, error)
+	UpdateACLEntry(ctx context.Context, i *UpdateACLEntryInput) (*ACLEntry, error)
+	UpdateAlertDefinition(ctx context.Context, i *UpdateAlertDefinitionInput) (*AlertDefinition, error)
+	UpdateBackend(ctx context.Context, i *UpdateBackendInput) (*Backend, error)
+	UpdateBigQuery(ctx context.Context, i *UpdateBigQueryInput) (*BigQuery, error)
+	UpdateBlobStorage(ctx context.Context, i *UpdateBlobStorageInput) (*BlobStorage, error)
+	UpdateBulkCertificate(ctx context.Context, i *UpdateBulkCertificateInput) (*BulkCertificate, error)
+	UpdateCacheSetting(ctx context.Context, i *UpdateCacheSettingInput) (*CacheSetting, error)
+	UpdateCloudfiles(ctx context.Context, i *UpdateCloudfilesInput) (*Cloudfiles, error)
+	UpdateCondition(ctx context.Context, i *UpdateConditionInput) (*Condition, error)
+	UpdateConfigStore(ctx context.Context, i *UpdateConfigStoreInput) (*ConfigStore, error)
+	UpdateConfigStoreItem(ctx context.Context, i *UpdateConfigStoreItemInput) (*ConfigStoreItem, error)
+	UpdateCustomTLSCertificate(ctx context.Context, i *UpdateCustomTLSCertificateInput) (*CustomTLSCertificate, error)
+	UpdateCustomTLSConfiguration(ctx context.Context, i *UpdateCustomTLSConfigurationInput) (*CustomTLSConfiguration, error)
+	UpdateDatadog(ctx context.Context, i *UpdateDatadogInput) (*Datadog, error)
+	UpdateDictionary(ctx context.Context, i *UpdateDictionaryInput) (*Dictionary, error)
+	UpdateDictionaryItem(ctx context.Context, i *UpdateDictionaryItemInput) (*DictionaryItem, error)
+	UpdateDigitalOcean(ctx context.Context, i *UpdateDigitalOceanInput) (*DigitalOcean, error)
+	UpdateDirector(ctx context.Context, i *UpdateDirectorInput) (*Director, error)
+	UpdateDomain(ctx context.Context, i *UpdateDomainInput) (*Domain, error)
+	UpdateDynamicSnippet(ctx context.Context, i *UpdateDynamicSnippetInput) (*DynamicSnippet, error)
+	UpdateERL(ctx context.Context, i *UpdateERLInput) (*ERL, error)
+	UpdateElasticsearch(ctx context.Context, i *UpdateElasticsearchInput) (*Elasticsearch, error)
+	UpdateFTP(ctx context.Context, i *UpdateFTPInput) (*FTP, error)
+	UpdateGCS(ctx context.Context, i *UpdateGCSInput) (*GCS, error)
+	UpdateGrafanaCloudLogs(ctx context.Context, i *UpdateGrafanaCloudLogsInput) (*GrafanaCloudLogs, error)
+	UpdateGzip(ctx context.Context, i *UpdateGzipInput) (*Gzip, error)
+	UpdateHTTPS(ctx context.Context, i *UpdateHTTPSInput) (*HTTPS, error)
+	UpdateHeader(ctx context.Context, i *UpdateHeaderInput) (*Header, error)
+	UpdateHealthCheck(ctx context.Context, i *UpdateHealthCheckInput) (*HealthCheck, error)
+	UpdateHeroku(ctx context.Context, i *UpdateHerokuInput) (*Heroku, error)
+	UpdateHoneycomb(ctx context.Context, i *UpdateHoneycombInput) (*Honeycomb, error)
+	UpdateImageOptimizerDefaultSettings(ctx context.Context, i *UpdateImageOptimizerDefaultSettingsInput) (*ImageOptimizerDefaultSettings, error)
+	UpdateIntegration(ctx context.Context, i *UpdateIntegrationInput) error
+	UpdateKafka(ctx context.Context, i *UpdateKafkaInput) (*Kafka, error)
+	UpdateKinesis(ctx context.Context, i *UpdateKinesisInput) (*Kinesis, error)
+	UpdateLogentries(ctx context.Context, i *UpdateLogentriesInput) (*Logentries, error)
+	UpdateLoggly(ctx context.Context, i *UpdateLogglyInput) (*Loggly, error)
+	UpdateLogshuttle(ctx context.Context, i *UpdateLogshuttleInput) (*Logshuttle, error)
+	UpdateNewRelic(ctx context.Context, i *UpdateNewRelicInput) (*NewRelic, error)
+	UpdateNewRelicOTLP(ctx context.Context, i *UpdateNewRelicOTLPInput) (*NewRelicOTLP, error)
+	UpdateObservabilityCustomDashboard(ctx context.Context, i *UpdateObservabilityCustomDashboardInput) (*ObservabilityCustomDashboard, error)
+	UpdateOpenstack(ctx context.Context, i *UpdateOpenstackInput) (*Openstack, error)
+	UpdatePackage(ctx context.Context, i *UpdatePackageInput) (*Package, error)
+	UpdatePapertrail(ctx context.Context, i *UpdatePapertrailInput) (*Papertrail, error)
+	UpdatePool(ctx context.Context, i *UpdatePoolInput) (*Pool, error)
+	UpdatePubsub(ctx context.Context, i *UpdatePubsubInput) (*Pubsub, error)
+	UpdateRequestSetting(ctx context.Context, i *UpdateRequestSettingInput) (*RequestSetting, error)
+	UpdateResource(ctx context.Context, i *UpdateResourceInput) (*Resource, error)
+	UpdateResponseObject(ctx context.Context, i *UpdateResponseObjectInput) (*ResponseObject, error)
+	UpdateS3(ctx context.Context, i *UpdateS3Input) (*S3, error)
+	UpdateSFTP(ctx context.Context, i *UpdateSFTPInput) (*SFTP, error)
+	UpdateScalyr(ctx context.Context, i *UpdateScalyrInput) (*Scalyr, error)
+	UpdateServer(ctx context.Context, i *UpdateServerInput) (*Server, error)
+	UpdateService(ctx context.Context, i *UpdateServiceInput) (*Service, error)
+	UpdateServiceAuthorization(ctx context.Context, i *UpdateServiceAuthorizationInput) (*ServiceAuthorization, error)
+	UpdateSettings(ctx context.Context, i *UpdateSettingsInput) (*Settings, error)
+	UpdateSnippet(ctx context.Context, i *UpdateSnippetInput) (*Snippet, error)
+	UpdateSplunk(ctx context.Context, i *UpdateSplunkInput) (*Splunk, error)
+	UpdateSumologic(ctx context.Context, i *UpdateSumologicInput) (*Sumologic, error)
+	UpdateSyslog(ctx context.Context, i *UpdateSyslogInput) (*Syslog, error)
+	UpdateTLSActivation(ctx context.Context, i *UpdateTLSActivationInput) (*TLSActivation, error)
+	UpdateTLSMutualAuthentication(ctx context.Context, i *UpdateTLSMutualAuthenticationInput) (*TLSMutualAuthentication, error)
+	UpdateTLSSubscription(ctx context.Context, i *UpdateTLSSubscriptionInput) (*TLSSubscription, error)
+	UpdateUser(ctx context.Context, i *UpdateUserInput) (*User, error)
+	UpdateVCL(ctx context.Context, i *UpdateVCLInput) (*VCL, error)
+	UpdateVersion(ctx context.Context, i *UpdateVersionInput) (*Version, error)
+	Use(middleware ...Middleware)
+	UserAgent() string
+	ValidateAllDomains(ctx context.Context, i *ValidateAllDomainsInput) ([]*DomainValidationResult, error)
+	ValidateDomain(ctx context.Context, i *ValidateDomainInput) (*DomainValidationResult, error)
+	ValidateVersion(ctx context.Context, i *ValidateVersionInput) (bool, string, error)
+}
+
+var _ API = (*Client)(nil)
@@ -21,7 +21,7 @@ type BulkAddTagsInput struct {
 }
 
 // BulkAddTags adds tags to multiple operations in a single request.
-func BulkAddTags(ctx context.Context, c *fastly.Client, i *BulkAddTagsInput) (*BulkOperationResultsResponse, error) {
+func BulkAddTags(ctx context.Context, c fastly.Requester, i *BulkAddTagsInput) (*BulkOperationResultsResponse, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
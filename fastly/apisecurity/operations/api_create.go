@@ -26,7 +26,7 @@ type CreateInput struct {
 }
 
 // Create creates a new operation associated with a service.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Operation, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Operation, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
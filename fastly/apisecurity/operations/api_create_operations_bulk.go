@@ -19,7 +19,7 @@ type BulkCreateOperationsInput struct {
 }
 
 // BulkCreateOperations creates multiple operations in a single request.
-func BulkCreateOperations(ctx context.Context, c *fastly.Client, i *BulkCreateOperationsInput) (*BulkCreateOperationsResponse, error) {
+func BulkCreateOperations(ctx context.Context, c fastly.Requester, i *BulkCreateOperationsInput) (*BulkCreateOperationsResponse, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
@@ -20,7 +20,7 @@ type CreateTagInput struct {
 }
 
 // CreateTag creates a new operation tag associated with a service.
-func CreateTag(ctx context.Context, c *fastly.Client, i *CreateTagInput) (*OperationTag, error) {
+func CreateTag(ctx context.Context, c fastly.Requester, i *CreateTagInput) (*OperationTag, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
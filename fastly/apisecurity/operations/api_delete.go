@@ -17,7 +17,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes an existing operation associated with a service.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.ServiceID == nil {
 		return fastly.ErrMissingServiceID
 	}
@@ -17,7 +17,7 @@ type DeleteTagInput struct {
 }
 
 // DeleteTag deletes an existing operation tag associated with a service.
-func DeleteTag(ctx context.Context, c *fastly.Client, i *DeleteTagInput) error {
+func DeleteTag(ctx context.Context, c fastly.Requester, i *DeleteTagInput) error {
 	if i.ServiceID == nil {
 		return fastly.ErrMissingServiceID
 	}
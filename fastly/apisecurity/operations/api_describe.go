@@ -18,7 +18,7 @@ type DescribeInput struct {
 }
 
 // Describe retrieves a specific operation associated with a service.
-func Describe(ctx context.Context, c *fastly.Client, i *DescribeInput) (*Operation, error) {
+func Describe(ctx context.Context, c fastly.Requester, i *DescribeInput) (*Operation, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
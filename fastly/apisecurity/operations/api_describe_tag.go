@@ -18,7 +18,7 @@ type DescribeTagInput struct {
 }
 
 // DescribeTag retrieves a specific operation tag associated with a service.
-func DescribeTag(ctx context.Context, c *fastly.Client, i *DescribeTagInput) (*OperationTag, error) {
+func DescribeTag(ctx context.Context, c fastly.Requester, i *DescribeTagInput) (*OperationTag, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
@@ -31,7 +31,7 @@ type ListDiscoveredInput struct {
 }
 
 // ListDiscovered lists discovered operations associated with a service.
-func ListDiscovered(ctx context.Context, c *fastly.Client, i *ListDiscoveredInput) (*DiscoveredOperations, error) {
+func ListDiscovered(ctx context.Context, c fastly.Requester, i *ListDiscoveredInput) (*DiscoveredOperations, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
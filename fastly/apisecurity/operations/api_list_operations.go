@@ -31,7 +31,7 @@ type ListOperationsInput struct {
 }
 
 // ListOperations lists all operations associated with a service.
-func ListOperations(ctx context.Context, c *fastly.Client, i *ListOperationsInput) (*Operations, error) {
+func ListOperations(ctx context.Context, c fastly.Requester, i *ListOperationsInput) (*Operations, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
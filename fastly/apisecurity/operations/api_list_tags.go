@@ -20,7 +20,7 @@ type ListTagsInput struct {
 }
 
 // ListTags lists all operation tags associated with a service.
-func ListTags(ctx context.Context, c *fastly.Client, i *ListTagsInput) (*OperationTags, error) {
+func ListTags(ctx context.Context, c fastly.Requester, i *ListTagsInput) (*OperationTags, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
@@ -22,7 +22,7 @@ type UpdateInput struct {
 }
 
 // Update partially updates an existing operation associated with a service.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Operation, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Operation, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
@@ -20,7 +20,7 @@ type UpdateDiscoveredStatusInput struct {
 }
 
 // UpdateDiscoveredStatus updates the status of a single discovered operation.
-func UpdateDiscoveredStatus(ctx context.Context, c *fastly.Client, i *UpdateDiscoveredStatusInput) (*DiscoveredOperation, error) {
+func UpdateDiscoveredStatus(ctx context.Context, c fastly.Requester, i *UpdateDiscoveredStatusInput) (*DiscoveredOperation, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
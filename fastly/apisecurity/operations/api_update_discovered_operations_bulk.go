@@ -21,7 +21,7 @@ type BulkUpdateDiscoveredStatusInput struct {
 }
 
 // BulkUpdateDiscoveredStatus updates the status of multiple discovered operations in a single request.
-func BulkUpdateDiscoveredStatus(ctx context.Context, c *fastly.Client, i *BulkUpdateDiscoveredStatusInput) (*BulkOperationResultsResponse, error) {
+func BulkUpdateDiscoveredStatus(ctx context.Context, c fastly.Requester, i *BulkUpdateDiscoveredStatusInput) (*BulkOperationResultsResponse, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
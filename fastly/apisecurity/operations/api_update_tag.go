@@ -22,7 +22,7 @@ type UpdateTagInput struct {
 }
 
 // UpdateTag partially updates an existing operation tag.
-func UpdateTag(ctx context.Context, c *fastly.Client, i *UpdateTagInput) (*OperationTag, error) {
+func UpdateTag(ctx context.Context, c fastly.Requester, i *UpdateTagInput) (*OperationTag, error) {
 	if i.ServiceID == nil {
 		return nil, fastly.ErrMissingServiceID
 	}
@@ -2,6 +2,7 @@ package operations
 
 import (
 	"context"
+	"iter"
 	"strconv"
 
 	"github.com/fastly/go-fastly/v17/fastly"
@@ -32,13 +33,13 @@ func normalizePageLimit(page, limit *int) (int, int) {
 	return p, l
 }
 
-type pageFetcher[T any] func(ctx context.Context, c *fastly.Client, page, limit int) ([]T, int, error)
+type pageFetcher[T any] func(ctx context.Context, c fastly.Requester, page, limit int) ([]T, int, error)
 
 // Paginator paginates a page+limit API where the response includes meta.total.
 // It does NOT rely on Link headers.
 type Paginator[T any] struct {
 	ctx   context.Context
-	c     *fastly.Client
+	c     fastly.Requester
 	fetch pageFetcher[T]
 
 	nextPage int
@@ -50,7 +51,7 @@ type Paginator[T any] struct {
 	done    bool
 }
 
-func newPaginator[T any](ctx context.Context, c *fastly.Client, startPage, limit int, fetch pageFetcher[T]) *Paginator[T] {
+func newPaginator[T any](ctx context.Context, c fastly.Requester, startPage, limit int, fetch pageFetcher[T]) *Paginator[T] {
 	return &Paginator[T]{
 		ctx:      ctx,
 		c:        c,
@@ -61,7 +62,7 @@ func newPaginator[T any](ctx context.Context, c *fastly.Client, startPage, limit
 }
 
 // SetClient swaps the underlying client. Useful in tests when using fastly.Record.
-func (p *Paginator[T]) SetClient(c *fastly.Client) {
+func (p *Paginator[T]) SetClient(c fastly.Requester) {
 	p.c = c
 }
 
@@ -81,6 +82,22 @@ func (p *Paginator[T]) HasNext() bool {
 
 // GetNext fetches the next page of results.
 func (p *Paginator[T]) GetNext() ([]T, error) {
+	return p.getNext(p.ctx)
+}
+
+// All returns an iterator over the items of all remaining pages, using ctx
+// for each request (see fastly.Paginate).
+func (p *Paginator[T]) All(ctx context.Context) iter.Seq2[*T, error] {
+	return fastly.PaginateValues(ctx, func(ctx context.Context) ([]T, bool, error) {
+		if !p.HasNext() {
+			return nil, false, nil
+		}
+		items, err := p.getNext(ctx)
+		return items, p.HasNext(), err
+	})
+}
+
+func (p *Paginator[T]) getNext(ctx context.Context) ([]T, error) {
 	if !p.HasNext() {
 		return nil, nil
 	}
@@ -90,7 +107,7 @@ func (p *Paginator[T]) GetNext() ([]T, error) {
 	page := p.nextPage
 	limit := p.limit
 
-	items, total, err := p.fetch(p.ctx, p.c, page, limit)
+	items, total, err := p.fetch(ctx, p.c, page, limit)
 	if err != nil {
 		p.done = true
 		return nil, err
@@ -121,7 +138,7 @@ type OperationPaginator = Paginator[Operation]
 
 // NewOperationPaginator returns a paginator that iterates over operations pages.
 // It respects any filters set on the input (TagID/Method/Domain/Path).
-func NewOperationPaginator(ctx context.Context, c *fastly.Client, i *ListOperationsInput) *OperationPaginator {
+func NewOperationPaginator(ctx context.Context, c fastly.Requester, i *ListOperationsInput) *OperationPaginator {
 	page, limit := normalizePageLimit(i.Page, i.Limit)
 
 	// Copy input so callers can reuse their struct without it being mutated.
@@ -129,7 +146,7 @@ func NewOperationPaginator(ctx context.Context, c *fastly.Client, i *ListOperati
 	cp.Page = nil
 	cp.Limit = nil
 
-	fetch := func(ctx context.Context, c *fastly.Client, page, limit int) ([]Operation, int, error) {
+	fetch := func(ctx context.Context, c fastly.Requester, page, limit int) ([]Operation, int, error) {
 		req := cp
 		req.Page = &page
 		req.Limit = &limit
@@ -145,7 +162,7 @@ func NewOperationPaginator(ctx context.Context, c *fastly.Client, i *ListOperati
 }
 
 // ListOperationsAll retrieves all operations across pages.
-func ListOperationsAll(ctx context.Context, c *fastly.Client, i *ListOperationsInput) ([]Operation, error) {
+func ListOperationsAll(ctx context.Context, c fastly.Requester, i *ListOperationsInput) ([]Operation, error) {
 	p := NewOperationPaginator(ctx, c, i)
 	var out []Operation
 	for p.HasNext() {
@@ -163,14 +180,14 @@ type DiscoveredOperationPaginator = Paginator[DiscoveredOperation]
 
 // NewDiscoveredOperationPaginator returns a paginator that iterates over discovered operation pages.
 // It respects any filters set on the input (Status/Method/Domain/Path).
-func NewDiscoveredOperationPaginator(ctx context.Context, c *fastly.Client, i *ListDiscoveredInput) *DiscoveredOperationPaginator {
+func NewDiscoveredOperationPaginator(ctx context.Context, c fastly.Requester, i *ListDiscoveredInput) *DiscoveredOperationPaginator {
 	page, limit := normalizePageLimit(i.Page, i.Limit)
 
 	cp := *i
 	cp.Page = nil
 	cp.Limit = nil
 
-	fetch := func(ctx context.Context, c *fastly.Client, page, limit int) ([]DiscoveredOperation, int, error) {
+	fetch := func(ctx context.Context, c fastly.Requester, page, limit int) ([]DiscoveredOperation, int, error) {
 		req := cp
 		req.Page = &page
 		req.Limit = &limit
@@ -186,7 +203,7 @@ func NewDiscoveredOperationPaginator(ctx context.Context, c *fastly.Client, i *L
 }
 
 // ListDiscoveredAll retrieves all discovered operations across pages.
-func ListDiscoveredAll(ctx context.Context, c *fastly.Client, i *ListDiscoveredInput) ([]DiscoveredOperation, error) {
+func ListDiscoveredAll(ctx context.Context, c fastly.Requester, i *ListDiscoveredInput) ([]DiscoveredOperation, error) {
 	p := NewDiscoveredOperationPaginator(ctx, c, i)
 	var out []DiscoveredOperation
 	for p.HasNext() {
@@ -205,14 +222,14 @@ func ListDiscoveredAll(ctx context.Context, c *fastly.Client, i *ListDiscoveredI
 type TagPaginator = Paginator[OperationTag]
 
 // NewTagPaginator returns a paginator that iterates over tag pages.
-func NewTagPaginator(ctx context.Context, c *fastly.Client, i *ListTagsInput) *TagPaginator {
+func NewTagPaginator(ctx context.Context, c fastly.Requester, i *ListTagsInput) *TagPaginator {
 	page, limit := normalizePageLimit(i.Page, i.Limit)
 
 	cp := *i
 	cp.Page = nil
 	cp.Limit = nil
 
-	fetch := func(ctx context.Context, c *fastly.Client, page, limit int) ([]OperationTag, int, error) {
+	fetch := func(ctx context.Context, c fastly.Requester, page, limit int) ([]OperationTag, int, error) {
 		req := cp
 		req.Page = &page
 		req.Limit = &limit
@@ -228,7 +245,7 @@ func NewTagPaginator(ctx context.Context, c *fastly.Client, i *ListTagsInput) *T
 }
 
 // ListTagsAll retrieves all tags across pages.
-func ListTagsAll(ctx context.Context, c *fastly.Client, i *ListTagsInput) ([]OperationTag, error) {
+func ListTagsAll(ctx context.Context, c fastly.Requester, i *ListTagsInput) ([]OperationTag, error) {
 	p := NewTagPaginator(ctx, c, i)
 	var out []OperationTag
 	for p.HasNext() {
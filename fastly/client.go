@@ -4,19 +4,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
-	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -76,20 +76,65 @@ var resourceLocks = NewResourceLockManager()
 type Client struct {
 	// Address is the address of Fastly's API endpoint.
 	Address string
-	// DebugMode enables HTTP request/response dumps.
+	// DebugMode enables HTTP request/response logging. If Logger is nil,
+	// the logs are written to stderr at debug level.
 	DebugMode bool
+	// DryRun enables dry-run mode: GET and HEAD requests are sent as
+	// usual, while every other request is recorded in the plan and answered
+	// with a synthesized success response. A nil value disables dry-run
+	// mode.
+	DryRun *Plan
+	// HAR records every request attempt and its response in an HTTP
+	// Archive log. A nil value disables recording.
+	HAR *HARRecorder
 	// HTTPClient is the HTTP client to use. If one is not provided, a default
 	// client will be used.
 	HTTPClient *http.Client
+	// Instrumentation is notified of every API operation, e.g. to record
+	// traces or metrics. A nil value disables instrumentation.
+	Instrumentation Instrumentation
+	// Logger receives structured logs of API requests and responses.
+	// Requests and successful responses are logged at debug level. A nil
+	// value disables logging unless DebugMode is enabled.
+	Logger *slog.Logger
+	// Redactor masks sensitive data before it is logged. If nil,
+	// DefaultRedactor is used.
+	Redactor Redactor
+	// RefreshOnUnauthorized makes the client resend a request once if it is
+	// rejected with a 401 and the TokenSource yields a new token after being
+	// refreshed.
+	RefreshOnUnauthorized bool
+	// RetryPolicy controls automatic retries of failed requests. A nil value
+	// disables retries.
+	RetryPolicy *RetryPolicy
+	// TokenSource supplies the API token for each request. If nil, the key
+	// given at construction is used.
+	TokenSource TokenSource
+	// WriteThrottleThreshold enables client-side throttling of non-read
+	// requests. When the last observed Fastly-RateLimit-Remaining value drops
+	// below this threshold, non-read requests wait until the time reported by
+	// Fastly-RateLimit-Reset (or until the request context is done). A zero
+	// value disables throttling.
+	WriteThrottleThreshold int
 
 	// apiKey is the Fastly API key to authenticate requests.
 	apiKey string
+	// resourceLocks serializes mutating requests per resource. If nil, the
+	// package level manager shared by all clients is used.
+	resourceLocks *ResourceLockManager
+	// userAgent is the User-Agent header sent by this client. If empty, the
+	// package level UserAgent is used.
+	userAgent string
 	// remaining is last observed value of http header Fastly-RateLimit-Remaining
-	remaining int
+	remaining atomic.Int64
 	// reset is last observed value of http header Fastly-RateLimit-Reset
-	reset int64
+	reset atomic.Int64
 	// url is the parsed URL from Address
 	url *url.URL
+	// middleware is the chain of middleware registered with Use.
+	middleware []Middleware
+	// middlewareMu guards middleware.
+	middlewareMu sync.RWMutex
 }
 
 // RTSClient is the entrypoint to the Fastly's Realtime Stats API.
@@ -99,7 +144,10 @@ type RTSClient struct {
 
 // DefaultClient instantiates a new Fastly API client. This function requires
 // the environment variable `FASTLY_API_KEY` is set and contains a valid API key
-// to authenticate with Fastly.
+// to authenticate with Fastly. It panics if the client cannot be created.
+//
+// Deprecated: Use New, which reads the same environment variables and
+// returns an error instead of panicking.
 func DefaultClient() *Client {
 	client, err := NewClient(os.Getenv(APIKeyEnvVar))
 	if err != nil {
@@ -126,23 +174,20 @@ func NewClient(key string) (*Client, error) {
 // endpoint. Because Fastly allows some requests without an API key, this
 // function will not error if the API token is not supplied. Attempts to make a
 // request that requires an API key will return a 403 response.
+//
+// The FASTLY_DEBUG_MODE and FASTLY_USER_AGENT environment variables are
+// applied to the returned client only. Use New for finer control.
 func NewClientForEndpoint(key, endpoint string) (*Client, error) {
-	client := &Client{apiKey: key, Address: endpoint}
-
-	if endpoint, ok := os.LookupEnv(DebugEnvVar); ok && endpoint == "true" {
-		client.DebugMode = true
-	}
-
-	if customUserAgent, ok := os.LookupEnv(UserAgentEnvVar); ok {
-		UserAgent = fmt.Sprintf("%s, %s", customUserAgent, UserAgent)
-	}
-
-	return client.init()
+	return New(WithAPIKey(key), WithEndpoint(endpoint))
 }
 
 // NewRealtimeStatsClient instantiates a new Fastly API client for the realtime stats.
 // This function requires the environment variable `FASTLY_API_KEY` is set and contains
-// a valid API key to authenticate with Fastly.
+// a valid API key to authenticate with Fastly. It panics if the client cannot be
+// created.
+//
+// Deprecated: Use NewRealtimeStats, which reads the same environment variables
+// and returns an error instead of panicking.
 func NewRealtimeStatsClient() *RTSClient {
 	endpoint, ok := os.LookupEnv(RealtimeStatsEndpointEnvVar)
 
@@ -161,18 +206,14 @@ func NewRealtimeStatsClient() *RTSClient {
 // `token` is a Fastly API token and `endpoint` is RealtimeStatsEndpoint for the production
 // realtime stats API.
 func NewRealtimeStatsClientForEndpoint(token, endpoint string) (*RTSClient, error) {
-	c, err := NewClientForEndpoint(token, endpoint)
-	if err != nil {
-		return nil, err
-	}
-	return &RTSClient{client: c}, nil
+	return NewRealtimeStats(WithAPIKey(token), WithEndpoint(endpoint))
 }
 
 func (c *Client) init() (*Client, error) {
 	// Until we do a request, we don't know how many are left.
 	// Use the default limit as a first guess:
 	// https://developer.fastly.com/reference/api/#rate-limiting
-	c.remaining = 1000
+	c.remaining.Store(1000)
 
 	u, err := url.Parse(c.Address)
 	if err != nil {
@@ -190,16 +231,32 @@ func (c *Client) init() (*Client, error) {
 	return c, nil
 }
 
+// lockManager returns the ResourceLockManager used by the client.
+func (c *Client) lockManager() *ResourceLockManager {
+	if c.resourceLocks != nil {
+		return c.resourceLocks
+	}
+	return resourceLocks
+}
+
+// UserAgent returns the User-Agent header sent by the client.
+func (c *Client) UserAgent() string {
+	if c.userAgent != "" {
+		return c.userAgent
+	}
+	return UserAgent
+}
+
 // RateLimitRemaining returns the number of non-read requests left before
 // rate limiting causes a 429 Too Many Requests error.
 func (c *Client) RateLimitRemaining() int {
-	return c.remaining
+	return int(c.remaining.Load())
 }
 
 // RateLimitReset returns the next time the rate limiter's counter will be
 // reset.
 func (c *Client) RateLimitReset() time.Time {
-	return time.Unix(c.reset, 0)
+	return time.Unix(c.reset.Load(), 0)
 }
 
 // Get issues an HTTP GET request.
@@ -313,90 +370,125 @@ func (c *Client) DeleteJSONAPIBulk(ctx context.Context, p string, i any, ro Requ
 }
 
 // Request makes an HTTP request against the HTTPClient using the given verb,
-// Path, and request options.
+// Path, and request options. Response metadata is recorded if ctx was created
+// with WithResponseMeta.
 func (c *Client) Request(ctx context.Context, verb, p string, ro RequestOptions) (*http.Response, error) {
+	info := &RequestInfo{
+		Operation:    operationName(),
+		Verb:         verb,
+		Path:         p,
+		PathTemplate: PathTemplate(p),
+		Parallel:     ro.Parallel,
+	}
+
 	if id, ok := impersonation.CustomerIDFromContext(ctx); ok {
 		ro.Params[impersonation.QueryParam] = id
+		info.CustomerID = id
 	}
 
-	req, err := c.RawRequest(ctx, verb, p, ro)
+	return c.dispatch(ctx, info, func(ctx context.Context) (*http.Request, error) {
+		return c.RawRequest(ctx, verb, p, ro)
+	})
+}
+
+// dispatch builds the request described by info with build and runs it
+// through the middleware chain, recording response metadata and
+// instrumenting the operation as configured.
+func (c *Client) dispatch(ctx context.Context, info *RequestInfo, build func(context.Context) (*http.Request, error)) (*http.Response, error) {
+	if id, ok := resourceIDFromContext(ctx); ok {
+		info.ResourceID = id
+	}
+
+	var op OperationInfo
+	if c.Instrumentation != nil {
+		op = info.operationInfo()
+		ctx = c.Instrumentation.StartOperation(ctx, op)
+	}
+
+	req, err := build(ctx)
 	if err != nil {
+		if c.Instrumentation != nil {
+			c.Instrumentation.EndOperation(ctx, op, OperationResult{Err: err})
+		}
 		return nil, err
 	}
+	info.Request = req
+
+	h := c.handler()
+	if meta, ok := responseMetaFromContext(ctx); ok {
+		h = recordResponseMeta(meta, h)
+	}
+	if c.Instrumentation != nil {
+		return c.instrument(ctx, op, info, h)
+	}
+	return h(ctx, info)
+}
+
+// do is the innermost RequestHandler. It applies write throttling and
+// resource locking, then sends the request with retries.
+func (c *Client) do(ctx context.Context, info *RequestInfo) (*http.Response, error) {
+	verb := info.Verb
+
+	if verb != http.MethodGet && verb != http.MethodHead {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+	}
 
-	if !ro.Parallel {
+	if !info.Parallel {
 		resourceID := "unknown"
-		if id, ok := resourceIDFromContext(ctx); ok {
-			resourceID = id
+		if info.ResourceID != "" {
+			resourceID = info.ResourceID
 		}
-		l := resourceLocks.Get(resourceID)
+		l := c.lockManager().Get(resourceID)
 		l.Lock()
 		defer l.Unlock()
 	}
 
-	if c.DebugMode {
-		r := req.Clone(context.TODO())
+	resp, retries, err := c.doWithRetry(info.Request)
+	info.Retries = retries
 
-		// 'r' and 'req' both have a reference to a Body that
-		// is an io.Reader, but only one of them can read its
-		// contents since io.Reader is not seekable and cannot
-		// be rewound
+	if c.RefreshOnUnauthorized {
+		resp, err = c.resendUnauthorized(ctx, info.Request, resp, err)
+	}
 
-		r.Header.Del(APIKeyHeader)
-		dump, _ := httputil.DumpRequestOut(r, true)
+	if resp != nil && verb != http.MethodGet && verb != http.MethodHead {
+		c.updateRateLimit(resp)
+	}
 
-		// httputil.DumpRequest has read the Body from 'r',
-		// and set r.Body to an io.ReadCloser that will return
-		// the same bytes as the original Body, so we can
-		// stuff that into 'req' for when the request is
-		// actually sent; it can't be read in 'r', but the
-		// lifetime of 'r' ends at the end of this block
-		req.Body = r.Body
+	return resp, err
+}
 
-		fmt.Printf("http.Request (dump): %q\n", dump)
+// send performs a single attempt of req, logging the request and response
+// when a Logger is configured or DebugMode is enabled, and recording them
+// when a HARRecorder is set.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	l := c.logger()
+	if l != nil {
+		c.logRequest(req.Context(), l, req)
 	}
 
-	// nosemgrep: trailofbits.go.invalid-usage-of-modified-variable.invalid-usage-of-modified-variable
-	// #nosec G704 -- req is constructed from RawRequest using client's trusted endpoint
-	resp, err := checkResp(c.HTTPClient.Do(req))
-
-	if c.DebugMode && resp != nil {
-		if err != nil {
-			var httpErr *HTTPError
-			if errors.As(err, &httpErr) {
-				fmt.Printf("http.Response (HTTPError): %s\n", httpErr.String())
-			} else {
-				fmt.Printf("http.Response (error): %s\n", err)
-			}
-		} else {
-			dump, dumpErr := httputil.DumpResponse(resp, true)
-			if dumpErr != nil {
-				fmt.Printf("http.Response dump error: %v\n", dumpErr)
-			}
-			fmt.Printf("http.Response (length, dump): %d - %q\n\n", resp.ContentLength, dump)
-		}
+	var trace *harTrace
+	if c.HAR != nil {
+		trace = &harTrace{}
+		req = trace.trace(req)
 	}
 
-	if err != nil {
-		return resp, err
+	start := time.Now()
+
+	// nosemgrep: trailofbits.go.invalid-usage-of-modified-variable.invalid-usage-of-modified-variable
+	// #nosec G704 -- req is constructed from RawRequest using client's trusted endpoint, or by SimpleGet from links in Fastly API responses
+	resp, err := c.HTTPClient.Do(req)
+	if c.HAR != nil {
+		resp = c.recordHAR(req, resp, err, trace, start)
 	}
+	resp, err = checkResp(resp, err)
 
-	if verb != http.MethodGet && verb != http.MethodHead {
-		remaining := resp.Header.Get("Fastly-RateLimit-Remaining")
-		if remaining != "" {
-			if val, err := strconv.Atoi(remaining); err == nil {
-				c.remaining = val
-			}
-		}
-		reset := resp.Header.Get("Fastly-RateLimit-Reset")
-		if reset != "" {
-			if val, err := strconv.ParseInt(reset, 10, 64); err == nil {
-				c.reset = val
-			}
-		}
+	if l != nil {
+		c.logResponse(req.Context(), l, req, resp, err, time.Since(start))
 	}
 
-	return resp, nil
+	return resp, err
 }
 
 // RequestOptions is the list of options to pass to the request.
@@ -446,12 +538,16 @@ func (c *Client) RawRequest(ctx context.Context, verb, p string, ro RequestOptio
 	request.URL.RawQuery = params.Encode()
 
 	// Set the API key.
-	if len(c.apiKey) > 0 {
-		request.Header.Set(APIKeyHeader, c.apiKey)
+	key, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) > 0 {
+		request.Header.Set(APIKeyHeader, key)
 	}
 
 	// Set the User-Agent.
-	request.Header.Set("User-Agent", UserAgent)
+	request.Header.Set("User-Agent", c.UserAgent())
 
 	// Add any custom headers.
 	for k, v := range ro.Headers {
@@ -469,7 +565,8 @@ func (c *Client) RawRequest(ctx context.Context, verb, p string, ro RequestOptio
 // SimpleGet combines the RawRequest and Request methods,
 // but doesn't add any parameters or change any encoding in the URL
 // passed to it. It's mostly for calling the URLs given to us
-// directly from Fastly without mangling them.
+// directly from Fastly without mangling them. The request goes through the
+// same middleware, retries and token refresh as Request.
 func (c *Client) SimpleGet(ctx context.Context, target string) (*http.Response, error) {
 	// We parse the URL and then convert it right back to a string
 	// later; this just acts as a check that Fastly isn't sending
@@ -479,19 +576,33 @@ func (c *Client) SimpleGet(ctx context.Context, target string) (*http.Response,
 		return nil, err
 	}
 
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		return nil, err
+	info := &RequestInfo{
+		Operation:    operationName(),
+		Verb:         http.MethodGet,
+		Path:         u.Path,
+		PathTemplate: PathTemplate(u.Path),
+		Parallel:     true,
 	}
-
-	if len(c.apiKey) > 0 {
-		request.Header.Set(APIKeyHeader, c.apiKey)
+	if id, ok := impersonation.CustomerIDFromContext(ctx); ok {
+		info.CustomerID = id
 	}
-	request.Header.Set("User-Agent", UserAgent)
 
-	// nosemgrep: trailofbits.go.invalid-usage-of-modified-variable.invalid-usage-of-modified-variable
-	// #nosec G704 -- URL is validated and comes from trusted Fastly API responses (pagination links)
-	return checkResp(c.HTTPClient.Do(request))
+	return c.dispatch(ctx, info, func(ctx context.Context) (*http.Request, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := c.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(key) > 0 {
+			request.Header.Set(APIKeyHeader, key)
+		}
+		request.Header.Set("User-Agent", c.UserAgent())
+		return request, nil
+	})
 }
 
 // parseHealthCheckHeaders returns the serialised body with the custom health
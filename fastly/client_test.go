@@ -2,10 +2,12 @@ package fastly
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -87,3 +89,54 @@ func TestClient_Impersonation(t *testing.T) {
 
 	require.Equal(impersonation.QueryParam+"="+testCustomerID, irt.rawQuery, "unexpected query parameter")
 }
+
+func TestClient_SimpleGetParallel(t *testing.T) {
+	t.Parallel()
+
+	const next = "https://api.fastly.com/events?page%5Bnumber%5D=2"
+	writing, release := make(chan struct{}), make(chan struct{})
+	c := newRetryTestClient(t, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"data":[{"type":"event","id":"e2"}],"links":{}}`
+		switch {
+		case req.Method == http.MethodPost:
+			close(writing)
+			<-release
+			body = `{}`
+		case req.URL.String() != next:
+			body = `{"data":[{"type":"event","id":"e1"}],"links":{"next":"` + next + `"}}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	}), nil)
+
+	// The write holds the lock of its resource until it is released.
+	ctx := NewContextForResourceID(context.TODO(), "svc")
+	written := make(chan error)
+	go func() {
+		resp, err := c.Post(ctx, "/events", CreateRequestOptions())
+		if err == nil {
+			resp.Body.Close()
+		}
+		written <- err
+	}()
+	<-writing
+
+	done := make(chan []string)
+	go func() {
+		var ids []string
+		for e, err := range c.AllAPIEvents(ctx, &GetAPIEventsFilterInput{}) {
+			if err != nil {
+				break
+			}
+			ids = append(ids, e.ID)
+		}
+		done <- ids
+	}()
+	select {
+	case ids := <-done:
+		require.Equal(t, []string{"e1", "e2"}, ids)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pagination waited for the resource lock")
+	}
+	close(release)
+	require.NoError(t, <-written)
+}
@@ -16,7 +16,7 @@ type CreateInput struct {
 }
 
 // Create creates a new compute ACL.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*ComputeACL, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*ComputeACL, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
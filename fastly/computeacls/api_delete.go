@@ -15,7 +15,7 @@ type DeleteInput struct {
 }
 
 // DeleteComputeACL deletes the specified compute ACL.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.ComputeACLID == nil {
 		return fastly.ErrMissingComputeACLID
 	}
@@ -16,7 +16,7 @@ type DescribeInput struct {
 }
 
 // Describe describes a specified compute ACL.
-func Describe(ctx context.Context, c *fastly.Client, i *DescribeInput) (*ComputeACL, error) {
+func Describe(ctx context.Context, c fastly.Requester, i *DescribeInput) (*ComputeACL, error) {
 	if i.ComputeACLID == nil {
 		return nil, fastly.ErrMissingComputeACLID
 	}
@@ -9,7 +9,7 @@ import (
 )
 
 // ListACLs retrieves all compute ACLs.
-func ListACLs(ctx context.Context, c *fastly.Client) (*ComputeACLs, error) {
+func ListACLs(ctx context.Context, c fastly.Requester) (*ComputeACLs, error) {
 	resp, err := c.Get(ctx, "/resources/acls", fastly.CreateRequestOptions())
 	if err != nil {
 		return nil, err
@@ -21,7 +21,7 @@ type ListEntriesInput struct {
 }
 
 // ListEntries.
-func ListEntries(ctx context.Context, c *fastly.Client, i *ListEntriesInput) (*ComputeACLEntries, error) {
+func ListEntries(ctx context.Context, c fastly.Requester, i *ListEntriesInput) (*ComputeACLEntries, error) {
 	if i.ComputeACLID == nil {
 		return nil, fastly.ErrMissingComputeACLID
 	}
@@ -19,7 +19,7 @@ type LookupInput struct {
 }
 
 // Lookup finds a matching ACL entry for an IP address.
-func Lookup(ctx context.Context, c *fastly.Client, i *LookupInput) (*ComputeACLEntry, error) {
+func Lookup(ctx context.Context, c fastly.Requester, i *LookupInput) (*ComputeACLEntry, error) {
 	if i.ComputeACLID == nil {
 		return nil, fastly.ErrMissingComputeACLID
 	}
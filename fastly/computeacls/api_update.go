@@ -27,7 +27,7 @@ type BatchComputeACLEntry struct {
 }
 
 // Update updates the specified compute ACl.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) error {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) error {
 	if i.ComputeACLID == nil {
 		return fastly.ErrMissingComputeACLID
 	}
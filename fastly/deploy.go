@@ -0,0 +1,377 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultDeployInterval is the time a [Deployer] waits between rounds of
+// health gates if its Interval is zero.
+const DefaultDeployInterval = 10 * time.Second
+
+// DeployCheck is a named check run by a [Deployer] against a service version.
+// It is used both for pre-activation checks and for post-activation health
+// gates.
+type DeployCheck struct {
+	// Name identifies the check in a [DeployReport].
+	Name string
+	// Run returns an error if the version fails the check.
+	Run func(ctx context.Context, c *Client, serviceID string, serviceVersion int) error
+}
+
+// Deployer runs the clone, change, validate, activate and observe workflow
+// for a service, rolling the service back to the previously active version
+// if the new one fails a health gate.
+//
+// A Deployer may be used for several deployments, including concurrent ones,
+// as long as its fields are not modified while a deployment is running.
+type Deployer struct {
+	// Checks are run in order against the validated draft version. The
+	// version is not activated if any of them fails.
+	Checks []DeployCheck
+	// Client is the client used for all requests.
+	Client *Client
+	// HealthGates are run in order against the activated version. The
+	// service is rolled back if any of them fails.
+	HealthGates []DeployCheck
+	// Interval is the time between rounds of health gates. It defaults to
+	// DefaultDeployInterval.
+	Interval time.Duration
+	// Observe is how long the activated version is watched. The health gates
+	// are run immediately after activation and then every Interval until
+	// Observe has elapsed. If it is zero they are run once.
+	Observe time.Duration
+}
+
+// NewDeployer returns a [Deployer] using c, without any checks or health
+// gates.
+func NewDeployer(c *Client) *Deployer {
+	return &Deployer{Client: c}
+}
+
+// DeployCheckResult is the outcome of a single run of a [DeployCheck].
+type DeployCheckResult struct {
+	// Duration is how long the check took.
+	Duration time.Duration
+	// Err is the error returned by the check, or nil if it passed.
+	Err error
+	// Name is the name of the check.
+	Name string
+	// Round is the round of health gates the result belongs to, starting at
+	// 1. It is zero for pre-activation checks.
+	Round int
+}
+
+// DeployReport describes a deployment run by a [Deployer].
+type DeployReport struct {
+	// Activated reports whether the new version was activated.
+	Activated bool
+	// BaseVersion is the version the new version was cloned from.
+	BaseVersion int
+	// Checks are the results of the pre-activation checks.
+	Checks []*DeployCheckResult
+	// Err is the error that ended the deployment, or nil if it succeeded.
+	Err error
+	// FinishedAt is the time the deployment ended.
+	FinishedAt time.Time
+	// HealthGates are the results of each run of the health gates.
+	HealthGates []*DeployCheckResult
+	// PreviousVersion is the version that was active before the deployment,
+	// or zero if none was.
+	PreviousVersion int
+	// RolledBack reports whether the service was rolled back after the new
+	// version failed a health gate.
+	RolledBack bool
+	// ServiceID is the ID of the service.
+	ServiceID string
+	// StartedAt is the time the deployment started.
+	StartedAt time.Time
+	// Validation is the message returned when validating the new version.
+	Validation string
+	// Version is the new version, or zero if it could not be created.
+	Version int
+}
+
+// Outcome returns a short description of how the deployment ended.
+func (r *DeployReport) Outcome() string {
+	switch {
+	case r.RolledBack && r.PreviousVersion != 0:
+		return fmt.Sprintf("rolled back to version %d", r.PreviousVersion)
+	case r.RolledBack:
+		return "rolled back by deactivating it"
+	case r.Activated && r.Err != nil:
+		return "activated with errors"
+	case r.Activated:
+		return "activated"
+	default:
+		return "not activated"
+	}
+}
+
+// String returns a human-readable summary of the report, listing the result
+// of each check below a line describing the outcome.
+func (r *DeployReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service %s version %d", r.ServiceID, r.Version)
+	if r.BaseVersion != 0 {
+		fmt.Fprintf(&b, " (cloned from %d)", r.BaseVersion)
+	}
+	fmt.Fprintf(&b, ": %s\n", r.Outcome())
+	if r.Validation != "" {
+		fmt.Fprintf(&b, "  validate: %s\n", r.Validation)
+	}
+	for _, res := range r.Checks {
+		fmt.Fprintf(&b, "  check %q: %s\n", res.Name, checkStatus(res.Err))
+	}
+	for _, res := range r.HealthGates {
+		fmt.Fprintf(&b, "  gate %q #%d: %s\n", res.Name, res.Round, checkStatus(res.Err))
+	}
+	if r.Err != nil {
+		fmt.Fprintf(&b, "  error: %v\n", r.Err)
+	}
+	return b.String()
+}
+
+func checkStatus(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return err.Error()
+}
+
+// DeployInput is used as input to the Deploy function.
+type DeployInput struct {
+	// Changes makes the changes to the cloned draft version (required).
+	Changes func(ctx context.Context, c *Client, serviceVersion int) error
+	// Comment is a personal freeform descriptive note set on the new
+	// version.
+	Comment *string
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// ServiceVersion is the version to clone. It defaults to the latest
+	// version.
+	ServiceVersion int
+}
+
+// Deploy clones a version of a service, makes the changes, validates the new
+// version and runs the checks against it, then activates it and runs the
+// health gates. If a health gate fails, the previously active version is
+// reactivated, or the new version is deactivated if no version was active,
+// and the returned error matches ErrRolledBack.
+//
+// The report is returned even if the deployment fails, and its Err field
+// holds the returned error. A draft version that fails validation or a check
+// is left in place. If ctx is cancelled while the new version is being
+// observed, it is left active.
+func (d *Deployer) Deploy(ctx context.Context, i *DeployInput) (*DeployReport, error) {
+	r := &DeployReport{ServiceID: i.ServiceID, StartedAt: time.Now()}
+	r.Err = d.deploy(ctx, i, r)
+	r.FinishedAt = time.Now()
+	return r, r.Err
+}
+
+func (d *Deployer) deploy(ctx context.Context, i *DeployInput, r *DeployReport) error {
+	if i.ServiceID == "" {
+		return ErrMissingServiceID
+	}
+	if i.Changes == nil {
+		return ErrMissingChanges
+	}
+	c := d.Client
+
+	versions, err := c.ListVersions(ctx, &ListVersionsInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.Active != nil && *v.Active && v.Number != nil {
+			r.PreviousVersion = *v.Number
+		}
+	}
+	r.BaseVersion = i.ServiceVersion
+	if r.BaseVersion == 0 {
+		if len(versions) == 0 || versions[len(versions)-1].Number == nil {
+			return fmt.Errorf("service %s has no versions", i.ServiceID)
+		}
+		r.BaseVersion = *versions[len(versions)-1].Number
+	}
+
+	v, err := c.CloneVersion(ctx, &CloneVersionInput{ServiceID: i.ServiceID, ServiceVersion: r.BaseVersion})
+	if err != nil {
+		return err
+	}
+	r.Version = *v.Number
+	if i.Comment != nil {
+		if _, err := c.UpdateVersion(ctx, &UpdateVersionInput{ServiceID: i.ServiceID, ServiceVersion: r.Version, Comment: i.Comment}); err != nil {
+			return err
+		}
+	}
+	if err := i.Changes(ctx, c, r.Version); err != nil {
+		return fmt.Errorf("changing version %d: %w", r.Version, err)
+	}
+
+	ok, msg, err := c.ValidateVersion(ctx, &ValidateVersionInput{ServiceID: i.ServiceID, ServiceVersion: r.Version})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		r.Validation = msg
+		return fmt.Errorf("version %d: %w: %s", r.Version, ErrValidationFailed, msg)
+	}
+	r.Validation = "ok"
+	for _, check := range d.Checks {
+		res := runCheck(ctx, c, check, i.ServiceID, r.Version, 0)
+		r.Checks = append(r.Checks, res)
+		if res.Err != nil {
+			return fmt.Errorf("check %q: %w", res.Name, res.Err)
+		}
+	}
+
+	if _, err := c.ActivateVersion(ctx, &ActivateVersionInput{ServiceID: i.ServiceID, ServiceVersion: r.Version}); err != nil {
+		return err
+	}
+	r.Activated = true
+
+	gateErr := d.observe(ctx, i.ServiceID, r)
+	if gateErr == nil || ctx.Err() != nil {
+		return gateErr
+	}
+	if r.PreviousVersion != 0 {
+		_, err = c.ActivateVersion(ctx, &ActivateVersionInput{ServiceID: i.ServiceID, ServiceVersion: r.PreviousVersion})
+	} else {
+		_, err = c.DeactivateVersion(ctx, &DeactivateVersionInput{ServiceID: i.ServiceID, ServiceVersion: r.Version})
+	}
+	if err != nil {
+		return errors.Join(gateErr, fmt.Errorf("rolling back: %w", err))
+	}
+	r.RolledBack = true
+	return fmt.Errorf("%w: %w", ErrRolledBack, gateErr)
+}
+
+// observe runs the health gates in rounds until one of them fails, ctx is
+// done or d.Observe has elapsed.
+func (d *Deployer) observe(ctx context.Context, serviceID string, r *DeployReport) error {
+	if len(d.HealthGates) == 0 {
+		return nil
+	}
+	interval := d.Interval
+	if interval <= 0 {
+		interval = DefaultDeployInterval
+	}
+	deadline := time.Now().Add(d.Observe)
+	for round := 1; ; round++ {
+		for _, gate := range d.HealthGates {
+			res := runCheck(ctx, d.Client, gate, serviceID, r.Version, round)
+			r.HealthGates = append(r.HealthGates, res)
+			if res.Err != nil {
+				return fmt.Errorf("health gate %q: %w", res.Name, res.Err)
+			}
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		t := time.NewTimer(min(interval, remaining))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func runCheck(ctx context.Context, c *Client, check DeployCheck, serviceID string, serviceVersion, round int) *DeployCheckResult {
+	start := time.Now()
+	err := check.Run(ctx, c, serviceID, serviceVersion)
+	return &DeployCheckResult{Duration: time.Since(start), Err: err, Name: check.Name, Round: round}
+}
+
+// ErrorRatioGate returns a health gate which fails if more than maxRatio of
+// the requests reported by the real-time stats since its previous run were
+// answered with a 5xx status. The first run for a version only considers the
+// latest second of stats. The gate passes if there were no requests.
+func ErrorRatioGate(rts *RTSClient, maxRatio float64) DeployCheck {
+	var (
+		mu         sync.Mutex
+		timestamps = map[string]uint64{}
+	)
+	return DeployCheck{
+		Name: "error_ratio",
+		Run: func(ctx context.Context, _ *Client, serviceID string, serviceVersion int) error {
+			key := fmt.Sprintf("%s/%d", serviceID, serviceVersion)
+			mu.Lock()
+			ts := timestamps[key]
+			mu.Unlock()
+
+			resp, err := rts.GetRealtimeStats(ctx, &GetRealtimeStatsInput{ServiceID: serviceID, Timestamp: ts})
+			if err != nil {
+				return err
+			}
+			if resp.Error != nil && *resp.Error != "" {
+				return errors.New(*resp.Error)
+			}
+			if resp.Timestamp != nil {
+				mu.Lock()
+				timestamps[key] = *resp.Timestamp
+				mu.Unlock()
+			}
+
+			var requests, errs uint64
+			for _, d := range resp.Data {
+				if d == nil || d.Aggregated == nil {
+					continue
+				}
+				if d.Aggregated.Requests != nil {
+					requests += *d.Aggregated.Requests
+				}
+				if d.Aggregated.Status5xx != nil {
+					errs += *d.Aggregated.Status5xx
+				}
+			}
+			if requests == 0 {
+				return nil
+			}
+			if ratio := float64(errs) / float64(requests); ratio > maxRatio {
+				return fmt.Errorf("error ratio %.4f (%d of %d requests) exceeds %.4f", ratio, errs, requests, maxRatio)
+			}
+			return nil
+		},
+	}
+}
+
+// EdgeCheckGate returns a health gate which fails if any Fastly server
+// answers the given URL with a 5xx status, using EdgeCheck.
+func EdgeCheckGate(rawURL string) DeployCheck {
+	return DeployCheck{
+		Name: "edge_check " + rawURL,
+		Run: func(ctx context.Context, c *Client, _ string, _ int) error {
+			checks, err := c.EdgeCheck(ctx, &EdgeCheckInput{URL: rawURL})
+			if err != nil {
+				return err
+			}
+			var failed []string
+			for _, check := range checks {
+				if check.Response == nil || check.Response.Status == nil || *check.Response.Status >= 500 {
+					server := "unknown"
+					if check.Server != nil {
+						server = *check.Server
+					}
+					status := 0
+					if check.Response != nil && check.Response.Status != nil {
+						status = *check.Response.Status
+					}
+					failed = append(failed, fmt.Sprintf("%s (%d)", server, status))
+				}
+			}
+			if len(failed) > 0 {
+				return fmt.Errorf("%d of %d servers failed: %s", len(failed), len(checks), strings.Join(failed, ", "))
+			}
+			return nil
+		},
+	}
+}
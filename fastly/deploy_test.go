@@ -0,0 +1,219 @@
+package fastly_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestDeployer_Deploy(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("deploy")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+
+	addBackend := func(name string) func(context.Context, *fastly.Client, int) error {
+		return func(ctx context.Context, c *fastly.Client, v int) error {
+			_, err := c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: v, Name: fastly.ToPointer(name)})
+			return err
+		}
+	}
+	active := func() int {
+		versions, err := c.ListVersions(ctx, &fastly.ListVersionsInput{ServiceID: id})
+		require.NoError(t, err)
+		for _, v := range versions {
+			if *v.Active {
+				return *v.Number
+			}
+		}
+		return 0
+	}
+
+	d := fastly.NewDeployer(c)
+	_, err = d.Deploy(ctx, &fastly.DeployInput{ServiceID: id})
+	require.ErrorIs(t, err, fastly.ErrMissingChanges)
+
+	// A successful deployment.
+	var checked int
+	d.Checks = []fastly.DeployCheck{{
+		Name: "backends",
+		Run: func(ctx context.Context, c *fastly.Client, serviceID string, v int) error {
+			checked = v
+			backends, err := c.ListBackends(ctx, &fastly.ListBackendsInput{ServiceID: serviceID, ServiceVersion: v})
+			if err == nil && len(backends) == 0 {
+				err = errors.New("no backends")
+			}
+			return err
+		},
+	}}
+	var gateErr error
+	d.HealthGates = []fastly.DeployCheck{{
+		Name: "errors",
+		Run: func(context.Context, *fastly.Client, string, int) error {
+			return gateErr
+		},
+	}}
+	report, err := d.Deploy(ctx, &fastly.DeployInput{ServiceID: id, Changes: addBackend("a"), Comment: fastly.ToPointer("add a")})
+	require.NoError(t, err)
+	require.Equal(t, 2, checked)
+	require.True(t, report.Activated)
+	require.Equal(t, 1, report.PreviousVersion)
+	require.Equal(t, 2, active())
+	require.Equal(t, `Service `+id+` version 2 (cloned from 1): activated
+  validate: ok
+  check "backends": ok
+  gate "errors" #1: ok
+`, report.String())
+	v, err := c.GetVersion(ctx, &fastly.GetVersionInput{ServiceID: id, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.Equal(t, "add a", *v.Comment)
+
+	// A failing health gate rolls back to the previously active version.
+	gateErr = errors.New("too many errors")
+	report, err = d.Deploy(ctx, &fastly.DeployInput{ServiceID: id, Changes: addBackend("b")})
+	require.ErrorIs(t, err, fastly.ErrRolledBack)
+	require.ErrorIs(t, err, gateErr)
+	require.True(t, report.RolledBack)
+	require.Equal(t, 2, active())
+	require.Equal(t, `Service `+id+` version 3 (cloned from 2): rolled back to version 2
+  validate: ok
+  check "backends": ok
+  gate "errors" #1: too many errors
+  error: deployment rolled back: health gate "errors": too many errors
+`, report.String())
+
+	// A failing check leaves the draft inactive.
+	d.Checks = append(d.Checks, fastly.DeployCheck{
+		Name: "reject",
+		Run: func(context.Context, *fastly.Client, string, int) error {
+			return errors.New("rejected")
+		},
+	})
+	report, err = d.Deploy(ctx, &fastly.DeployInput{ServiceID: id, ServiceVersion: 2, Changes: addBackend("c")})
+	require.ErrorContains(t, err, `check "reject": rejected`)
+	require.Equal(t, 4, report.Version)
+	require.Equal(t, 2, report.BaseVersion)
+	require.False(t, report.Activated)
+	require.Equal(t, 2, active())
+
+	// A version failing validation is not activated.
+	err = c.DeleteDomain(ctx, &fastly.DeleteDomainInput{ServiceID: id, ServiceVersion: 4, Name: "example.com"})
+	require.NoError(t, err)
+	report, err = d.Deploy(ctx, &fastly.DeployInput{ServiceID: id, Changes: addBackend("d")})
+	require.ErrorIs(t, err, fastly.ErrValidationFailed)
+	require.Equal(t, "Version has no domains", report.Validation)
+	require.Empty(t, report.Checks)
+	require.Equal(t, "not activated", report.Outcome())
+}
+
+func TestDeployer_Observe(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("observe")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+
+	var rounds int
+	d := &fastly.Deployer{
+		Client: c,
+		HealthGates: []fastly.DeployCheck{{
+			Name: "third",
+			Run: func(context.Context, *fastly.Client, string, int) error {
+				rounds++
+				if rounds == 3 {
+					return errors.New("failed")
+				}
+				return nil
+			},
+		}},
+		Interval: time.Millisecond,
+		Observe:  time.Minute,
+	}
+	noop := func(context.Context, *fastly.Client, int) error { return nil }
+
+	// Without a previously active version, the new one is deactivated.
+	report, err := d.Deploy(ctx, &fastly.DeployInput{ServiceID: id, Changes: noop})
+	require.ErrorIs(t, err, fastly.ErrRolledBack)
+	require.Len(t, report.HealthGates, 3)
+	require.Equal(t, 3, report.HealthGates[2].Round)
+	require.Equal(t, "rolled back by deactivating it", report.Outcome())
+	v, err := c.GetVersion(ctx, &fastly.GetVersionInput{ServiceID: id, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.False(t, *v.Active)
+
+	// The gates stop once the observation period has elapsed.
+	rounds = 10
+	d.Observe = 5 * time.Millisecond
+	report, err = d.Deploy(ctx, &fastly.DeployInput{ServiceID: id, Changes: noop})
+	require.NoError(t, err)
+	require.Greater(t, len(report.HealthGates), 1)
+	require.Equal(t, "activated", report.Outcome())
+}
+
+func TestErrorRatioGate(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	rts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if len(paths) == 1 {
+			_, _ = w.Write([]byte(`{"Timestamp":100,"Data":[{"aggregated":{"requests":100,"status_5xx":1}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Timestamp":101,"Data":[{"aggregated":{"requests":50,"status_5xx":5}},{"aggregated":{"requests":50,"status_5xx":5}}]}`))
+	}))
+	t.Cleanup(rts.Close)
+	client, err := fastly.NewRealtimeStatsClientForEndpoint("token", rts.URL)
+	require.NoError(t, err)
+
+	gate := fastly.ErrorRatioGate(client, 0.05)
+	require.NoError(t, gate.Run(context.TODO(), nil, "svc", 2))
+	err = gate.Run(context.TODO(), nil, "svc", 2)
+	require.EqualError(t, err, "error ratio 0.1000 (10 of 100 requests) exceeds 0.0500")
+	require.Equal(t, []string{"/v1/channel/svc/ts/0", "/v1/channel/svc/ts/100"}, paths)
+}
+
+func TestEdgeCheckGate(t *testing.T) {
+	t.Parallel()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"server":"cache-a","response":{"status":200}},{"server":"cache-b","response":{"status":503}}]`))
+	}))
+	t.Cleanup(api.Close)
+	c, err := fastly.NewClientForEndpoint("token", api.URL)
+	require.NoError(t, err)
+
+	gate := fastly.EdgeCheckGate("https://example.com/")
+	require.Equal(t, "edge_check https://example.com/", gate.Name)
+	err = gate.Run(context.TODO(), c, "svc", 2)
+	require.EqualError(t, err, "1 of 2 servers failed: cache-b (503)")
+}
@@ -0,0 +1,335 @@
+package fastly
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// DiffHunk is a run of consecutive changed lines in the text format of a
+// [Diff]. The text format is a YAML rendering of both versions with each line
+// prefixed by " ", "-" or "+"; a hunk never spans more than one section,
+// object or VCL subroutine.
+type DiffHunk struct {
+	// Added are the added lines, without their prefix.
+	Added []string
+	// NewLines is the number of lines of the hunk in the new version.
+	NewLines int
+	// NewStart is the 1-based line number of the hunk in the new version. If
+	// NewLines is zero, it is the line after which lines were removed.
+	NewStart int
+	// Object is the name of the object the lines belong to, e.g. a backend
+	// name, or an empty string for lines outside any named object.
+	Object string
+	// OldLines is the number of lines of the hunk in the old version.
+	OldLines int
+	// OldStart is the 1-based line number of the hunk in the old version. If
+	// OldLines is zero, it is the line after which lines were added.
+	OldStart int
+	// Removed are the removed lines, without their prefix.
+	Removed []string
+	// Section is the top-level key of the version the lines belong to, e.g.
+	// "backends", "settings" or "vcls".
+	Section string
+	// Subroutine is the VCL subroutine the lines belong to, e.g. "vcl_recv",
+	// for lines of custom VCLs and snippets. It is empty otherwise.
+	Subroutine string
+}
+
+// String returns the location of the hunk, e.g. `vcls "main" vcl_recv`.
+func (h *DiffHunk) String() string {
+	s := h.Section
+	if h.Object != "" {
+		s += fmt.Sprintf(" %q", h.Object)
+	}
+	if h.Subroutine != "" {
+		s += " " + h.Subroutine
+	}
+	return s
+}
+
+// ParsedDiff is a [Diff] in the text format parsed into hunks.
+type ParsedDiff struct {
+	// From is the version the diff is from.
+	From int
+	// Hunks are the changes, in the order they appear in the diff.
+	Hunks []*DiffHunk
+	// To is the version the diff is to.
+	To int
+}
+
+// Empty reports whether the diff has no changes.
+func (p *ParsedDiff) Empty() bool {
+	return len(p.Hunks) == 0
+}
+
+// SubroutineChange summarizes the changes to one VCL subroutine.
+type SubroutineChange struct {
+	// Added is the number of added lines.
+	Added int
+	// Removed is the number of removed lines.
+	Removed int
+	// Sources are the custom VCLs and snippets the lines belong to, e.g.
+	// `vcls "main"`, in sorted order.
+	Sources []string
+	// Subroutine is the name of the subroutine, e.g. "vcl_recv".
+	Subroutine string
+}
+
+// Subroutines summarizes the changes to custom VCLs and snippets by VCL
+// subroutine, ordered by subroutine name.
+func (p *ParsedDiff) Subroutines() []*SubroutineChange {
+	bySub := map[string]*SubroutineChange{}
+	for _, h := range p.Hunks {
+		if h.Subroutine == "" {
+			continue
+		}
+		sc, ok := bySub[h.Subroutine]
+		if !ok {
+			sc = &SubroutineChange{Subroutine: h.Subroutine}
+			bySub[h.Subroutine] = sc
+		}
+		sc.Added += len(h.Added)
+		sc.Removed += len(h.Removed)
+		source := h.Section
+		if h.Object != "" {
+			source += fmt.Sprintf(" %q", h.Object)
+		}
+		if !slices.Contains(sc.Sources, source) {
+			sc.Sources = append(sc.Sources, source)
+		}
+	}
+	out := make([]*SubroutineChange, 0, len(bySub))
+	for _, sc := range bySub {
+		slices.Sort(sc.Sources)
+		out = append(out, sc)
+	}
+	slices.SortFunc(out, func(a, b *SubroutineChange) int {
+		return cmp.Compare(a.Subroutine, b.Subroutine)
+	})
+	return out
+}
+
+// Summary returns a concise human-readable summary of the diff, with the
+// number of added and removed lines per section and object, followed by the
+// changes per VCL subroutine.
+func (p *ParsedDiff) Summary() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Version %d -> %d: ", p.From, p.To)
+	if p.Empty() {
+		b.WriteString("no changes\n")
+		return b.String()
+	}
+	var added, removed int
+	type location struct{ section, object string }
+	var order []location
+	counts := map[location][2]int{}
+	for _, h := range p.Hunks {
+		added += len(h.Added)
+		removed += len(h.Removed)
+		loc := location{h.Section, h.Object}
+		if _, ok := counts[loc]; !ok {
+			order = append(order, loc)
+		}
+		c := counts[loc]
+		counts[loc] = [2]int{c[0] + len(h.Added), c[1] + len(h.Removed)}
+	}
+	fmt.Fprintf(&b, "%d hunk(s), +%d -%d\n", len(p.Hunks), added, removed)
+	for _, loc := range order {
+		name := loc.section
+		if loc.object != "" {
+			name += fmt.Sprintf(" %q", loc.object)
+		}
+		fmt.Fprintf(&b, "  %s: +%d -%d\n", name, counts[loc][0], counts[loc][1])
+	}
+	if subs := p.Subroutines(); len(subs) > 0 {
+		b.WriteString("Subroutines:\n")
+		for _, sc := range subs {
+			fmt.Fprintf(&b, "  %s: +%d -%d (%s)\n", sc.Subroutine, sc.Added, sc.Removed, strings.Join(sc.Sources, ", "))
+		}
+	}
+	return b.String()
+}
+
+// Parse parses the diff into hunks. Only the "text" format is supported.
+func (d *Diff) Parse() (*ParsedDiff, error) {
+	if d.Format != "" && d.Format != "text" {
+		return nil, fmt.Errorf("unsupported diff format %q", d.Format)
+	}
+	hunks, err := ParseDiffText(d.Diff)
+	if err != nil {
+		return nil, err
+	}
+	return &ParsedDiff{From: d.From, Hunks: hunks, To: d.To}, nil
+}
+
+var (
+	diffSectionRE    = regexp.MustCompile(`^([^\s#:-][^:]*):(?:\s|$)`)
+	diffNameRE       = regexp.MustCompile(`^(?:- |  )name: (.*)$`)
+	diffTypeRE       = regexp.MustCompile(`^(?:- |  )type: (.*)$`)
+	diffContentRE    = regexp.MustCompile(`^(?:- |  )content: [|>]`)
+	diffSubroutineRE = regexp.MustCompile(`^\s*sub\s+([A-Za-z0-9_.]+)`)
+)
+
+// diffItem is an element of a list section, such as a backend.
+type diffItem struct {
+	hunks       []*DiffHunk
+	name        string
+	snippetType string
+}
+
+// diffSide is the VCL parsing state of one version.
+type diffSide struct {
+	line       int
+	subroutine string
+}
+
+// ParseDiffText parses a diff in the text format returned by GetDiff into
+// hunks.
+func ParseDiffText(text string) ([]*DiffHunk, error) {
+	var (
+		hunks     []*DiffHunk
+		hunk      *DiffHunk
+		section   string
+		item      *diffItem
+		items     []*diffItem
+		inContent bool
+		before    diffSide
+		after     diffSide
+	)
+	for n, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		if line == "" {
+			// Blank context lines may have lost their prefix.
+			line = " "
+		}
+		if line[0] == '\\' {
+			continue
+		}
+		op, body := line[0], line[1:]
+		if op != ' ' && op != '-' && op != '+' {
+			return nil, fmt.Errorf("line %d: unexpected diff line prefix %q", n+1, op)
+		}
+
+		// Track the location of the line.
+		switch {
+		case diffSectionRE.MatchString(body):
+			section = diffSectionRE.FindStringSubmatch(body)[1]
+			item, inContent = nil, false
+			before.subroutine, after.subroutine = "", ""
+		case strings.HasPrefix(body, "- "):
+			item, inContent = &diffItem{}, false
+			items = append(items, item)
+			before.subroutine, after.subroutine = "", ""
+		case inContent && (strings.HasPrefix(body, "    ") || strings.TrimSpace(body) == ""):
+		default:
+			inContent = false
+		}
+		startContent := false
+		if item != nil {
+			if m := diffNameRE.FindStringSubmatch(body); m != nil && item.name == "" {
+				item.name = diffUnquote(m[1])
+			}
+			if m := diffTypeRE.FindStringSubmatch(body); m != nil && section == "snippets" {
+				item.snippetType = diffUnquote(m[1])
+			}
+			startContent = diffContentRE.MatchString(body)
+		}
+		var subroutine string
+		if inContent {
+			vcl := strings.TrimPrefix(body, "    ")
+			sides := []*diffSide{&before, &after}
+			switch op {
+			case '-':
+				sides = sides[:1]
+			case '+':
+				sides = sides[1:]
+			}
+			for _, side := range sides {
+				if m := diffSubroutineRE.FindStringSubmatch(vcl); m != nil {
+					side.subroutine = m[1]
+				}
+				subroutine = side.subroutine
+				if strings.TrimRight(vcl, " \t") == "}" {
+					side.subroutine = ""
+				}
+			}
+		}
+		if startContent {
+			inContent = true
+		}
+
+		if op == ' ' {
+			hunk = nil
+			before.line++
+			after.line++
+			continue
+		}
+		object := ""
+		if item != nil {
+			object = item.name
+		}
+		if hunk == nil || hunk.Section != section || hunk.Object != object || hunk.Subroutine != subroutine || (item != nil && !slices.Contains(item.hunks, hunk)) {
+			hunk = &DiffHunk{
+				NewStart:   after.line + 1,
+				Object:     object,
+				OldStart:   before.line + 1,
+				Section:    section,
+				Subroutine: subroutine,
+			}
+			hunks = append(hunks, hunk)
+			if item != nil {
+				item.hunks = append(item.hunks, hunk)
+			}
+		}
+		if op == '-' {
+			hunk.Removed = append(hunk.Removed, body)
+			hunk.OldLines++
+			before.line++
+		} else {
+			hunk.Added = append(hunk.Added, body)
+			hunk.NewLines++
+			after.line++
+		}
+	}
+
+	// Names and snippet types may follow the changed lines of an object.
+	for _, item := range items {
+		for _, h := range item.hunks {
+			if h.Object == "" {
+				h.Object = item.name
+			}
+			if h.Subroutine == "" && h.Section == "snippets" {
+				h.Subroutine = snippetSubroutine(item.snippetType)
+			}
+		}
+	}
+	for _, h := range hunks {
+		if h.OldLines == 0 {
+			h.OldStart--
+		}
+		if h.NewLines == 0 {
+			h.NewStart--
+		}
+	}
+	return hunks, nil
+}
+
+// snippetSubroutine returns the VCL subroutine a snippet of type typ is
+// inserted into, or an empty string for snippets outside any subroutine.
+func snippetSubroutine(typ string) string {
+	switch SnippetType(typ) {
+	case "", SnippetTypeInit, SnippetTypeNone:
+		return ""
+	}
+	return "vcl_" + typ
+}
+
+func diffUnquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '\'' && s[len(s)-1] == '\'' || s[0] == '"' && s[len(s)-1] == '"') {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,100 @@
+package fastly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTextDiff = ` acls: []
+-backends: []
++backends:
++- name: test-backend
++  address: integ-test.go-fastly.com
++  port: 80
+ cache_settings: []
+ settings:
+-  general.default_ttl: 3600
++  general.default_ttl: 60
+   general.stale_if_error: false
+ snippets:
+ - name: headers
+   content: |
+-    set resp.http.X = "1";
++    set resp.http.X = "2";
+   dynamic: 0
+   type: deliver
+ vcls:
+ - name: main
+   content: |
+     sub vcl_recv {
+       #FASTLY recv
+-      return(pass);
++      return(lookup);
+     }
+
+     sub vcl_deliver {
++      set resp.http.Y = "1";
+       #FASTLY deliver
+     }
+   main: true
+`
+
+func TestDiff_Parse(t *testing.T) {
+	t.Parallel()
+
+	d := &Diff{Diff: testTextDiff, Format: "text", From: 1, To: 2}
+	p, err := d.Parse()
+	require.NoError(t, err)
+	require.False(t, p.Empty())
+
+	var got []string
+	for _, h := range p.Hunks {
+		got = append(got, h.String())
+	}
+	require.Equal(t, []string{
+		"backends",
+		`backends "test-backend"`,
+		"settings",
+		`snippets "headers" vcl_deliver`,
+		`vcls "main" vcl_recv`,
+		`vcls "main" vcl_deliver`,
+	}, got)
+
+	require.Equal(t, &DiffHunk{
+		Added:    []string{"backends:"},
+		NewLines: 1,
+		NewStart: 2,
+		OldLines: 1,
+		OldStart: 2,
+		Removed:  []string{"backends: []"},
+		Section:  "backends",
+	}, p.Hunks[0])
+	require.Equal(t, []string{"- name: test-backend", "  address: integ-test.go-fastly.com", "  port: 80"}, p.Hunks[1].Added)
+	require.Equal(t, 3, p.Hunks[1].NewStart)
+	require.Equal(t, 2, p.Hunks[1].OldStart)
+	require.Zero(t, p.Hunks[1].OldLines)
+	require.Equal(t, []string{"      set resp.http.Y = \"1\";"}, p.Hunks[5].Added)
+	require.Equal(t, 21, p.Hunks[5].OldStart)
+
+	require.Equal(t, `Version 1 -> 2: 6 hunk(s), +8 -4
+  backends: +1 -1
+  backends "test-backend": +3 -0
+  settings: +1 -1
+  snippets "headers": +1 -1
+  vcls "main": +2 -1
+Subroutines:
+  vcl_deliver: +2 -1 (snippets "headers", vcls "main")
+  vcl_recv: +1 -1 (vcls "main")
+`, p.Summary())
+
+	p, err = (&Diff{Diff: " acls: []\n backends: []\n", From: 1, To: 1}).Parse()
+	require.NoError(t, err)
+	require.True(t, p.Empty())
+	require.Equal(t, "Version 1 -> 1: no changes\n", p.Summary())
+
+	_, err = (&Diff{Diff: "<pre></pre>", Format: "html"}).Parse()
+	require.EqualError(t, err, `unsupported diff format "html"`)
+	_, err = ParseDiffText(" a: 1\n*b: 2\n")
+	require.EqualError(t, err, `line 2: unexpected diff line prefix '*'`)
+}
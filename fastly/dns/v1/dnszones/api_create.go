@@ -21,7 +21,7 @@ type CreateInput struct {
 }
 
 // Create creates a new DNS Zone.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Zone, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Zone, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
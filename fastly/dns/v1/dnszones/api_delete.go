@@ -14,7 +14,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes a specified DNS Zone.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.ZoneID == nil {
 		return fastly.ErrMissingID
 	}
@@ -15,7 +15,7 @@ type GetInput struct {
 }
 
 // Get retrieves a specified DNS Zone.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Zone, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Zone, error) {
 	if i.ZoneID == nil {
 		return nil, fastly.ErrMissingID
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"strconv"
 
 	"github.com/fastly/go-fastly/v17/fastly"
@@ -20,7 +21,7 @@ type ListInput struct {
 }
 
 // List retrieves all DNS zones, automatically paginating through all pages.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) ([]Zone, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) ([]Zone, error) {
 	var (
 		out    []Zone
 		cursor *string
@@ -39,8 +40,22 @@ func List(ctx context.Context, c *fastly.Client, i *ListInput) ([]Zone, error) {
 	return out, nil
 }
 
+// All returns an iterator over all DNS zones, fetching them a page at a time
+// (see fastly.Paginate).
+func All(ctx context.Context, c fastly.Requester, i *ListInput) iter.Seq2[*Zone, error] {
+	var cursor *string
+	return fastly.PaginateValues(ctx, func(ctx context.Context) ([]Zone, bool, error) {
+		page, err := listPage(ctx, c, i, cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		cursor = page.Meta.NextCursor
+		return page.Data, cursor != nil && *cursor != "", nil
+	})
+}
+
 // listPage retrieves a single page of DNS zones.
-func listPage(ctx context.Context, c *fastly.Client, i *ListInput, cursor *string) (*Zones, error) {
+func listPage(ctx context.Context, c fastly.Requester, i *ListInput, cursor *string) (*Zones, error) {
 	path := fastly.ToSafeURL("dns", "v1", "zones")
 
 	requestOptions := fastly.CreateRequestOptions()
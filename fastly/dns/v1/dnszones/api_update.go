@@ -19,7 +19,7 @@ type UpdateInput struct {
 }
 
 // Update updates an existing DNS Zone.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Zone, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Zone, error) {
 	if i.ZoneID == nil {
 		return nil, fastly.ErrMissingID
 	}
@@ -21,7 +21,7 @@ type CreateInput struct {
 }
 
 // Create creates a new TSIG key.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*TSIGKey, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*TSIGKey, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
@@ -14,7 +14,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes a specified TSIG key.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.TSIGKeyID == nil {
 		return fastly.ErrMissingID
 	}
@@ -15,7 +15,7 @@ type GetInput struct {
 }
 
 // Get retrieves a specified TSIG key.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*TSIGKey, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*TSIGKey, error) {
 	if i.TSIGKeyID == nil {
 		return nil, fastly.ErrMissingID
 	}
@@ -20,7 +20,7 @@ type ListInput struct {
 }
 
 // List retrieves all TSIG keys, automatically paginating through all pages.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) ([]TSIGKey, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) ([]TSIGKey, error) {
 	var (
 		out    []TSIGKey
 		cursor *string
@@ -40,7 +40,7 @@ func List(ctx context.Context, c *fastly.Client, i *ListInput) ([]TSIGKey, error
 }
 
 // listPage retrieves a single page of TSIG keys.
-func listPage(ctx context.Context, c *fastly.Client, i *ListInput, cursor *string) (*TSIGKeys, error) {
+func listPage(ctx context.Context, c fastly.Requester, i *ListInput, cursor *string) (*TSIGKeys, error) {
 	path := fastly.ToSafeURL("dns", "v1", "tsig-keys")
 
 	requestOptions := fastly.CreateRequestOptions()
@@ -23,7 +23,7 @@ type UpdateInput struct {
 }
 
 // Update updates an existing TSIG key.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*TSIGKey, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*TSIGKey, error) {
 	if i.TSIGKeyID == nil {
 		return nil, fastly.ErrMissingID
 	}
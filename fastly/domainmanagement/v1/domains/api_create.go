@@ -21,7 +21,7 @@ type CreateInput struct {
 }
 
 // Create creates a new domain.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Data, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Data, error) {
 	resp, err := c.PostJSON(ctx, "/domain-management/v1/domains", i, fastly.CreateRequestOptions())
 	if err != nil {
 		return nil, err
@@ -16,7 +16,7 @@ type GetInput struct {
 }
 
 // Get retrieves a specified domain.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Data, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Data, error) {
 	if i.DomainID == nil {
 		return nil, fastly.ErrMissingDomainID
 	}
@@ -27,7 +27,7 @@ type ListInput struct {
 }
 
 // List retrieves a list of domains, with optional filtering and pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Collection, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Collection, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Cursor != nil {
 		requestOptions.Params["cursor"] = *i.Cursor
@@ -21,7 +21,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified domain.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Data, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Data, error) {
 	if i.DomainID == nil {
 		return nil, fastly.ErrMissingDomainID
 	}
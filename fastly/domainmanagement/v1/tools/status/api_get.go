@@ -26,7 +26,7 @@ type GetInput struct {
 }
 
 // Get performs a domain status check for a given domain.
-func Get(ctx context.Context, c *fastly.Client, g *GetInput) (*Status, error) {
+func Get(ctx context.Context, c fastly.Requester, g *GetInput) (*Status, error) {
 	if g.Domain == "" {
 		return nil, fastly.ErrMissingDomain
 	}
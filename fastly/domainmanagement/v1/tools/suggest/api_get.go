@@ -26,7 +26,7 @@ type GetInput struct {
 }
 
 // Get returns a list of domain suggestions matching the query criteria.
-func Get(ctx context.Context, c *fastly.Client, g *GetInput) (*Suggestions, error) {
+func Get(ctx context.Context, c fastly.Requester, g *GetInput) (*Suggestions, error) {
 	if g.Query == "" {
 		return nil, fastly.ErrMissingDomainQuery
 	}
@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/jsonapi"
 )
@@ -473,6 +475,27 @@ var ErrNotImplemented = errors.New("not implemented")
 // already enabled for a service.
 var ErrManagedLoggingEnabled = errors.New("managed logging already enabled")
 
+// ErrValidationFailed is an error that indicates that a service version
+// failed validation and was not activated.
+var ErrValidationFailed = errors.New("service version failed validation")
+
+// ErrRolledBack is an error that indicates that a newly activated service
+// version failed a health gate and the service was rolled back.
+var ErrRolledBack = errors.New("deployment rolled back")
+
+// ErrBrokenReferences is an error that indicates that the objects of a
+// service version refer to objects which are missing or of the wrong type.
+var ErrBrokenReferences = errors.New("service version has broken references")
+
+// ErrMaskedSecrets is an error that indicates that a snapshot holds masked
+// credentials, which must be supplied again before it can be restored.
+var ErrMaskedSecrets = errors.New("snapshot has masked secrets")
+
+// ErrSharedEntries is an error that indicates that copying dictionary items
+// or ACL entries would change dictionaries or ACLs of locked or active
+// versions, as they are not versioned.
+var ErrSharedEntries = errors.New("dictionaries or ACLs are shared with locked versions")
+
 // ErrMissingToken is an error that is returned when an input struct
 // requires a "Token" key, but one was not set.
 var ErrMissingToken = NewFieldError("Token")
@@ -566,6 +589,45 @@ var ErrMissingEventTypes = NewFieldError("EventTypes")
 // requires an "IntegrationIDs" key, but one was not set.
 var ErrMissingIntegrationIDs = NewFieldError("IntegrationIDs")
 
+// ErrMissingDesired is an error that is returned when an input struct
+// requires a "Desired" key, but one was not set.
+var ErrMissingDesired = NewFieldError("Desired")
+
+// ErrMissingPlan is an error that is returned when an input struct requires
+// a "Plan" key, but one was not set.
+var ErrMissingPlan = NewFieldError("Plan")
+
+// ErrMissingSnapshot is an error that is returned when an input struct
+// requires a "Snapshot" key, but one was not set.
+var ErrMissingSnapshot = NewFieldError("Snapshot")
+
+// ErrMissingChanges is an error that is returned when an input struct
+// requires a "Changes" key, but one was not set.
+var ErrMissingChanges = NewFieldError("Changes")
+
+// ErrMissingFunc is an error that is returned when an input struct requires
+// a "Func" key, but one was not set.
+var ErrMissingFunc = NewFieldError("Func")
+
+// The following errors classify an *HTTPError for use with errors.Is, e.g.
+//
+//	if errors.Is(err, fastly.ErrConflict) { ... }
+var (
+	// ErrUnauthorized matches an *HTTPError with status 401.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches an *HTTPError with status 403.
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict matches an *HTTPError with status 409.
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited matches an *HTTPError with status 429.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrVersionLocked matches an *HTTPError rejecting a change to a
+	// locked service version. Active versions are always locked.
+	ErrVersionLocked = errors.New("service version is locked")
+	// ErrServerError matches an *HTTPError with a 5xx status.
+	ErrServerError = errors.New("server error")
+)
+
 // Ensure HTTPError is, in fact, an error.
 var _ error = (*HTTPError)(nil)
 
@@ -583,6 +645,29 @@ type HTTPError struct {
 	// as a Unix timestamp. A `nil` value indicates the API returned no value for
 	// the associated Fastly-RateLimit-Reset response header.
 	RateLimitReset *int
+	// RetryAfter is the delay requested by the API before retrying, parsed
+	// from the Retry-After header or, for a 429, the Fastly-RateLimit-Reset
+	// header. It is zero if the API requested no delay.
+	RetryAfter time.Duration
+	// RequestID is the value of the X-Request-Id or Fastly-Request-Id
+	// response header, if any.
+	RequestID string
+	// Body is the raw response body.
+	Body []byte
+	// BatchErrors holds the per-item failures reported by batch endpoints
+	// such as the KV Store batch insert.
+	BatchErrors []BatchItemError
+}
+
+// BatchItemError describes the failure of a single item in a batch request.
+type BatchItemError struct {
+	// Index is the zero-based position of the item in the batch, or -1 if
+	// the API did not report one.
+	Index int
+	// Code is the machine-readable error code.
+	Code string
+	// Detail is the human-readable reason for the failure.
+	Detail string
 }
 
 // ErrorObject is a single error.
@@ -615,15 +700,22 @@ func NewHTTPError(resp *http.Response) *HTTPError {
 		e.RateLimitReset = &v
 	}
 
+	if d, ok := retryAfter(resp, time.Now()); ok {
+		e.RetryAfter = d
+	}
+	e.RequestID = requestIDFromHeader(resp.Header)
+
 	if resp.Body == nil {
 		return &e
 	}
 
-	// Save a copy of the body as it's read/decoded.
+	// Save a copy of the body before it's decoded.
 	// If decoding fails, it can then be used (via addDecodeErr)
 	// to create a generic error containing the body's read contents.
 	var bodyCp bytes.Buffer
-	body := io.TeeReader(resp.Body, &bodyCp)
+	_, _ = io.Copy(&bodyCp, resp.Body)
+	e.Body = bodyCp.Bytes()
+	body := bytes.NewReader(e.Body)
 	addDecodeErr := func() {
 		// There are 2 errors at this point:
 		//  1. The response error.
@@ -688,9 +780,11 @@ func NewHTTPError(resp *http.Response) *HTTPError {
 						detail, _ = d.(string)
 					}
 					var title string
+					batchIndex := -1
 					if i, ok := le["index"]; ok {
 						index, _ = i.(float64)
 						title = fmt.Sprintf("error at index: %v", index)
+						batchIndex = int(index)
 					}
 					if t, ok := le["title"]; ok {
 						title, _ = t.(string)
@@ -700,6 +794,11 @@ func NewHTTPError(resp *http.Response) *HTTPError {
 						Detail: detail,
 						Title:  title,
 					})
+					e.BatchErrors = append(e.BatchErrors, BatchItemError{
+						Index:  batchIndex,
+						Code:   code,
+						Detail: detail,
+					})
 				}
 			} else {
 				msg := lerr.Message
@@ -778,3 +877,40 @@ func (e *HTTPError) IsNotFound() bool {
 func (e *HTTPError) IsPreconditionFailed() bool {
 	return e.StatusCode == http.StatusPreconditionFailed
 }
+
+// Is reports whether the error matches target, one of ErrUnauthorized,
+// ErrForbidden, ErrConflict, ErrRateLimited, ErrVersionLocked or
+// ErrServerError. It allows errors.Is to classify API errors.
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrVersionLocked:
+		return e.isVersionLocked()
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// isVersionLocked reports whether the API rejected a change because the
+// service version is locked. The API does not use a dedicated
+// status code for this, so the error messages are inspected.
+func (e *HTTPError) isVersionLocked() bool {
+	if e.StatusCode < http.StatusBadRequest || e.StatusCode >= http.StatusInternalServerError {
+		return false
+	}
+	for _, eo := range e.Errors {
+		msg := strings.ToLower(eo.Title + " " + eo.Detail)
+		if strings.Contains(msg, "version") && strings.Contains(msg, "locked") {
+			return true
+		}
+	}
+	return false
+}
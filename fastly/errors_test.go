@@ -2,10 +2,14 @@ package fastly
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/jsonapi"
 )
@@ -144,4 +148,71 @@ func TestNewHTTPError(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("batch", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header: http.Header(map[string][]string{
+				"Content-Type":      {"application/json"},
+				"Fastly-Request-Id": {"req-123"},
+			}),
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"errors":[{"index":2,"code":"invalid","reason":"bad key"},{"code":"other","detail":"no index"}]}`)),
+		}
+		e := NewHTTPError(resp)
+
+		expected := []BatchItemError{
+			{Index: 2, Code: "invalid", Detail: "bad key"},
+			{Index: -1, Code: "other", Detail: "no index"},
+		}
+		if !reflect.DeepEqual(e.BatchErrors, expected) {
+			t.Errorf("expected %+v to be %+v", e.BatchErrors, expected)
+		}
+		if e.RequestID != "req-123" {
+			t.Errorf("bad request ID: %q", e.RequestID)
+		}
+		if !strings.Contains(string(e.Body), `"bad key"`) {
+			t.Errorf("bad body: %q", e.Body)
+		}
+	})
+}
+
+func TestHTTPError_Is(t *testing.T) {
+	t.Parallel()
+
+	newErr := func(status int, header http.Header, body string) error {
+		return NewHTTPError(&http.Response{
+			StatusCode: status,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+		})
+	}
+
+	cases := []struct {
+		err    error
+		target error
+		want   bool
+	}{
+		{newErr(http.StatusUnauthorized, nil, `{}`), ErrUnauthorized, true},
+		{newErr(http.StatusForbidden, nil, `{}`), ErrForbidden, true},
+		{newErr(http.StatusConflict, nil, `{}`), ErrConflict, true},
+		{newErr(http.StatusTooManyRequests, nil, `{}`), ErrRateLimited, true},
+		{newErr(http.StatusBadGateway, nil, `{}`), ErrServerError, true},
+		{newErr(http.StatusBadRequest, nil, `{"msg":"Bad request","detail":"Version 3 is locked"}`), ErrVersionLocked, true},
+		{newErr(http.StatusBadRequest, nil, `{"msg":"Bad request"}`), ErrVersionLocked, false},
+		{newErr(http.StatusNotFound, nil, `{}`), ErrServerError, false},
+		{newErr(http.StatusUnauthorized, nil, `{}`), ErrForbidden, false},
+		{fmt.Errorf("wrapped: %w", newErr(http.StatusConflict, nil, `{}`)), ErrConflict, true},
+	}
+	for i, tc := range cases {
+		if got := errors.Is(tc.err, tc.target); got != tc.want {
+			t.Errorf("case %d: errors.Is(%v, %v) = %t, want %t", i, tc.err, tc.target, got, tc.want)
+		}
+	}
+
+	var e *HTTPError
+	err := newErr(http.StatusTooManyRequests, http.Header{"Retry-After": {"7"}}, `{}`)
+	if !errors.As(err, &e) || e.RetryAfter != 7*time.Second {
+		t.Errorf("bad retry after: %v", err)
+	}
 }
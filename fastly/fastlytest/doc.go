@@ -0,0 +1,33 @@
+// Package fastlytest provides an in-process fake of the Fastly API for
+// testing code that uses [fastly.Client], without network access or
+// recorded fixtures.
+//
+// A [Server] keeps an in-memory model of services and their versions
+// (including clone, activate and lock semantics), the versioned VCL
+// configuration objects (domains, backends, directors, health checks,
+// conditions, headers, settings objects, response objects, gzips, snippets
+// (including the versionless content of dynamic snippets), custom VCLs, resource links, rate limiters and logging endpoints),
+// version settings, dictionaries and their items, ACLs and their entries,
+// KV, config and secret stores, and purges. Configuration objects are
+// stored as submitted and are not validated. Responses use the same
+// JSON shapes, status codes, JSON:API error bodies and rate limit headers
+// as the real API, so a client pointed at the server works end to end:
+//
+//	srv := fastlytest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := fastly.NewClientForEndpoint(fastlytest.Token, srv.URL)
+//
+// Only the endpoints listed above are modelled. Requests to any other
+// endpoint receive a 404 response.
+//
+// For tests against the real API, a [Recorder] records interactions to a
+// go-vcr cassette and replays them, removing API tokens, real resource IDs
+// and other secrets from the cassette:
+//
+//	r, err := fastlytest.NewRecorder("fixtures/create_service",
+//		fastlytest.WithMaskedFields("secret"))
+//	defer r.Stop()
+//
+//	client, err := r.Client(fastly.WithAPIKey(os.Getenv("FASTLY_API_KEY")))
+package fastlytest
@@ -0,0 +1,351 @@
+package fastlytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+)
+
+func (s *Server) registerItems() {
+	s.mux.HandleFunc("GET /service/{service}/dictionary/{dictionary}/items", s.listDictionaryItems)
+	s.mux.HandleFunc("PATCH /service/{service}/dictionary/{dictionary}/items", s.batchDictionaryItems)
+	s.mux.HandleFunc("POST /service/{service}/dictionary/{dictionary}/item", s.createDictionaryItem)
+	s.mux.HandleFunc("GET /service/{service}/dictionary/{dictionary}/item/{key}", s.getDictionaryItem)
+	s.mux.HandleFunc("PUT /service/{service}/dictionary/{dictionary}/item/{key}", s.upsertDictionaryItem)
+	s.mux.HandleFunc("DELETE /service/{service}/dictionary/{dictionary}/item/{key}", s.deleteDictionaryItem)
+
+	s.mux.HandleFunc("GET /service/{service}/acl/{acl}/entries", s.listACLEntries)
+	s.mux.HandleFunc("PATCH /service/{service}/acl/{acl}/entries", s.batchACLEntries)
+	s.mux.HandleFunc("POST /service/{service}/acl/{acl}/entry", s.createACLEntry)
+	s.mux.HandleFunc("GET /service/{service}/acl/{acl}/entry/{id}", s.getACLEntry)
+	s.mux.HandleFunc("PATCH /service/{service}/acl/{acl}/entry/{id}", s.updateACLEntry)
+	s.mux.HandleFunc("DELETE /service/{service}/acl/{acl}/entry/{id}", s.deleteACLEntry)
+}
+
+// lookupContainer returns the items of the dictionary or ACL whose ID is
+// given by the path value kind, or writes a 404 and returns nil if no
+// version of the service has one with that ID.
+func (s *Server) lookupContainer(w http.ResponseWriter, r *http.Request, kind string, items map[string]map[string]record) map[string]record {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return nil
+	}
+	id := r.PathValue(kind)
+	for _, v := range svc.versions {
+		for _, rec := range v.config[kind] {
+			if rec.str("id") != id {
+				continue
+			}
+			if items[id] == nil {
+				items[id] = map[string]record{}
+			}
+			return items[id]
+		}
+	}
+	writeError(w, http.StatusNotFound, "Record not found")
+	return nil
+}
+
+func (s *Server) newDictionaryItem(r *http.Request, key, value string) record {
+	return record{
+		"created_at":    s.timestamp(),
+		"deleted_at":    nil,
+		"dictionary_id": r.PathValue("dictionary"),
+		"item_key":      key,
+		"item_value":    value,
+		"service_id":    r.PathValue("service"),
+		"updated_at":    s.timestamp(),
+	}
+}
+
+func (s *Server) listDictionaryItems(w http.ResponseWriter, r *http.Request) {
+	items := s.lookupContainer(w, r, "dictionary", s.dictItems)
+	if items == nil {
+		return
+	}
+	recs := slices.Collect(maps.Values(items))
+	sortRecords(r, recs, "item_key")
+	s.writePage(w, r, recs)
+}
+
+func (s *Server) createDictionaryItem(w http.ResponseWriter, r *http.Request) {
+	items := s.lookupContainer(w, r, "dictionary", s.dictItems)
+	if items == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	key := r.PostForm.Get("item_key")
+	if key == "" {
+		writeError(w, http.StatusBadRequest, "Item key can't be blank")
+		return
+	}
+	if _, ok := items[key]; ok {
+		writeError(w, http.StatusConflict, "Duplicate record")
+		return
+	}
+	rec := s.newDictionaryItem(r, key, r.PostForm.Get("item_value"))
+	items[key] = rec
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) getDictionaryItem(w http.ResponseWriter, r *http.Request) {
+	items := s.lookupContainer(w, r, "dictionary", s.dictItems)
+	if items == nil {
+		return
+	}
+	rec, ok := items[r.PathValue("key")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) upsertDictionaryItem(w http.ResponseWriter, r *http.Request) {
+	items := s.lookupContainer(w, r, "dictionary", s.dictItems)
+	if items == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	key := r.PathValue("key")
+	rec, ok := items[key]
+	if !ok {
+		rec = s.newDictionaryItem(r, key, "")
+		items[key] = rec
+	}
+	rec["item_value"] = r.PostForm.Get("item_value")
+	rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) deleteDictionaryItem(w http.ResponseWriter, r *http.Request) {
+	items := s.lookupContainer(w, r, "dictionary", s.dictItems)
+	if items == nil {
+		return
+	}
+	key := r.PathValue("key")
+	if _, ok := items[key]; !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	delete(items, key)
+	writeStatusOK(w)
+}
+
+func (s *Server) batchDictionaryItems(w http.ResponseWriter, r *http.Request) {
+	items := s.lookupContainer(w, r, "dictionary", s.dictItems)
+	if items == nil {
+		return
+	}
+	var body struct {
+		Items []struct {
+			Op    string `json:"op"`
+			Key   string `json:"item_key"`
+			Value string `json:"item_value"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Validate every operation before applying any, so that a failed batch
+	// leaves the dictionary unchanged.
+	for _, item := range body.Items {
+		_, exists := items[item.Key]
+		switch item.Op {
+		case "create":
+			if exists {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Item %q already exists", item.Key))
+				return
+			}
+		case "update", "delete":
+			if !exists {
+				writeError(w, http.StatusNotFound, fmt.Sprintf("Item %q not found", item.Key))
+				return
+			}
+		case "upsert":
+		default:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid operation %q", item.Op))
+			return
+		}
+	}
+	for _, item := range body.Items {
+		if item.Op == "delete" {
+			delete(items, item.Key)
+			continue
+		}
+		rec, ok := items[item.Key]
+		if !ok {
+			rec = s.newDictionaryItem(r, item.Key, "")
+			items[item.Key] = rec
+		}
+		rec["item_value"] = item.Value
+		rec["updated_at"] = s.timestamp()
+	}
+	writeStatusOK(w)
+}
+
+func (s *Server) newACLEntry(r *http.Request) record {
+	return record{
+		"acl_id":     r.PathValue("acl"),
+		"comment":    "",
+		"created_at": s.timestamp(),
+		"deleted_at": nil,
+		"id":         newID(),
+		"negated":    false,
+		"service_id": r.PathValue("service"),
+		"subnet":     nil,
+		"updated_at": s.timestamp(),
+	}
+}
+
+func (s *Server) listACLEntries(w http.ResponseWriter, r *http.Request) {
+	entries := s.lookupContainer(w, r, "acl", s.aclEntries)
+	if entries == nil {
+		return
+	}
+	recs := slices.Collect(maps.Values(entries))
+	sortRecords(r, recs, "created_at")
+	s.writePage(w, r, recs)
+}
+
+func (s *Server) createACLEntry(w http.ResponseWriter, r *http.Request) {
+	entries := s.lookupContainer(w, r, "acl", s.aclEntries)
+	if entries == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Get("ip") == "" {
+		writeError(w, http.StatusBadRequest, "IP can't be blank")
+		return
+	}
+	rec := s.newACLEntry(r)
+	applyForm(rec, r.PostForm)
+	entries[rec.str("id")] = rec
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) getACLEntry(w http.ResponseWriter, r *http.Request) {
+	entries := s.lookupContainer(w, r, "acl", s.aclEntries)
+	if entries == nil {
+		return
+	}
+	rec, ok := entries[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) updateACLEntry(w http.ResponseWriter, r *http.Request) {
+	entries := s.lookupContainer(w, r, "acl", s.aclEntries)
+	if entries == nil {
+		return
+	}
+	rec, ok := entries[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	applyForm(rec, r.PostForm)
+	rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) deleteACLEntry(w http.ResponseWriter, r *http.Request) {
+	entries := s.lookupContainer(w, r, "acl", s.aclEntries)
+	if entries == nil {
+		return
+	}
+	id := r.PathValue("id")
+	if _, ok := entries[id]; !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	delete(entries, id)
+	writeStatusOK(w)
+}
+
+func (s *Server) batchACLEntries(w http.ResponseWriter, r *http.Request) {
+	entries := s.lookupContainer(w, r, "acl", s.aclEntries)
+	if entries == nil {
+		return
+	}
+	var body struct {
+		Entries []struct {
+			Op      string  `json:"op"`
+			ID      string  `json:"id"`
+			IP      *string `json:"ip"`
+			Subnet  *int    `json:"subnet"`
+			Negated any     `json:"negated"`
+			Comment *string `json:"comment"`
+		} `json:"entries"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, e := range body.Entries {
+		_, exists := entries[e.ID]
+		switch e.Op {
+		case "create":
+			if e.IP == nil {
+				writeError(w, http.StatusBadRequest, "IP can't be blank")
+				return
+			}
+		case "update", "delete":
+			if !exists {
+				writeError(w, http.StatusNotFound, fmt.Sprintf("Entry %q not found", e.ID))
+				return
+			}
+		case "upsert":
+		default:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid operation %q", e.Op))
+			return
+		}
+	}
+	for _, e := range body.Entries {
+		if e.Op == "delete" {
+			delete(entries, e.ID)
+			continue
+		}
+		rec, ok := entries[e.ID]
+		if !ok {
+			rec = s.newACLEntry(r)
+			entries[rec.str("id")] = rec
+		}
+		if e.IP != nil {
+			rec["ip"] = *e.IP
+		}
+		if e.Subnet != nil {
+			rec["subnet"] = *e.Subnet
+		}
+		if e.Negated != nil {
+			// Compatibool values are sent as "0" or "1".
+			rec["negated"] = e.Negated == true || e.Negated == "1"
+		}
+		if e.Comment != nil {
+			rec["comment"] = *e.Comment
+		}
+		rec["updated_at"] = s.timestamp()
+	}
+	writeStatusOK(w)
+}
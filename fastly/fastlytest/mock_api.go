@@ -0,0 +1,4323 @@
+// Code generated by internal/cmd/apigen; DO NOT EDIT.
+
+package fastlytest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"github.com/fastly/go-fastly/v17/fastly"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// MockAPI is an implementation of fastly.API for unit tests. Each method
+// calls the function in the field of the same name with a Func suffix, which
+// the test sets as needed. Calling a method whose function is nil panics.
+type MockAPI struct {
+	ActivateVCLFunc                         func(ctx context.Context, i *fastly.ActivateVCLInput) (*fastly.VCL, error)
+	ActivateVersionFunc                     func(ctx context.Context, i *fastly.ActivateVersionInput) (*fastly.Version, error)
+	AllAPIEventsFunc                        func(ctx context.Context, i *fastly.GetAPIEventsFilterInput) iter.Seq2[*fastly.Event, error]
+	AllAlertDefinitionsFunc                 func(ctx context.Context, i *fastly.ListAlertDefinitionsInput) iter.Seq2[*fastly.AlertDefinition, error]
+	AllAlertHistoryFunc                     func(ctx context.Context, i *fastly.ListAlertHistoryInput) iter.Seq2[*fastly.AlertHistory, error]
+	AllDatacentersFunc                      func(ctx context.Context) ([]fastly.Datacenter, error)
+	AllIPsFunc                              func(ctx context.Context) (fastly.IPAddrs, fastly.IPAddrs, error)
+	AllKVStoreKeysFunc                      func(ctx context.Context, i *fastly.ListKVStoreKeysInput) iter.Seq2[string, error]
+	AllKVStoresFunc                         func(ctx context.Context, i *fastly.ListKVStoresInput) iter.Seq2[*fastly.KVStore, error]
+	AllServicesFunc                         func(ctx context.Context) iter.Seq2[*fastly.Service, error]
+	ApplyServiceConfigFunc                  func(ctx context.Context, i *fastly.ApplyServiceConfigInput) (*fastly.Version, error)
+	BatchDeleteTokensFunc                   func(ctx context.Context, i *fastly.BatchDeleteTokensInput) error
+	BatchModifyACLEntriesFunc               func(ctx context.Context, i *fastly.BatchModifyACLEntriesInput) error
+	BatchModifyConfigStoreItemsFunc         func(ctx context.Context, i *fastly.BatchModifyConfigStoreItemsInput) error
+	BatchModifyDictionaryItemsFunc          func(ctx context.Context, i *fastly.BatchModifyDictionaryItemsInput) error
+	BatchModifyKVStoreKeyFunc               func(ctx context.Context, i *fastly.BatchModifyKVStoreKeyInput) error
+	CheckVersionReferencesFunc              func(ctx context.Context, i *fastly.CheckVersionReferencesInput) (*fastly.ReferenceReport, error)
+	CleanupStaleDraftsFunc                  func(ctx context.Context, i *fastly.CleanupStaleDraftsInput) ([]*fastly.Version, error)
+	CloneVersionFunc                        func(ctx context.Context, i *fastly.CloneVersionInput) (*fastly.Version, error)
+	CopyServiceConfigFunc                   func(ctx context.Context, i *fastly.CopyServiceConfigInput) (*fastly.CopyServiceConfigResult, error)
+	CreateACLFunc                           func(ctx context.Context, i *fastly.CreateACLInput) (*fastly.ACL, error)
+	CreateACLEntryFunc                      func(ctx context.Context, i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error)
+	CreateAlertDefinitionFunc               func(ctx context.Context, i *fastly.CreateAlertDefinitionInput) (*fastly.AlertDefinition, error)
+	CreateAutomationTokenFunc               func(ctx context.Context, i *fastly.CreateAutomationTokenInput) (*fastly.AutomationToken, error)
+	CreateBackendFunc                       func(ctx context.Context, i *fastly.CreateBackendInput) (*fastly.Backend, error)
+	CreateBigQueryFunc                      func(ctx context.Context, i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error)
+	CreateBlobStorageFunc                   func(ctx context.Context, i *fastly.CreateBlobStorageInput) (*fastly.BlobStorage, error)
+	CreateBulkCertificateFunc               func(ctx context.Context, i *fastly.CreateBulkCertificateInput) (*fastly.BulkCertificate, error)
+	CreateCacheSettingFunc                  func(ctx context.Context, i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	CreateClientKeyFunc                     func(ctx context.Context) (*fastly.ClientKey, error)
+	CreateCloudfilesFunc                    func(ctx context.Context, i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error)
+	CreateConditionFunc                     func(ctx context.Context, i *fastly.CreateConditionInput) (*fastly.Condition, error)
+	CreateConfigStoreFunc                   func(ctx context.Context, i *fastly.CreateConfigStoreInput) (*fastly.ConfigStore, error)
+	CreateConfigStoreItemFunc               func(ctx context.Context, i *fastly.CreateConfigStoreItemInput) (*fastly.ConfigStoreItem, error)
+	CreateCustomTLSCertificateFunc          func(ctx context.Context, i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	CreateDatadogFunc                       func(ctx context.Context, i *fastly.CreateDatadogInput) (*fastly.Datadog, error)
+	CreateDictionaryFunc                    func(ctx context.Context, i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error)
+	CreateDictionaryItemFunc                func(ctx context.Context, i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error)
+	CreateDictionaryItemsFunc               func(ctx context.Context, i []fastly.CreateDictionaryItemInput) ([]fastly.DictionaryItem, error)
+	CreateDigitalOceanFunc                  func(ctx context.Context, i *fastly.CreateDigitalOceanInput) (*fastly.DigitalOcean, error)
+	CreateDirectorFunc                      func(ctx context.Context, i *fastly.CreateDirectorInput) (*fastly.Director, error)
+	CreateDirectorBackendFunc               func(ctx context.Context, i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error)
+	CreateDomainFunc                        func(ctx context.Context, i *fastly.CreateDomainInput) (*fastly.Domain, error)
+	CreateERLFunc                           func(ctx context.Context, i *fastly.CreateERLInput) (*fastly.ERL, error)
+	CreateElasticsearchFunc                 func(ctx context.Context, i *fastly.CreateElasticsearchInput) (*fastly.Elasticsearch, error)
+	CreateFTPFunc                           func(ctx context.Context, i *fastly.CreateFTPInput) (*fastly.FTP, error)
+	CreateGCSFunc                           func(ctx context.Context, i *fastly.CreateGCSInput) (*fastly.GCS, error)
+	CreateGrafanaCloudLogsFunc              func(ctx context.Context, i *fastly.CreateGrafanaCloudLogsInput) (*fastly.GrafanaCloudLogs, error)
+	CreateGzipFunc                          func(ctx context.Context, i *fastly.CreateGzipInput) (*fastly.Gzip, error)
+	CreateHTTPSFunc                         func(ctx context.Context, i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error)
+	CreateHeaderFunc                        func(ctx context.Context, i *fastly.CreateHeaderInput) (*fastly.Header, error)
+	CreateHealthCheckFunc                   func(ctx context.Context, i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error)
+	CreateHerokuFunc                        func(ctx context.Context, i *fastly.CreateHerokuInput) (*fastly.Heroku, error)
+	CreateHoneycombFunc                     func(ctx context.Context, i *fastly.CreateHoneycombInput) (*fastly.Honeycomb, error)
+	CreateIntegrationFunc                   func(ctx context.Context, i *fastly.CreateIntegrationInput) (*fastly.CreateIntegrationResponse, error)
+	CreateKVStoreFunc                       func(ctx context.Context, i *fastly.CreateKVStoreInput) (*fastly.KVStore, error)
+	CreateKafkaFunc                         func(ctx context.Context, i *fastly.CreateKafkaInput) (*fastly.Kafka, error)
+	CreateKinesisFunc                       func(ctx context.Context, i *fastly.CreateKinesisInput) (*fastly.Kinesis, error)
+	CreateLogentriesFunc                    func(ctx context.Context, i *fastly.CreateLogentriesInput) (*fastly.Logentries, error)
+	CreateLogglyFunc                        func(ctx context.Context, i *fastly.CreateLogglyInput) (*fastly.Loggly, error)
+	CreateLogshuttleFunc                    func(ctx context.Context, i *fastly.CreateLogshuttleInput) (*fastly.Logshuttle, error)
+	CreateMailinglistConfirmationFunc       func(ctx context.Context, i *fastly.CreateMailinglistConfirmationInput) error
+	CreateManagedLoggingFunc                func(ctx context.Context, i *fastly.CreateManagedLoggingInput) (*fastly.ManagedLogging, error)
+	CreateNewRelicFunc                      func(ctx context.Context, i *fastly.CreateNewRelicInput) (*fastly.NewRelic, error)
+	CreateNewRelicOTLPFunc                  func(ctx context.Context, i *fastly.CreateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error)
+	CreateObservabilityCustomDashboardFunc  func(ctx context.Context, i *fastly.CreateObservabilityCustomDashboardInput) (*fastly.ObservabilityCustomDashboard, error)
+	CreateOpenstackFunc                     func(ctx context.Context, i *fastly.CreateOpenstackInput) (*fastly.Openstack, error)
+	CreatePapertrailFunc                    func(ctx context.Context, i *fastly.CreatePapertrailInput) (*fastly.Papertrail, error)
+	CreatePoolFunc                          func(ctx context.Context, i *fastly.CreatePoolInput) (*fastly.Pool, error)
+	CreatePrivateKeyFunc                    func(ctx context.Context, i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error)
+	CreatePubsubFunc                        func(ctx context.Context, i *fastly.CreatePubsubInput) (*fastly.Pubsub, error)
+	CreateRequestSettingFunc                func(ctx context.Context, i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	CreateResourceFunc                      func(ctx context.Context, i *fastly.CreateResourceInput) (*fastly.Resource, error)
+	CreateResponseObjectFunc                func(ctx context.Context, i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	CreateS3Func                            func(ctx context.Context, i *fastly.CreateS3Input) (*fastly.S3, error)
+	CreateSFTPFunc                          func(ctx context.Context, i *fastly.CreateSFTPInput) (*fastly.SFTP, error)
+	CreateScalyrFunc                        func(ctx context.Context, i *fastly.CreateScalyrInput) (*fastly.Scalyr, error)
+	CreateSecretFunc                        func(ctx context.Context, i *fastly.CreateSecretInput) (*fastly.Secret, error)
+	CreateSecretStoreFunc                   func(ctx context.Context, i *fastly.CreateSecretStoreInput) (*fastly.SecretStore, error)
+	CreateServerFunc                        func(ctx context.Context, i *fastly.CreateServerInput) (*fastly.Server, error)
+	CreateServiceFunc                       func(ctx context.Context, i *fastly.CreateServiceInput) (*fastly.Service, error)
+	CreateServiceAuthorizationFunc          func(ctx context.Context, i *fastly.CreateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error)
+	CreateSnippetFunc                       func(ctx context.Context, i *fastly.CreateSnippetInput) (*fastly.Snippet, error)
+	CreateSplunkFunc                        func(ctx context.Context, i *fastly.CreateSplunkInput) (*fastly.Splunk, error)
+	CreateSumologicFunc                     func(ctx context.Context, i *fastly.CreateSumologicInput) (*fastly.Sumologic, error)
+	CreateSyslogFunc                        func(ctx context.Context, i *fastly.CreateSyslogInput) (*fastly.Syslog, error)
+	CreateTLSActivationFunc                 func(ctx context.Context, i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error)
+	CreateTLSMutualAuthenticationFunc       func(ctx context.Context, i *fastly.CreateTLSMutualAuthenticationInput) (*fastly.TLSMutualAuthentication, error)
+	CreateTLSSubscriptionFunc               func(ctx context.Context, i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	CreateTokenFunc                         func(ctx context.Context, i *fastly.CreateTokenInput) (*fastly.Token, error)
+	CreateUserFunc                          func(ctx context.Context, i *fastly.CreateUserInput) (*fastly.User, error)
+	CreateVCLFunc                           func(ctx context.Context, i *fastly.CreateVCLInput) (*fastly.VCL, error)
+	CreateVersionFunc                       func(ctx context.Context, i *fastly.CreateVersionInput) (*fastly.Version, error)
+	DeactivateVersionFunc                   func(ctx context.Context, i *fastly.DeactivateVersionInput) (*fastly.Version, error)
+	DeleteFunc                              func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	DeleteACLFunc                           func(ctx context.Context, i *fastly.DeleteACLInput) error
+	DeleteACLEntryFunc                      func(ctx context.Context, i *fastly.DeleteACLEntryInput) error
+	DeleteAlertDefinitionFunc               func(ctx context.Context, i *fastly.DeleteAlertDefinitionInput) error
+	DeleteAutomationTokenFunc               func(ctx context.Context, i *fastly.DeleteAutomationTokenInput) error
+	DeleteBackendFunc                       func(ctx context.Context, i *fastly.DeleteBackendInput) error
+	DeleteBigQueryFunc                      func(ctx context.Context, i *fastly.DeleteBigQueryInput) error
+	DeleteBlobStorageFunc                   func(ctx context.Context, i *fastly.DeleteBlobStorageInput) error
+	DeleteBulkCertificateFunc               func(ctx context.Context, i *fastly.DeleteBulkCertificateInput) error
+	DeleteCacheSettingFunc                  func(ctx context.Context, i *fastly.DeleteCacheSettingInput) error
+	DeleteCloudfilesFunc                    func(ctx context.Context, i *fastly.DeleteCloudfilesInput) error
+	DeleteConditionFunc                     func(ctx context.Context, i *fastly.DeleteConditionInput) error
+	DeleteConfigStoreFunc                   func(ctx context.Context, i *fastly.DeleteConfigStoreInput) error
+	DeleteConfigStoreItemFunc               func(ctx context.Context, i *fastly.DeleteConfigStoreItemInput) error
+	DeleteCustomTLSCertificateFunc          func(ctx context.Context, i *fastly.DeleteCustomTLSCertificateInput) error
+	DeleteDatadogFunc                       func(ctx context.Context, i *fastly.DeleteDatadogInput) error
+	DeleteDictionaryFunc                    func(ctx context.Context, i *fastly.DeleteDictionaryInput) error
+	DeleteDictionaryItemFunc                func(ctx context.Context, i *fastly.DeleteDictionaryItemInput) error
+	DeleteDigitalOceanFunc                  func(ctx context.Context, i *fastly.DeleteDigitalOceanInput) error
+	DeleteDirectorFunc                      func(ctx context.Context, i *fastly.DeleteDirectorInput) error
+	DeleteDirectorBackendFunc               func(ctx context.Context, i *fastly.DeleteDirectorBackendInput) error
+	DeleteDomainFunc                        func(ctx context.Context, i *fastly.DeleteDomainInput) error
+	DeleteERLFunc                           func(ctx context.Context, i *fastly.DeleteERLInput) error
+	DeleteElasticsearchFunc                 func(ctx context.Context, i *fastly.DeleteElasticsearchInput) error
+	DeleteFTPFunc                           func(ctx context.Context, i *fastly.DeleteFTPInput) error
+	DeleteGCSFunc                           func(ctx context.Context, i *fastly.DeleteGCSInput) error
+	DeleteGrafanaCloudLogsFunc              func(ctx context.Context, i *fastly.DeleteGrafanaCloudLogsInput) error
+	DeleteGzipFunc                          func(ctx context.Context, i *fastly.DeleteGzipInput) error
+	DeleteHTTPSFunc                         func(ctx context.Context, i *fastly.DeleteHTTPSInput) error
+	DeleteHeaderFunc                        func(ctx context.Context, i *fastly.DeleteHeaderInput) error
+	DeleteHealthCheckFunc                   func(ctx context.Context, i *fastly.DeleteHealthCheckInput) error
+	DeleteHerokuFunc                        func(ctx context.Context, i *fastly.DeleteHerokuInput) error
+	DeleteHoneycombFunc                     func(ctx context.Context, i *fastly.DeleteHoneycombInput) error
+	DeleteIntegrationFunc                   func(ctx context.Context, i *fastly.DeleteIntegrationInput) error
+	DeleteJSONAPIFunc                       func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	DeleteJSONAPIBulkFunc                   func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	DeleteKVStoreFunc                       func(ctx context.Context, i *fastly.DeleteKVStoreInput) error
+	DeleteKVStoreKeyFunc                    func(ctx context.Context, i *fastly.DeleteKVStoreKeyInput) error
+	DeleteKafkaFunc                         func(ctx context.Context, i *fastly.DeleteKafkaInput) error
+	DeleteKinesisFunc                       func(ctx context.Context, i *fastly.DeleteKinesisInput) error
+	DeleteLogentriesFunc                    func(ctx context.Context, i *fastly.DeleteLogentriesInput) error
+	DeleteLogglyFunc                        func(ctx context.Context, i *fastly.DeleteLogglyInput) error
+	DeleteLogshuttleFunc                    func(ctx context.Context, i *fastly.DeleteLogshuttleInput) error
+	DeleteManagedLoggingFunc                func(ctx context.Context, i *fastly.DeleteManagedLoggingInput) error
+	DeleteNewRelicFunc                      func(ctx context.Context, i *fastly.DeleteNewRelicInput) error
+	DeleteNewRelicOTLPFunc                  func(ctx context.Context, i *fastly.DeleteNewRelicOTLPInput) error
+	DeleteObservabilityCustomDashboardFunc  func(ctx context.Context, i *fastly.DeleteObservabilityCustomDashboardInput) error
+	DeleteOpenstackFunc                     func(ctx context.Context, i *fastly.DeleteOpenstackInput) error
+	DeletePapertrailFunc                    func(ctx context.Context, i *fastly.DeletePapertrailInput) error
+	DeletePoolFunc                          func(ctx context.Context, i *fastly.DeletePoolInput) error
+	DeletePrivateKeyFunc                    func(ctx context.Context, i *fastly.DeletePrivateKeyInput) error
+	DeletePubsubFunc                        func(ctx context.Context, i *fastly.DeletePubsubInput) error
+	DeleteRequestSettingFunc                func(ctx context.Context, i *fastly.DeleteRequestSettingInput) error
+	DeleteResourceFunc                      func(ctx context.Context, i *fastly.DeleteResourceInput) error
+	DeleteResponseObjectFunc                func(ctx context.Context, i *fastly.DeleteResponseObjectInput) error
+	DeleteS3Func                            func(ctx context.Context, i *fastly.DeleteS3Input) error
+	DeleteSFTPFunc                          func(ctx context.Context, i *fastly.DeleteSFTPInput) error
+	DeleteScalyrFunc                        func(ctx context.Context, i *fastly.DeleteScalyrInput) error
+	DeleteSecretFunc                        func(ctx context.Context, i *fastly.DeleteSecretInput) error
+	DeleteSecretStoreFunc                   func(ctx context.Context, i *fastly.DeleteSecretStoreInput) error
+	DeleteServerFunc                        func(ctx context.Context, i *fastly.DeleteServerInput) error
+	DeleteServiceFunc                       func(ctx context.Context, i *fastly.DeleteServiceInput) error
+	DeleteServiceAuthorizationFunc          func(ctx context.Context, i *fastly.DeleteServiceAuthorizationInput) error
+	DeleteSnippetFunc                       func(ctx context.Context, i *fastly.DeleteSnippetInput) error
+	DeleteSplunkFunc                        func(ctx context.Context, i *fastly.DeleteSplunkInput) error
+	DeleteSumologicFunc                     func(ctx context.Context, i *fastly.DeleteSumologicInput) error
+	DeleteSyslogFunc                        func(ctx context.Context, i *fastly.DeleteSyslogInput) error
+	DeleteTLSActivationFunc                 func(ctx context.Context, i *fastly.DeleteTLSActivationInput) error
+	DeleteTLSMutualAuthenticationFunc       func(ctx context.Context, i *fastly.DeleteTLSMutualAuthenticationInput) error
+	DeleteTLSSubscriptionFunc               func(ctx context.Context, i *fastly.DeleteTLSSubscriptionInput) error
+	DeleteTokenFunc                         func(ctx context.Context, i *fastly.DeleteTokenInput) error
+	DeleteTokenSelfFunc                     func(ctx context.Context) error
+	DeleteUserFunc                          func(ctx context.Context, i *fastly.DeleteUserInput) error
+	DeleteVCLFunc                           func(ctx context.Context, i *fastly.DeleteVCLInput) error
+	DiffVersionsFunc                        func(ctx context.Context, i *fastly.DiffVersionsInput) (*fastly.VersionDiff, error)
+	DisableHTTP3Func                        func(ctx context.Context, i *fastly.DisableHTTP3Input) error
+	EdgeCheckFunc                           func(ctx context.Context, i *fastly.EdgeCheckInput) ([]*fastly.EdgeCheck, error)
+	EnableHTTP3Func                         func(ctx context.Context, i *fastly.EnableHTTP3Input) (*fastly.HTTP3, error)
+	ExportServiceVersionFunc                func(ctx context.Context, i *fastly.ExportServiceVersionInput) (*fastly.ServiceVersionSnapshot, error)
+	ForEachServiceFunc                      func(ctx context.Context, i *fastly.ForEachServiceInput) (*fastly.ForEachServiceReport, error)
+	GetFunc                                 func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	GetACLFunc                              func(ctx context.Context, i *fastly.GetACLInput) (*fastly.ACL, error)
+	GetACLEntriesFunc                       func(ctx context.Context, i *fastly.GetACLEntriesInput) *fastly.ListPaginator[fastly.ACLEntry]
+	GetACLEntryFunc                         func(ctx context.Context, i *fastly.GetACLEntryInput) (*fastly.ACLEntry, error)
+	GetAPIEventFunc                         func(ctx context.Context, i *fastly.GetAPIEventInput) (*fastly.Event, error)
+	GetAPIEventsFunc                        func(ctx context.Context, i *fastly.GetAPIEventsFilterInput) (fastly.GetAPIEventsResponse, error)
+	GetAggregateJSONFunc                    func(ctx context.Context, i *fastly.GetAggregateInput, dst any) error
+	GetAlertDefinitionFunc                  func(ctx context.Context, i *fastly.GetAlertDefinitionInput) (*fastly.AlertDefinition, error)
+	GetAutomationTokenFunc                  func(ctx context.Context, i *fastly.GetAutomationTokenInput) (*fastly.AutomationToken, error)
+	GetAutomationTokensFunc                 func(ctx context.Context, i *fastly.GetAutomationTokensInput) *fastly.ListPaginator[fastly.AutomationTokenPaginator]
+	GetBackendFunc                          func(ctx context.Context, i *fastly.GetBackendInput) (*fastly.Backend, error)
+	GetBigQueryFunc                         func(ctx context.Context, i *fastly.GetBigQueryInput) (*fastly.BigQuery, error)
+	GetBillingFunc                          func(ctx context.Context, i *fastly.GetBillingInput) (*fastly.Billing, error)
+	GetBlobStorageFunc                      func(ctx context.Context, i *fastly.GetBlobStorageInput) (*fastly.BlobStorage, error)
+	GetBulkCertificateFunc                  func(ctx context.Context, i *fastly.GetBulkCertificateInput) (*fastly.BulkCertificate, error)
+	GetCacheSettingFunc                     func(ctx context.Context, i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	GetCloudfilesFunc                       func(ctx context.Context, i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error)
+	GetConditionFunc                        func(ctx context.Context, i *fastly.GetConditionInput) (*fastly.Condition, error)
+	GetConfigStoreFunc                      func(ctx context.Context, i *fastly.GetConfigStoreInput) (*fastly.ConfigStore, error)
+	GetConfigStoreItemFunc                  func(ctx context.Context, i *fastly.GetConfigStoreItemInput) (*fastly.ConfigStoreItem, error)
+	GetConfigStoreMetadataFunc              func(ctx context.Context, i *fastly.GetConfigStoreMetadataInput) (*fastly.ConfigStoreMetadata, error)
+	GetCurrentUserFunc                      func(ctx context.Context) (*fastly.User, error)
+	GetCustomTLSCertificateFunc             func(ctx context.Context, i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	GetCustomTLSConfigurationFunc           func(ctx context.Context, i *fastly.GetCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error)
+	GetDatadogFunc                          func(ctx context.Context, i *fastly.GetDatadogInput) (*fastly.Datadog, error)
+	GetDictionaryFunc                       func(ctx context.Context, i *fastly.GetDictionaryInput) (*fastly.Dictionary, error)
+	GetDictionaryInfoFunc                   func(ctx context.Context, i *fastly.GetDictionaryInfoInput) (*fastly.DictionaryInfo, error)
+	GetDictionaryItemFunc                   func(ctx context.Context, i *fastly.GetDictionaryItemInput) (*fastly.DictionaryItem, error)
+	GetDictionaryItemsFunc                  func(ctx context.Context, i *fastly.GetDictionaryItemsInput) *fastly.ListPaginator[fastly.DictionaryItem]
+	GetDiffFunc                             func(ctx context.Context, i *fastly.GetDiffInput) (*fastly.Diff, error)
+	GetDigitalOceanFunc                     func(ctx context.Context, i *fastly.GetDigitalOceanInput) (*fastly.DigitalOcean, error)
+	GetDirectorFunc                         func(ctx context.Context, i *fastly.GetDirectorInput) (*fastly.Director, error)
+	GetDirectorBackendFunc                  func(ctx context.Context, i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error)
+	GetDomainFunc                           func(ctx context.Context, i *fastly.GetDomainInput) (*fastly.Domain, error)
+	GetDomainMetricsForServiceFunc          func(ctx context.Context, i *fastly.GetDomainMetricsInput) (*fastly.DomainInspector, error)
+	GetDomainMetricsForServiceJSONFunc      func(ctx context.Context, i *fastly.GetDomainMetricsInput, dst any) error
+	GetDynamicSnippetFunc                   func(ctx context.Context, i *fastly.GetDynamicSnippetInput) (*fastly.DynamicSnippet, error)
+	GetERLFunc                              func(ctx context.Context, i *fastly.GetERLInput) (*fastly.ERL, error)
+	GetElasticsearchFunc                    func(ctx context.Context, i *fastly.GetElasticsearchInput) (*fastly.Elasticsearch, error)
+	GetFTPFunc                              func(ctx context.Context, i *fastly.GetFTPInput) (*fastly.FTP, error)
+	GetGCSFunc                              func(ctx context.Context, i *fastly.GetGCSInput) (*fastly.GCS, error)
+	GetGeneratedVCLFunc                     func(ctx context.Context, i *fastly.GetGeneratedVCLInput) (*fastly.VCL, error)
+	GetGrafanaCloudLogsFunc                 func(ctx context.Context, i *fastly.GetGrafanaCloudLogsInput) (*fastly.GrafanaCloudLogs, error)
+	GetGzipFunc                             func(ctx context.Context, i *fastly.GetGzipInput) (*fastly.Gzip, error)
+	GetHTTP3Func                            func(ctx context.Context, i *fastly.GetHTTP3Input) (*fastly.HTTP3, error)
+	GetHTTPSFunc                            func(ctx context.Context, i *fastly.GetHTTPSInput) (*fastly.HTTPS, error)
+	GetHeaderFunc                           func(ctx context.Context, i *fastly.GetHeaderInput) (*fastly.Header, error)
+	GetHealthCheckFunc                      func(ctx context.Context, i *fastly.GetHealthCheckInput) (*fastly.HealthCheck, error)
+	GetHerokuFunc                           func(ctx context.Context, i *fastly.GetHerokuInput) (*fastly.Heroku, error)
+	GetHoneycombFunc                        func(ctx context.Context, i *fastly.GetHoneycombInput) (*fastly.Honeycomb, error)
+	GetImageOptimizerDefaultSettingsFunc    func(ctx context.Context, i *fastly.GetImageOptimizerDefaultSettingsInput) (*fastly.ImageOptimizerDefaultSettings, error)
+	GetIntegrationFunc                      func(ctx context.Context, i *fastly.GetIntegrationInput) (*fastly.Integration, error)
+	GetIntegrationTypesFunc                 func(ctx context.Context) (*[]fastly.IntegrationType, error)
+	GetJSONFunc                             func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	GetKVStoreFunc                          func(ctx context.Context, i *fastly.GetKVStoreInput) (*fastly.KVStore, error)
+	GetKVStoreItemFunc                      func(ctx context.Context, i *fastly.GetKVStoreItemInput) (fastly.GetKVStoreItemOutput, error)
+	GetKVStoreKeyFunc                       func(ctx context.Context, i *fastly.GetKVStoreKeyInput) (string, error)
+	GetKafkaFunc                            func(ctx context.Context, i *fastly.GetKafkaInput) (*fastly.Kafka, error)
+	GetKinesisFunc                          func(ctx context.Context, i *fastly.GetKinesisInput) (*fastly.Kinesis, error)
+	GetLogInsightsFunc                      func(ctx context.Context, i *fastly.GetLogInsightsInput) (*fastly.LogInsightsResponse, error)
+	GetLogRecordsFunc                       func(ctx context.Context, i *fastly.GetLogRecordsInput) (*fastly.LogRecordsResponse, error)
+	GetLogentriesFunc                       func(ctx context.Context, i *fastly.GetLogentriesInput) (*fastly.Logentries, error)
+	GetLoggingEndpointErrorsFunc            func(ctx context.Context, i *fastly.LoggingEndpointErrorsInput) (*fastly.LoggingEndpointErrorsResponse, error)
+	GetLogglyFunc                           func(ctx context.Context, i *fastly.GetLogglyInput) (*fastly.Loggly, error)
+	GetLogshuttleFunc                       func(ctx context.Context, i *fastly.GetLogshuttleInput) (*fastly.Logshuttle, error)
+	GetNewRelicFunc                         func(ctx context.Context, i *fastly.GetNewRelicInput) (*fastly.NewRelic, error)
+	GetNewRelicOTLPFunc                     func(ctx context.Context, i *fastly.GetNewRelicOTLPInput) (*fastly.NewRelicOTLP, error)
+	GetObservabilityCustomDashboardFunc     func(ctx context.Context, i *fastly.GetObservabilityCustomDashboardInput) (*fastly.ObservabilityCustomDashboard, error)
+	GetOpenstackFunc                        func(ctx context.Context, i *fastly.GetOpenstackInput) (*fastly.Openstack, error)
+	GetOriginMetricsForServiceFunc          func(ctx context.Context, i *fastly.GetOriginMetricsInput) (*fastly.OriginInspector, error)
+	GetOriginMetricsForServiceJSONFunc      func(ctx context.Context, i *fastly.GetOriginMetricsInput, dst any) error
+	GetPackageFunc                          func(ctx context.Context, i *fastly.GetPackageInput) (*fastly.Package, error)
+	GetPapertrailFunc                       func(ctx context.Context, i *fastly.GetPapertrailInput) (*fastly.Papertrail, error)
+	GetPoolFunc                             func(ctx context.Context, i *fastly.GetPoolInput) (*fastly.Pool, error)
+	GetPrivateKeyFunc                       func(ctx context.Context, i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error)
+	GetPubsubFunc                           func(ctx context.Context, i *fastly.GetPubsubInput) (*fastly.Pubsub, error)
+	GetRegionsFunc                          func(ctx context.Context) (*fastly.RegionsResponse, error)
+	GetRequestSettingFunc                   func(ctx context.Context, i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	GetResourceFunc                         func(ctx context.Context, i *fastly.GetResourceInput) (*fastly.Resource, error)
+	GetResponseObjectFunc                   func(ctx context.Context, i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	GetS3Func                               func(ctx context.Context, i *fastly.GetS3Input) (*fastly.S3, error)
+	GetSFTPFunc                             func(ctx context.Context, i *fastly.GetSFTPInput) (*fastly.SFTP, error)
+	GetScalyrFunc                           func(ctx context.Context, i *fastly.GetScalyrInput) (*fastly.Scalyr, error)
+	GetSecretFunc                           func(ctx context.Context, i *fastly.GetSecretInput) (*fastly.Secret, error)
+	GetSecretStoreFunc                      func(ctx context.Context, i *fastly.GetSecretStoreInput) (*fastly.SecretStore, error)
+	GetServerFunc                           func(ctx context.Context, i *fastly.GetServerInput) (*fastly.Server, error)
+	GetServiceFunc                          func(ctx context.Context, i *fastly.GetServiceInput) (*fastly.Service, error)
+	GetServiceAuthorizationFunc             func(ctx context.Context, i *fastly.GetServiceAuthorizationInput) (*fastly.ServiceAuthorization, error)
+	GetServiceConfigFunc                    func(ctx context.Context, i *fastly.GetServiceConfigInput) (*fastly.ServiceConfig, error)
+	GetServiceDetailsFunc                   func(ctx context.Context, i *fastly.GetServiceDetailsInput) (*fastly.ServiceDetail, error)
+	GetServicesFunc                         func(ctx context.Context, i *fastly.GetServicesInput) *fastly.ListPaginator[fastly.Service]
+	GetSettingsFunc                         func(ctx context.Context, i *fastly.GetSettingsInput) (*fastly.Settings, error)
+	GetSigningKeyFunc                       func(ctx context.Context) (ed25519.PublicKey, error)
+	GetSnippetFunc                          func(ctx context.Context, i *fastly.GetSnippetInput) (*fastly.Snippet, error)
+	GetSplunkFunc                           func(ctx context.Context, i *fastly.GetSplunkInput) (*fastly.Splunk, error)
+	GetStatsFunc                            func(ctx context.Context, i *fastly.GetStatsInput) (*fastly.StatsResponse, error)
+	GetStatsFieldFunc                       func(ctx context.Context, i *fastly.GetStatsInput) (*fastly.StatsFieldResponse, error)
+	GetStatsJSONFunc                        func(ctx context.Context, i *fastly.GetStatsInput, dst any) error
+	GetSumologicFunc                        func(ctx context.Context, i *fastly.GetSumologicInput) (*fastly.Sumologic, error)
+	GetSyslogFunc                           func(ctx context.Context, i *fastly.GetSyslogInput) (*fastly.Syslog, error)
+	GetTLSActivationFunc                    func(ctx context.Context, i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error)
+	GetTLSMutualAuthenticationFunc          func(ctx context.Context, i *fastly.GetTLSMutualAuthenticationInput) (*fastly.TLSMutualAuthentication, error)
+	GetTLSSubscriptionFunc                  func(ctx context.Context, i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	GetTokenSelfFunc                        func(ctx context.Context) (*fastly.Token, error)
+	GetUsageFunc                            func(ctx context.Context, i *fastly.GetUsageInput) (*fastly.UsageResponse, error)
+	GetUsageByServiceFunc                   func(ctx context.Context, i *fastly.GetUsageInput) (*fastly.UsageByServiceResponse, error)
+	GetUserFunc                             func(ctx context.Context, i *fastly.GetUserInput) (*fastly.User, error)
+	GetVCLFunc                              func(ctx context.Context, i *fastly.GetVCLInput) (*fastly.VCL, error)
+	GetVersionFunc                          func(ctx context.Context, i *fastly.GetVersionInput) (*fastly.Version, error)
+	GetVersionInventoryFunc                 func(ctx context.Context, i *fastly.GetVersionInventoryInput) (*fastly.VersionInventory, error)
+	GetWebhookSigningKeyFunc                func(ctx context.Context, i *fastly.GetWebhookSigningKeyInput) (*fastly.WebhookSigningKeyResponse, error)
+	HeadFunc                                func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	IPsFunc                                 func(ctx context.Context) (fastly.IPAddrs, error)
+	IPsV6Func                               func(ctx context.Context) (fastly.IPAddrs, error)
+	InsertKVStoreKeyFunc                    func(ctx context.Context, i *fastly.InsertKVStoreKeyInput) error
+	LatestVersionFunc                       func(ctx context.Context, i *fastly.LatestVersionInput) (*fastly.Version, error)
+	ListACLEntriesFunc                      func(ctx context.Context, i *fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error)
+	ListACLsFunc                            func(ctx context.Context, i *fastly.ListACLsInput) ([]*fastly.ACL, error)
+	ListAlertDefinitionsFunc                func(ctx context.Context, i *fastly.ListAlertDefinitionsInput) (*fastly.AlertDefinitionsResponse, error)
+	ListAlertHistoryFunc                    func(ctx context.Context, i *fastly.ListAlertHistoryInput) (*fastly.AlertHistoryResponse, error)
+	ListAutomationTokensFunc                func(ctx context.Context) ([]*fastly.AutomationToken, error)
+	ListBackendsFunc                        func(ctx context.Context, i *fastly.ListBackendsInput) ([]*fastly.Backend, error)
+	ListBigQueriesFunc                      func(ctx context.Context, i *fastly.ListBigQueriesInput) ([]*fastly.BigQuery, error)
+	ListBlobStoragesFunc                    func(ctx context.Context, i *fastly.ListBlobStoragesInput) ([]*fastly.BlobStorage, error)
+	ListBulkCertificatesFunc                func(ctx context.Context, i *fastly.ListBulkCertificatesInput) ([]*fastly.BulkCertificate, error)
+	ListCacheSettingsFunc                   func(ctx context.Context, i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	ListCloudfilesFunc                      func(ctx context.Context, i *fastly.ListCloudfilesInput) ([]*fastly.Cloudfiles, error)
+	ListConditionsFunc                      func(ctx context.Context, i *fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	ListConfigStoreItemsFunc                func(ctx context.Context, i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error)
+	ListConfigStoreServicesFunc             func(ctx context.Context, i *fastly.ListConfigStoreServicesInput) ([]*fastly.Service, error)
+	ListConfigStoresFunc                    func(ctx context.Context, i *fastly.ListConfigStoresInput) ([]*fastly.ConfigStore, error)
+	ListCustomTLSCertificatesFunc           func(ctx context.Context, i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error)
+	ListCustomTLSConfigurationsFunc         func(ctx context.Context, i *fastly.ListCustomTLSConfigurationsInput) ([]*fastly.CustomTLSConfiguration, error)
+	ListCustomerTokensFunc                  func(ctx context.Context, i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error)
+	ListCustomerUsersFunc                   func(ctx context.Context, i *fastly.ListCustomerUsersInput) ([]*fastly.User, error)
+	ListDatadogFunc                         func(ctx context.Context, i *fastly.ListDatadogInput) ([]*fastly.Datadog, error)
+	ListDictionariesFunc                    func(ctx context.Context, i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error)
+	ListDictionaryItemsFunc                 func(ctx context.Context, i *fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error)
+	ListDigitalOceansFunc                   func(ctx context.Context, i *fastly.ListDigitalOceansInput) ([]*fastly.DigitalOcean, error)
+	ListDirectorsFunc                       func(ctx context.Context, i *fastly.ListDirectorsInput) ([]*fastly.Director, error)
+	ListDomainsFunc                         func(ctx context.Context, i *fastly.ListDomainsInput) ([]*fastly.Domain, error)
+	ListERLsFunc                            func(ctx context.Context, i *fastly.ListERLsInput) ([]*fastly.ERL, error)
+	ListElasticsearchFunc                   func(ctx context.Context, i *fastly.ListElasticsearchInput) ([]*fastly.Elasticsearch, error)
+	ListFTPsFunc                            func(ctx context.Context, i *fastly.ListFTPsInput) ([]*fastly.FTP, error)
+	ListGCSsFunc                            func(ctx context.Context, i *fastly.ListGCSsInput) ([]*fastly.GCS, error)
+	ListGrafanaCloudLogsFunc                func(ctx context.Context, i *fastly.ListGrafanaCloudLogsInput) ([]*fastly.GrafanaCloudLogs, error)
+	ListGzipsFunc                           func(ctx context.Context, i *fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	ListHTTPSFunc                           func(ctx context.Context, i *fastly.ListHTTPSInput) ([]*fastly.HTTPS, error)
+	ListHeadersFunc                         func(ctx context.Context, i *fastly.ListHeadersInput) ([]*fastly.Header, error)
+	ListHealthChecksFunc                    func(ctx context.Context, i *fastly.ListHealthChecksInput) ([]*fastly.HealthCheck, error)
+	ListHerokusFunc                         func(ctx context.Context, i *fastly.ListHerokusInput) ([]*fastly.Heroku, error)
+	ListHoneycombsFunc                      func(ctx context.Context, i *fastly.ListHoneycombsInput) ([]*fastly.Honeycomb, error)
+	ListKVStoreKeysFunc                     func(ctx context.Context, i *fastly.ListKVStoreKeysInput) (*fastly.ListKVStoreKeysResponse, error)
+	ListKVStoresFunc                        func(ctx context.Context, i *fastly.ListKVStoresInput) (*fastly.ListKVStoresResponse, error)
+	ListKafkasFunc                          func(ctx context.Context, i *fastly.ListKafkasInput) ([]*fastly.Kafka, error)
+	ListKinesisFunc                         func(ctx context.Context, i *fastly.ListKinesisInput) ([]*fastly.Kinesis, error)
+	ListLogentriesFunc                      func(ctx context.Context, i *fastly.ListLogentriesInput) ([]*fastly.Logentries, error)
+	ListLogglyFunc                          func(ctx context.Context, i *fastly.ListLogglyInput) ([]*fastly.Loggly, error)
+	ListLogshuttlesFunc                     func(ctx context.Context, i *fastly.ListLogshuttlesInput) ([]*fastly.Logshuttle, error)
+	ListNewRelicFunc                        func(ctx context.Context, i *fastly.ListNewRelicInput) ([]*fastly.NewRelic, error)
+	ListNewRelicOTLPFunc                    func(ctx context.Context, i *fastly.ListNewRelicOTLPInput) ([]*fastly.NewRelicOTLP, error)
+	ListObservabilityCustomDashboardsFunc   func(ctx context.Context, i *fastly.ListObservabilityCustomDashboardsInput) (*fastly.ListDashboardsResponse, error)
+	ListOpenstackFunc                       func(ctx context.Context, i *fastly.ListOpenstackInput) ([]*fastly.Openstack, error)
+	ListPapertrailsFunc                     func(ctx context.Context, i *fastly.ListPapertrailsInput) ([]*fastly.Papertrail, error)
+	ListPoolsFunc                           func(ctx context.Context, i *fastly.ListPoolsInput) ([]*fastly.Pool, error)
+	ListPrivateKeysFunc                     func(ctx context.Context, i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error)
+	ListPubsubsFunc                         func(ctx context.Context, i *fastly.ListPubsubsInput) ([]*fastly.Pubsub, error)
+	ListRequestSettingsFunc                 func(ctx context.Context, i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	ListResourcesFunc                       func(ctx context.Context, i *fastly.ListResourcesInput) ([]*fastly.Resource, error)
+	ListResponseObjectsFunc                 func(ctx context.Context, i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	ListS3sFunc                             func(ctx context.Context, i *fastly.ListS3sInput) ([]*fastly.S3, error)
+	ListSFTPsFunc                           func(ctx context.Context, i *fastly.ListSFTPsInput) ([]*fastly.SFTP, error)
+	ListScalyrsFunc                         func(ctx context.Context, i *fastly.ListScalyrsInput) ([]*fastly.Scalyr, error)
+	ListSecretStoresFunc                    func(ctx context.Context, i *fastly.ListSecretStoresInput) (*fastly.SecretStores, error)
+	ListSecretsFunc                         func(ctx context.Context, i *fastly.ListSecretsInput) (*fastly.Secrets, error)
+	ListServersFunc                         func(ctx context.Context, i *fastly.ListServersInput) ([]*fastly.Server, error)
+	ListServiceAuthorizationsFunc           func(ctx context.Context, i *fastly.ListServiceAuthorizationsInput) (*fastly.ServiceAuthorizations, error)
+	ListServiceDomainsFunc                  func(ctx context.Context, i *fastly.ListServiceDomainInput) (fastly.ServiceDomainsList, error)
+	ListServicesFunc                        func(ctx context.Context, i *fastly.ListServicesInput) ([]*fastly.Service, error)
+	ListSnippetsFunc                        func(ctx context.Context, i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error)
+	ListSplunksFunc                         func(ctx context.Context, i *fastly.ListSplunksInput) ([]*fastly.Splunk, error)
+	ListSumologicsFunc                      func(ctx context.Context, i *fastly.ListSumologicsInput) ([]*fastly.Sumologic, error)
+	ListSyslogsFunc                         func(ctx context.Context, i *fastly.ListSyslogsInput) ([]*fastly.Syslog, error)
+	ListTLSActivationsFunc                  func(ctx context.Context, i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error)
+	ListTLSDomainsFunc                      func(ctx context.Context, i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error)
+	ListTLSMutualAuthenticationFunc         func(ctx context.Context, i *fastly.ListTLSMutualAuthenticationsInput) ([]*fastly.TLSMutualAuthentication, error)
+	ListTLSSubscriptionsFunc                func(ctx context.Context, i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error)
+	ListTokensFunc                          func(ctx context.Context, p1_0 *fastly.ListTokensInput) ([]*fastly.Token, error)
+	ListVCLsFunc                            func(ctx context.Context, i *fastly.ListVCLsInput) ([]*fastly.VCL, error)
+	ListVersionsFunc                        func(ctx context.Context, i *fastly.ListVersionsInput) ([]*fastly.Version, error)
+	LockVersionFunc                         func(ctx context.Context, i *fastly.LockVersionInput) (*fastly.Version, error)
+	NewListKVStoreKeysPaginatorFunc         func(ctx context.Context, i *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries
+	NewListKVStoresPaginatorFunc            func(ctx context.Context, i *fastly.ListKVStoresInput) *fastly.ListKVStoresPaginator
+	PatchFunc                               func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	PatchFormFunc                           func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PatchJSONFunc                           func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PatchJSONAPIFunc                        func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PlanServiceConfigFunc                   func(ctx context.Context, i *fastly.PlanServiceConfigInput) (*fastly.ServiceConfigPlan, error)
+	PostFunc                                func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	PostFormFunc                            func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PostJSONFunc                            func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PostJSONAPIFunc                         func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PostJSONAPIBulkFunc                     func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PurgeFunc                               func(ctx context.Context, i *fastly.PurgeInput) (*fastly.Purge, error)
+	PurgeAllFunc                            func(ctx context.Context, i *fastly.PurgeAllInput) (*fastly.Purge, error)
+	PurgeKeyFunc                            func(ctx context.Context, i *fastly.PurgeKeyInput) (*fastly.Purge, error)
+	PurgeKeysFunc                           func(ctx context.Context, i *fastly.PurgeKeysInput) (map[string]string, error)
+	PutFunc                                 func(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error)
+	PutFormFunc                             func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PutFormFileFunc                         func(ctx context.Context, urlPath string, filePath string, fieldName string, ro fastly.RequestOptions) (*http.Response, error)
+	PutFormFileFromReaderFunc               func(ctx context.Context, urlPath string, fileName string, fileBytes io.Reader, fieldName string, ro fastly.RequestOptions) (*http.Response, error)
+	PutJSONFunc                             func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	PutJSONAPIFunc                          func(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	RateLimitRemainingFunc                  func() int
+	RateLimitResetFunc                      func() time.Time
+	RawRequestFunc                          func(ctx context.Context, verb string, p string, ro fastly.RequestOptions) (*http.Request, error)
+	RequestFunc                             func(ctx context.Context, verb string, p string, ro fastly.RequestOptions) (*http.Response, error)
+	RequestFormFunc                         func(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	RequestFormFileFunc                     func(ctx context.Context, verb string, urlPath string, filePath string, fieldName string, ro fastly.RequestOptions) (*http.Response, error)
+	RequestFormFileFromReaderFunc           func(ctx context.Context, verb string, urlPath string, fileName string, fileBytes io.Reader, fieldName string, ro fastly.RequestOptions) (*http.Response, error)
+	RequestJSONFunc                         func(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	RequestJSONAPIFunc                      func(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	RequestJSONAPIBulkFunc                  func(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error)
+	ResetUserPasswordFunc                   func(ctx context.Context, i *fastly.ResetUserPasswordInput) error
+	RestoreServiceVersionFunc               func(ctx context.Context, i *fastly.RestoreServiceVersionInput) (*fastly.Version, error)
+	ReuseOrCloneVersionFunc                 func(ctx context.Context, i *fastly.ReuseOrCloneVersionInput) (*fastly.Version, error)
+	RotateWebhookSigningKeyFunc             func(ctx context.Context, i *fastly.RotateWebhookSigningKeyInput) (*fastly.WebhookSigningKeyResponse, error)
+	SearchIntegrationsFunc                  func(ctx context.Context, i *fastly.SearchIntegrationsInput) (*fastly.SearchIntegrationsResponse, error)
+	SearchServiceFunc                       func(ctx context.Context, i *fastly.SearchServiceInput) (*fastly.Service, error)
+	SimpleGetFunc                           func(ctx context.Context, target string) (*http.Response, error)
+	TestAlertDefinitionFunc                 func(ctx context.Context, i *fastly.TestAlertDefinitionInput) error
+	TokenExpiresWithinFunc                  func(ctx context.Context, d time.Duration) (bool, *fastly.Token, error)
+	UpdateACLFunc                           func(ctx context.Context, i *fastly.UpdateACLInput) (*fastly.ACL, error)
+	UpdateACLEntryFunc                      func(ctx context.Context, i *fastly.UpdateACLEntryInput) (*fastly.ACLEntry, error)
+	UpdateAlertDefinitionFunc               func(ctx context.Context, i *fastly.UpdateAlertDefinitionInput) (*fastly.AlertDefinition, error)
+	UpdateBackendFunc                       func(ctx context.Context, i *fastly.UpdateBackendInput) (*fastly.Backend, error)
+	UpdateBigQueryFunc                      func(ctx context.Context, i *fastly.UpdateBigQueryInput) (*fastly.BigQuery, error)
+	UpdateBlobStorageFunc                   func(ctx context.Context, i *fastly.UpdateBlobStorageInput) (*fastly.BlobStorage, error)
+	UpdateBulkCertificateFunc               func(ctx context.Context, i *fastly.UpdateBulkCertificateInput) (*fastly.BulkCertificate, error)
+	UpdateCacheSettingFunc                  func(ctx context.Context, i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCloudfilesFunc                    func(ctx context.Context, i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error)
+	UpdateConditionFunc                     func(ctx context.Context, i *fastly.UpdateConditionInput) (*fastly.Condition, error)
+	UpdateConfigStoreFunc                   func(ctx context.Context, i *fastly.UpdateConfigStoreInput) (*fastly.ConfigStore, error)
+	UpdateConfigStoreItemFunc               func(ctx context.Context, i *fastly.UpdateConfigStoreItemInput) (*fastly.ConfigStoreItem, error)
+	UpdateCustomTLSCertificateFunc          func(ctx context.Context, i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	UpdateCustomTLSConfigurationFunc        func(ctx context.Context, i *fastly.UpdateCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error)
+	UpdateDatadogFunc                       func(ctx context.Context, i *fastly.UpdateDatadogInput) (*fastly.Datadog, error)
+	UpdateDictionaryFunc                    func(ctx context.Context, i *fastly.UpdateDictionaryInput) (*fastly.Dictionary, error)
+	UpdateDictionaryItemFunc                func(ctx context.Context, i *fastly.UpdateDictionaryItemInput) (*fastly.DictionaryItem, error)
+	UpdateDigitalOceanFunc                  func(ctx context.Context, i *fastly.UpdateDigitalOceanInput) (*fastly.DigitalOcean, error)
+	UpdateDirectorFunc                      func(ctx context.Context, i *fastly.UpdateDirectorInput) (*fastly.Director, error)
+	UpdateDomainFunc                        func(ctx context.Context, i *fastly.UpdateDomainInput) (*fastly.Domain, error)
+	UpdateDynamicSnippetFunc                func(ctx context.Context, i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error)
+	UpdateERLFunc                           func(ctx context.Context, i *fastly.UpdateERLInput) (*fastly.ERL, error)
+	UpdateElasticsearchFunc                 func(ctx context.Context, i *fastly.UpdateElasticsearchInput) (*fastly.Elasticsearch, error)
+	UpdateFTPFunc                           func(ctx context.Context, i *fastly.UpdateFTPInput) (*fastly.FTP, error)
+	UpdateGCSFunc                           func(ctx context.Context, i *fastly.UpdateGCSInput) (*fastly.GCS, error)
+	UpdateGrafanaCloudLogsFunc              func(ctx context.Context, i *fastly.UpdateGrafanaCloudLogsInput) (*fastly.GrafanaCloudLogs, error)
+	UpdateGzipFunc                          func(ctx context.Context, i *fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	UpdateHTTPSFunc                         func(ctx context.Context, i *fastly.UpdateHTTPSInput) (*fastly.HTTPS, error)
+	UpdateHeaderFunc                        func(ctx context.Context, i *fastly.UpdateHeaderInput) (*fastly.Header, error)
+	UpdateHealthCheckFunc                   func(ctx context.Context, i *fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
+	UpdateHerokuFunc                        func(ctx context.Context, i *fastly.UpdateHerokuInput) (*fastly.Heroku, error)
+	UpdateHoneycombFunc                     func(ctx context.Context, i *fastly.UpdateHoneycombInput) (*fastly.Honeycomb, error)
+	UpdateImageOptimizerDefaultSettingsFunc func(ctx context.Context, i *fastly.UpdateImageOptimizerDefaultSettingsInput) (*fastly.ImageOptimizerDefaultSettings, error)
+	UpdateIntegrationFunc                   func(ctx context.Context, i *fastly.UpdateIntegrationInput) error
+	UpdateKafkaFunc                         func(ctx context.Context, i *fastly.UpdateKafkaInput) (*fastly.Kafka, error)
+	UpdateKinesisFunc                       func(ctx context.Context, i *fastly.UpdateKinesisInput) (*fastly.Kinesis, error)
+	UpdateLogentriesFunc                    func(ctx context.Context, i *fastly.UpdateLogentriesInput) (*fastly.Logentries, error)
+	UpdateLogglyFunc                        func(ctx context.Context, i *fastly.UpdateLogglyInput) (*fastly.Loggly, error)
+	UpdateLogshuttleFunc                    func(ctx context.Context, i *fastly.UpdateLogshuttleInput) (*fastly.Logshuttle, error)
+	UpdateNewRelicFunc                      func(ctx context.Context, i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error)
+	UpdateNewRelicOTLPFunc                  func(ctx context.Context, i *fastly.UpdateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error)
+	UpdateObservabilityCustomDashboardFunc  func(ctx context.Context, i *fastly.UpdateObservabilityCustomDashboardInput) (*fastly.ObservabilityCustomDashboard, error)
+	UpdateOpenstackFunc                     func(ctx context.Context, i *fastly.UpdateOpenstackInput) (*fastly.Openstack, error)
+	UpdatePackageFunc                       func(ctx context.Context, i *fastly.UpdatePackageInput) (*fastly.Package, error)
+	UpdatePapertrailFunc                    func(ctx context.Context, i *fastly.UpdatePapertrailInput) (*fastly.Papertrail, error)
+	UpdatePoolFunc                          func(ctx context.Context, i *fastly.UpdatePoolInput) (*fastly.Pool, error)
+	UpdatePubsubFunc                        func(ctx context.Context, i *fastly.UpdatePubsubInput) (*fastly.Pubsub, error)
+	UpdateRequestSettingFunc                func(ctx context.Context, i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateResourceFunc                      func(ctx context.Context, i *fastly.UpdateResourceInput) (*fastly.Resource, error)
+	UpdateResponseObjectFunc                func(ctx context.Context, i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateS3Func                            func(ctx context.Context, i *fastly.UpdateS3Input) (*fastly.S3, error)
+	UpdateSFTPFunc                          func(ctx context.Context, i *fastly.UpdateSFTPInput) (*fastly.SFTP, error)
+	UpdateScalyrFunc                        func(ctx context.Context, i *fastly.UpdateScalyrInput) (*fastly.Scalyr, error)
+	UpdateServerFunc                        func(ctx context.Context, i *fastly.UpdateServerInput) (*fastly.Server, error)
+	UpdateServiceFunc                       func(ctx context.Context, i *fastly.UpdateServiceInput) (*fastly.Service, error)
+	UpdateServiceAuthorizationFunc          func(ctx context.Context, i *fastly.UpdateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error)
+	UpdateSettingsFunc                      func(ctx context.Context, i *fastly.UpdateSettingsInput) (*fastly.Settings, error)
+	UpdateSnippetFunc                       func(ctx context.Context, i *fastly.UpdateSnippetInput) (*fastly.Snippet, error)
+	UpdateSplunkFunc                        func(ctx context.Context, i *fastly.UpdateSplunkInput) (*fastly.Splunk, error)
+	UpdateSumologicFunc                     func(ctx context.Context, i *fastly.UpdateSumologicInput) (*fastly.Sumologic, error)
+	UpdateSyslogFunc                        func(ctx context.Context, i *fastly.UpdateSyslogInput) (*fastly.Syslog, error)
+	UpdateTLSActivationFunc                 func(ctx context.Context, i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error)
+	UpdateTLSMutualAuthenticationFunc       func(ctx context.Context, i *fastly.UpdateTLSMutualAuthenticationInput) (*fastly.TLSMutualAuthentication, error)
+	UpdateTLSSubscriptionFunc               func(ctx context.Context, i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	UpdateUserFunc                          func(ctx context.Context, i *fastly.UpdateUserInput) (*fastly.User, error)
+	UpdateVCLFunc                           func(ctx context.Context, i *fastly.UpdateVCLInput) (*fastly.VCL, error)
+	UpdateVersionFunc                       func(ctx context.Context, i *fastly.UpdateVersionInput) (*fastly.Version, error)
+	UseFunc                                 func(middleware ...fastly.Middleware)
+	UserAgentFunc                           func() string
+	ValidateAllDomainsFunc                  func(ctx context.Context, i *fastly.ValidateAllDomainsInput) ([]*fastly.DomainValidationResult, error)
+	ValidateDomainFunc                      func(ctx context.Context, i *fastly.ValidateDomainInput) (*fastly.DomainValidationResult, error)
+	ValidateVersionFunc                     func(ctx context.Context, i *fastly.ValidateVersionInput) (bool, string, error)
+}
+
+var _ fastly.API = (*MockAPI)(nil)
+
+// ActivateVCL calls ActivateVCLFunc.
+func (mock *MockAPI) ActivateVCL(ctx context.Context, i *fastly.ActivateVCLInput) (*fastly.VCL, error) {
+	if mock.ActivateVCLFunc == nil {
+		panic("fastlytest: MockAPI.ActivateVCL called but ActivateVCLFunc is nil")
+	}
+	return mock.ActivateVCLFunc(ctx, i)
+}
+
+// ActivateVersion calls ActivateVersionFunc.
+func (mock *MockAPI) ActivateVersion(ctx context.Context, i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+	if mock.ActivateVersionFunc == nil {
+		panic("fastlytest: MockAPI.ActivateVersion called but ActivateVersionFunc is nil")
+	}
+	return mock.ActivateVersionFunc(ctx, i)
+}
+
+// AllAPIEvents calls AllAPIEventsFunc.
+func (mock *MockAPI) AllAPIEvents(ctx context.Context, i *fastly.GetAPIEventsFilterInput) iter.Seq2[*fastly.Event, error] {
+	if mock.AllAPIEventsFunc == nil {
+		panic("fastlytest: MockAPI.AllAPIEvents called but AllAPIEventsFunc is nil")
+	}
+	return mock.AllAPIEventsFunc(ctx, i)
+}
+
+// AllAlertDefinitions calls AllAlertDefinitionsFunc.
+func (mock *MockAPI) AllAlertDefinitions(ctx context.Context, i *fastly.ListAlertDefinitionsInput) iter.Seq2[*fastly.AlertDefinition, error] {
+	if mock.AllAlertDefinitionsFunc == nil {
+		panic("fastlytest: MockAPI.AllAlertDefinitions called but AllAlertDefinitionsFunc is nil")
+	}
+	return mock.AllAlertDefinitionsFunc(ctx, i)
+}
+
+// AllAlertHistory calls AllAlertHistoryFunc.
+func (mock *MockAPI) AllAlertHistory(ctx context.Context, i *fastly.ListAlertHistoryInput) iter.Seq2[*fastly.AlertHistory, error] {
+	if mock.AllAlertHistoryFunc == nil {
+		panic("fastlytest: MockAPI.AllAlertHistory called but AllAlertHistoryFunc is nil")
+	}
+	return mock.AllAlertHistoryFunc(ctx, i)
+}
+
+// AllDatacenters calls AllDatacentersFunc.
+func (mock *MockAPI) AllDatacenters(ctx context.Context) ([]fastly.Datacenter, error) {
+	if mock.AllDatacentersFunc == nil {
+		panic("fastlytest: MockAPI.AllDatacenters called but AllDatacentersFunc is nil")
+	}
+	return mock.AllDatacentersFunc(ctx)
+}
+
+// AllIPs calls AllIPsFunc.
+func (mock *MockAPI) AllIPs(ctx context.Context) (fastly.IPAddrs, fastly.IPAddrs, error) {
+	if mock.AllIPsFunc == nil {
+		panic("fastlytest: MockAPI.AllIPs called but AllIPsFunc is nil")
+	}
+	return mock.AllIPsFunc(ctx)
+}
+
+// AllKVStoreKeys calls AllKVStoreKeysFunc.
+func (mock *MockAPI) AllKVStoreKeys(ctx context.Context, i *fastly.ListKVStoreKeysInput) iter.Seq2[string, error] {
+	if mock.AllKVStoreKeysFunc == nil {
+		panic("fastlytest: MockAPI.AllKVStoreKeys called but AllKVStoreKeysFunc is nil")
+	}
+	return mock.AllKVStoreKeysFunc(ctx, i)
+}
+
+// AllKVStores calls AllKVStoresFunc.
+func (mock *MockAPI) AllKVStores(ctx context.Context, i *fastly.ListKVStoresInput) iter.Seq2[*fastly.KVStore, error] {
+	if mock.AllKVStoresFunc == nil {
+		panic("fastlytest: MockAPI.AllKVStores called but AllKVStoresFunc is nil")
+	}
+	return mock.AllKVStoresFunc(ctx, i)
+}
+
+// AllServices calls AllServicesFunc.
+func (mock *MockAPI) AllServices(ctx context.Context) iter.Seq2[*fastly.Service, error] {
+	if mock.AllServicesFunc == nil {
+		panic("fastlytest: MockAPI.AllServices called but AllServicesFunc is nil")
+	}
+	return mock.AllServicesFunc(ctx)
+}
+
+// ApplyServiceConfig calls ApplyServiceConfigFunc.
+func (mock *MockAPI) ApplyServiceConfig(ctx context.Context, i *fastly.ApplyServiceConfigInput) (*fastly.Version, error) {
+	if mock.ApplyServiceConfigFunc == nil {
+		panic("fastlytest: MockAPI.ApplyServiceConfig called but ApplyServiceConfigFunc is nil")
+	}
+	return mock.ApplyServiceConfigFunc(ctx, i)
+}
+
+// BatchDeleteTokens calls BatchDeleteTokensFunc.
+func (mock *MockAPI) BatchDeleteTokens(ctx context.Context, i *fastly.BatchDeleteTokensInput) error {
+	if mock.BatchDeleteTokensFunc == nil {
+		panic("fastlytest: MockAPI.BatchDeleteTokens called but BatchDeleteTokensFunc is nil")
+	}
+	return mock.BatchDeleteTokensFunc(ctx, i)
+}
+
+// BatchModifyACLEntries calls BatchModifyACLEntriesFunc.
+func (mock *MockAPI) BatchModifyACLEntries(ctx context.Context, i *fastly.BatchModifyACLEntriesInput) error {
+	if mock.BatchModifyACLEntriesFunc == nil {
+		panic("fastlytest: MockAPI.BatchModifyACLEntries called but BatchModifyACLEntriesFunc is nil")
+	}
+	return mock.BatchModifyACLEntriesFunc(ctx, i)
+}
+
+// BatchModifyConfigStoreItems calls BatchModifyConfigStoreItemsFunc.
+func (mock *MockAPI) BatchModifyConfigStoreItems(ctx context.Context, i *fastly.BatchModifyConfigStoreItemsInput) error {
+	if mock.BatchModifyConfigStoreItemsFunc == nil {
+		panic("fastlytest: MockAPI.BatchModifyConfigStoreItems called but BatchModifyConfigStoreItemsFunc is nil")
+	}
+	return mock.BatchModifyConfigStoreItemsFunc(ctx, i)
+}
+
+// BatchModifyDictionaryItems calls BatchModifyDictionaryItemsFunc.
+func (mock *MockAPI) BatchModifyDictionaryItems(ctx context.Context, i *fastly.BatchModifyDictionaryItemsInput) error {
+	if mock.BatchModifyDictionaryItemsFunc == nil {
+		panic("fastlytest: MockAPI.BatchModifyDictionaryItems called but BatchModifyDictionaryItemsFunc is nil")
+	}
+	return mock.BatchModifyDictionaryItemsFunc(ctx, i)
+}
+
+// BatchModifyKVStoreKey calls BatchModifyKVStoreKeyFunc.
+func (mock *MockAPI) BatchModifyKVStoreKey(ctx context.Context, i *fastly.BatchModifyKVStoreKeyInput) error {
+	if mock.BatchModifyKVStoreKeyFunc == nil {
+		panic("fastlytest: MockAPI.BatchModifyKVStoreKey called but BatchModifyKVStoreKeyFunc is nil")
+	}
+	return mock.BatchModifyKVStoreKeyFunc(ctx, i)
+}
+
+// CheckVersionReferences calls CheckVersionReferencesFunc.
+func (mock *MockAPI) CheckVersionReferences(ctx context.Context, i *fastly.CheckVersionReferencesInput) (*fastly.ReferenceReport, error) {
+	if mock.CheckVersionReferencesFunc == nil {
+		panic("fastlytest: MockAPI.CheckVersionReferences called but CheckVersionReferencesFunc is nil")
+	}
+	return mock.CheckVersionReferencesFunc(ctx, i)
+}
+
+// CleanupStaleDrafts calls CleanupStaleDraftsFunc.
+func (mock *MockAPI) CleanupStaleDrafts(ctx context.Context, i *fastly.CleanupStaleDraftsInput) ([]*fastly.Version, error) {
+	if mock.CleanupStaleDraftsFunc == nil {
+		panic("fastlytest: MockAPI.CleanupStaleDrafts called but CleanupStaleDraftsFunc is nil")
+	}
+	return mock.CleanupStaleDraftsFunc(ctx, i)
+}
+
+// CloneVersion calls CloneVersionFunc.
+func (mock *MockAPI) CloneVersion(ctx context.Context, i *fastly.CloneVersionInput) (*fastly.Version, error) {
+	if mock.CloneVersionFunc == nil {
+		panic("fastlytest: MockAPI.CloneVersion called but CloneVersionFunc is nil")
+	}
+	return mock.CloneVersionFunc(ctx, i)
+}
+
+// CopyServiceConfig calls CopyServiceConfigFunc.
+func (mock *MockAPI) CopyServiceConfig(ctx context.Context, i *fastly.CopyServiceConfigInput) (*fastly.CopyServiceConfigResult, error) {
+	if mock.CopyServiceConfigFunc == nil {
+		panic("fastlytest: MockAPI.CopyServiceConfig called but CopyServiceConfigFunc is nil")
+	}
+	return mock.CopyServiceConfigFunc(ctx, i)
+}
+
+// CreateACL calls CreateACLFunc.
+func (mock *MockAPI) CreateACL(ctx context.Context, i *fastly.CreateACLInput) (*fastly.ACL, error) {
+	if mock.CreateACLFunc == nil {
+		panic("fastlytest: MockAPI.CreateACL called but CreateACLFunc is nil")
+	}
+	return mock.CreateACLFunc(ctx, i)
+}
+
+// CreateACLEntry calls CreateACLEntryFunc.
+func (mock *MockAPI) CreateACLEntry(ctx context.Context, i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error) {
+	if mock.CreateACLEntryFunc == nil {
+		panic("fastlytest: MockAPI.CreateACLEntry called but CreateACLEntryFunc is nil")
+	}
+	return mock.CreateACLEntryFunc(ctx, i)
+}
+
+// CreateAlertDefinition calls CreateAlertDefinitionFunc.
+func (mock *MockAPI) CreateAlertDefinition(ctx context.Context, i *fastly.CreateAlertDefinitionInput) (*fastly.AlertDefinition, error) {
+	if mock.CreateAlertDefinitionFunc == nil {
+		panic("fastlytest: MockAPI.CreateAlertDefinition called but CreateAlertDefinitionFunc is nil")
+	}
+	return mock.CreateAlertDefinitionFunc(ctx, i)
+}
+
+// CreateAutomationToken calls CreateAutomationTokenFunc.
+func (mock *MockAPI) CreateAutomationToken(ctx context.Context, i *fastly.CreateAutomationTokenInput) (*fastly.AutomationToken, error) {
+	if mock.CreateAutomationTokenFunc == nil {
+		panic("fastlytest: MockAPI.CreateAutomationToken called but CreateAutomationTokenFunc is nil")
+	}
+	return mock.CreateAutomationTokenFunc(ctx, i)
+}
+
+// CreateBackend calls CreateBackendFunc.
+func (mock *MockAPI) CreateBackend(ctx context.Context, i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+	if mock.CreateBackendFunc == nil {
+		panic("fastlytest: MockAPI.CreateBackend called but CreateBackendFunc is nil")
+	}
+	return mock.CreateBackendFunc(ctx, i)
+}
+
+// CreateBigQuery calls CreateBigQueryFunc.
+func (mock *MockAPI) CreateBigQuery(ctx context.Context, i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error) {
+	if mock.CreateBigQueryFunc == nil {
+		panic("fastlytest: MockAPI.CreateBigQuery called but CreateBigQueryFunc is nil")
+	}
+	return mock.CreateBigQueryFunc(ctx, i)
+}
+
+// CreateBlobStorage calls CreateBlobStorageFunc.
+func (mock *MockAPI) CreateBlobStorage(ctx context.Context, i *fastly.CreateBlobStorageInput) (*fastly.BlobStorage, error) {
+	if mock.CreateBlobStorageFunc == nil {
+		panic("fastlytest: MockAPI.CreateBlobStorage called but CreateBlobStorageFunc is nil")
+	}
+	return mock.CreateBlobStorageFunc(ctx, i)
+}
+
+// CreateBulkCertificate calls CreateBulkCertificateFunc.
+func (mock *MockAPI) CreateBulkCertificate(ctx context.Context, i *fastly.CreateBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	if mock.CreateBulkCertificateFunc == nil {
+		panic("fastlytest: MockAPI.CreateBulkCertificate called but CreateBulkCertificateFunc is nil")
+	}
+	return mock.CreateBulkCertificateFunc(ctx, i)
+}
+
+// CreateCacheSetting calls CreateCacheSettingFunc.
+func (mock *MockAPI) CreateCacheSetting(ctx context.Context, i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	if mock.CreateCacheSettingFunc == nil {
+		panic("fastlytest: MockAPI.CreateCacheSetting called but CreateCacheSettingFunc is nil")
+	}
+	return mock.CreateCacheSettingFunc(ctx, i)
+}
+
+// CreateClientKey calls CreateClientKeyFunc.
+func (mock *MockAPI) CreateClientKey(ctx context.Context) (*fastly.ClientKey, error) {
+	if mock.CreateClientKeyFunc == nil {
+		panic("fastlytest: MockAPI.CreateClientKey called but CreateClientKeyFunc is nil")
+	}
+	return mock.CreateClientKeyFunc(ctx)
+}
+
+// CreateCloudfiles calls CreateCloudfilesFunc.
+func (mock *MockAPI) CreateCloudfiles(ctx context.Context, i *fastly.CreateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	if mock.CreateCloudfilesFunc == nil {
+		panic("fastlytest: MockAPI.CreateCloudfiles called but CreateCloudfilesFunc is nil")
+	}
+	return mock.CreateCloudfilesFunc(ctx, i)
+}
+
+// CreateCondition calls CreateConditionFunc.
+func (mock *MockAPI) CreateCondition(ctx context.Context, i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	if mock.CreateConditionFunc == nil {
+		panic("fastlytest: MockAPI.CreateCondition called but CreateConditionFunc is nil")
+	}
+	return mock.CreateConditionFunc(ctx, i)
+}
+
+// CreateConfigStore calls CreateConfigStoreFunc.
+func (mock *MockAPI) CreateConfigStore(ctx context.Context, i *fastly.CreateConfigStoreInput) (*fastly.ConfigStore, error) {
+	if mock.CreateConfigStoreFunc == nil {
+		panic("fastlytest: MockAPI.CreateConfigStore called but CreateConfigStoreFunc is nil")
+	}
+	return mock.CreateConfigStoreFunc(ctx, i)
+}
+
+// CreateConfigStoreItem calls CreateConfigStoreItemFunc.
+func (mock *MockAPI) CreateConfigStoreItem(ctx context.Context, i *fastly.CreateConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	if mock.CreateConfigStoreItemFunc == nil {
+		panic("fastlytest: MockAPI.CreateConfigStoreItem called but CreateConfigStoreItemFunc is nil")
+	}
+	return mock.CreateConfigStoreItemFunc(ctx, i)
+}
+
+// CreateCustomTLSCertificate calls CreateCustomTLSCertificateFunc.
+func (mock *MockAPI) CreateCustomTLSCertificate(ctx context.Context, i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	if mock.CreateCustomTLSCertificateFunc == nil {
+		panic("fastlytest: MockAPI.CreateCustomTLSCertificate called but CreateCustomTLSCertificateFunc is nil")
+	}
+	return mock.CreateCustomTLSCertificateFunc(ctx, i)
+}
+
+// CreateDatadog calls CreateDatadogFunc.
+func (mock *MockAPI) CreateDatadog(ctx context.Context, i *fastly.CreateDatadogInput) (*fastly.Datadog, error) {
+	if mock.CreateDatadogFunc == nil {
+		panic("fastlytest: MockAPI.CreateDatadog called but CreateDatadogFunc is nil")
+	}
+	return mock.CreateDatadogFunc(ctx, i)
+}
+
+// CreateDictionary calls CreateDictionaryFunc.
+func (mock *MockAPI) CreateDictionary(ctx context.Context, i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error) {
+	if mock.CreateDictionaryFunc == nil {
+		panic("fastlytest: MockAPI.CreateDictionary called but CreateDictionaryFunc is nil")
+	}
+	return mock.CreateDictionaryFunc(ctx, i)
+}
+
+// CreateDictionaryItem calls CreateDictionaryItemFunc.
+func (mock *MockAPI) CreateDictionaryItem(ctx context.Context, i *fastly.CreateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	if mock.CreateDictionaryItemFunc == nil {
+		panic("fastlytest: MockAPI.CreateDictionaryItem called but CreateDictionaryItemFunc is nil")
+	}
+	return mock.CreateDictionaryItemFunc(ctx, i)
+}
+
+// CreateDictionaryItems calls CreateDictionaryItemsFunc.
+func (mock *MockAPI) CreateDictionaryItems(ctx context.Context, i []fastly.CreateDictionaryItemInput) ([]fastly.DictionaryItem, error) {
+	if mock.CreateDictionaryItemsFunc == nil {
+		panic("fastlytest: MockAPI.CreateDictionaryItems called but CreateDictionaryItemsFunc is nil")
+	}
+	return mock.CreateDictionaryItemsFunc(ctx, i)
+}
+
+// CreateDigitalOcean calls CreateDigitalOceanFunc.
+func (mock *MockAPI) CreateDigitalOcean(ctx context.Context, i *fastly.CreateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	if mock.CreateDigitalOceanFunc == nil {
+		panic("fastlytest: MockAPI.CreateDigitalOcean called but CreateDigitalOceanFunc is nil")
+	}
+	return mock.CreateDigitalOceanFunc(ctx, i)
+}
+
+// CreateDirector calls CreateDirectorFunc.
+func (mock *MockAPI) CreateDirector(ctx context.Context, i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	if mock.CreateDirectorFunc == nil {
+		panic("fastlytest: MockAPI.CreateDirector called but CreateDirectorFunc is nil")
+	}
+	return mock.CreateDirectorFunc(ctx, i)
+}
+
+// CreateDirectorBackend calls CreateDirectorBackendFunc.
+func (mock *MockAPI) CreateDirectorBackend(ctx context.Context, i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	if mock.CreateDirectorBackendFunc == nil {
+		panic("fastlytest: MockAPI.CreateDirectorBackend called but CreateDirectorBackendFunc is nil")
+	}
+	return mock.CreateDirectorBackendFunc(ctx, i)
+}
+
+// CreateDomain calls CreateDomainFunc.
+func (mock *MockAPI) CreateDomain(ctx context.Context, i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+	if mock.CreateDomainFunc == nil {
+		panic("fastlytest: MockAPI.CreateDomain called but CreateDomainFunc is nil")
+	}
+	return mock.CreateDomainFunc(ctx, i)
+}
+
+// CreateERL calls CreateERLFunc.
+func (mock *MockAPI) CreateERL(ctx context.Context, i *fastly.CreateERLInput) (*fastly.ERL, error) {
+	if mock.CreateERLFunc == nil {
+		panic("fastlytest: MockAPI.CreateERL called but CreateERLFunc is nil")
+	}
+	return mock.CreateERLFunc(ctx, i)
+}
+
+// CreateElasticsearch calls CreateElasticsearchFunc.
+func (mock *MockAPI) CreateElasticsearch(ctx context.Context, i *fastly.CreateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	if mock.CreateElasticsearchFunc == nil {
+		panic("fastlytest: MockAPI.CreateElasticsearch called but CreateElasticsearchFunc is nil")
+	}
+	return mock.CreateElasticsearchFunc(ctx, i)
+}
+
+// CreateFTP calls CreateFTPFunc.
+func (mock *MockAPI) CreateFTP(ctx context.Context, i *fastly.CreateFTPInput) (*fastly.FTP, error) {
+	if mock.CreateFTPFunc == nil {
+		panic("fastlytest: MockAPI.CreateFTP called but CreateFTPFunc is nil")
+	}
+	return mock.CreateFTPFunc(ctx, i)
+}
+
+// CreateGCS calls CreateGCSFunc.
+func (mock *MockAPI) CreateGCS(ctx context.Context, i *fastly.CreateGCSInput) (*fastly.GCS, error) {
+	if mock.CreateGCSFunc == nil {
+		panic("fastlytest: MockAPI.CreateGCS called but CreateGCSFunc is nil")
+	}
+	return mock.CreateGCSFunc(ctx, i)
+}
+
+// CreateGrafanaCloudLogs calls CreateGrafanaCloudLogsFunc.
+func (mock *MockAPI) CreateGrafanaCloudLogs(ctx context.Context, i *fastly.CreateGrafanaCloudLogsInput) (*fastly.GrafanaCloudLogs, error) {
+	if mock.CreateGrafanaCloudLogsFunc == nil {
+		panic("fastlytest: MockAPI.CreateGrafanaCloudLogs called but CreateGrafanaCloudLogsFunc is nil")
+	}
+	return mock.CreateGrafanaCloudLogsFunc(ctx, i)
+}
+
+// CreateGzip calls CreateGzipFunc.
+func (mock *MockAPI) CreateGzip(ctx context.Context, i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	if mock.CreateGzipFunc == nil {
+		panic("fastlytest: MockAPI.CreateGzip called but CreateGzipFunc is nil")
+	}
+	return mock.CreateGzipFunc(ctx, i)
+}
+
+// CreateHTTPS calls CreateHTTPSFunc.
+func (mock *MockAPI) CreateHTTPS(ctx context.Context, i *fastly.CreateHTTPSInput) (*fastly.HTTPS, error) {
+	if mock.CreateHTTPSFunc == nil {
+		panic("fastlytest: MockAPI.CreateHTTPS called but CreateHTTPSFunc is nil")
+	}
+	return mock.CreateHTTPSFunc(ctx, i)
+}
+
+// CreateHeader calls CreateHeaderFunc.
+func (mock *MockAPI) CreateHeader(ctx context.Context, i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	if mock.CreateHeaderFunc == nil {
+		panic("fastlytest: MockAPI.CreateHeader called but CreateHeaderFunc is nil")
+	}
+	return mock.CreateHeaderFunc(ctx, i)
+}
+
+// CreateHealthCheck calls CreateHealthCheckFunc.
+func (mock *MockAPI) CreateHealthCheck(ctx context.Context, i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error) {
+	if mock.CreateHealthCheckFunc == nil {
+		panic("fastlytest: MockAPI.CreateHealthCheck called but CreateHealthCheckFunc is nil")
+	}
+	return mock.CreateHealthCheckFunc(ctx, i)
+}
+
+// CreateHeroku calls CreateHerokuFunc.
+func (mock *MockAPI) CreateHeroku(ctx context.Context, i *fastly.CreateHerokuInput) (*fastly.Heroku, error) {
+	if mock.CreateHerokuFunc == nil {
+		panic("fastlytest: MockAPI.CreateHeroku called but CreateHerokuFunc is nil")
+	}
+	return mock.CreateHerokuFunc(ctx, i)
+}
+
+// CreateHoneycomb calls CreateHoneycombFunc.
+func (mock *MockAPI) CreateHoneycomb(ctx context.Context, i *fastly.CreateHoneycombInput) (*fastly.Honeycomb, error) {
+	if mock.CreateHoneycombFunc == nil {
+		panic("fastlytest: MockAPI.CreateHoneycomb called but CreateHoneycombFunc is nil")
+	}
+	return mock.CreateHoneycombFunc(ctx, i)
+}
+
+// CreateIntegration calls CreateIntegrationFunc.
+func (mock *MockAPI) CreateIntegration(ctx context.Context, i *fastly.CreateIntegrationInput) (*fastly.CreateIntegrationResponse, error) {
+	if mock.CreateIntegrationFunc == nil {
+		panic("fastlytest: MockAPI.CreateIntegration called but CreateIntegrationFunc is nil")
+	}
+	return mock.CreateIntegrationFunc(ctx, i)
+}
+
+// CreateKVStore calls CreateKVStoreFunc.
+func (mock *MockAPI) CreateKVStore(ctx context.Context, i *fastly.CreateKVStoreInput) (*fastly.KVStore, error) {
+	if mock.CreateKVStoreFunc == nil {
+		panic("fastlytest: MockAPI.CreateKVStore called but CreateKVStoreFunc is nil")
+	}
+	return mock.CreateKVStoreFunc(ctx, i)
+}
+
+// CreateKafka calls CreateKafkaFunc.
+func (mock *MockAPI) CreateKafka(ctx context.Context, i *fastly.CreateKafkaInput) (*fastly.Kafka, error) {
+	if mock.CreateKafkaFunc == nil {
+		panic("fastlytest: MockAPI.CreateKafka called but CreateKafkaFunc is nil")
+	}
+	return mock.CreateKafkaFunc(ctx, i)
+}
+
+// CreateKinesis calls CreateKinesisFunc.
+func (mock *MockAPI) CreateKinesis(ctx context.Context, i *fastly.CreateKinesisInput) (*fastly.Kinesis, error) {
+	if mock.CreateKinesisFunc == nil {
+		panic("fastlytest: MockAPI.CreateKinesis called but CreateKinesisFunc is nil")
+	}
+	return mock.CreateKinesisFunc(ctx, i)
+}
+
+// CreateLogentries calls CreateLogentriesFunc.
+func (mock *MockAPI) CreateLogentries(ctx context.Context, i *fastly.CreateLogentriesInput) (*fastly.Logentries, error) {
+	if mock.CreateLogentriesFunc == nil {
+		panic("fastlytest: MockAPI.CreateLogentries called but CreateLogentriesFunc is nil")
+	}
+	return mock.CreateLogentriesFunc(ctx, i)
+}
+
+// CreateLoggly calls CreateLogglyFunc.
+func (mock *MockAPI) CreateLoggly(ctx context.Context, i *fastly.CreateLogglyInput) (*fastly.Loggly, error) {
+	if mock.CreateLogglyFunc == nil {
+		panic("fastlytest: MockAPI.CreateLoggly called but CreateLogglyFunc is nil")
+	}
+	return mock.CreateLogglyFunc(ctx, i)
+}
+
+// CreateLogshuttle calls CreateLogshuttleFunc.
+func (mock *MockAPI) CreateLogshuttle(ctx context.Context, i *fastly.CreateLogshuttleInput) (*fastly.Logshuttle, error) {
+	if mock.CreateLogshuttleFunc == nil {
+		panic("fastlytest: MockAPI.CreateLogshuttle called but CreateLogshuttleFunc is nil")
+	}
+	return mock.CreateLogshuttleFunc(ctx, i)
+}
+
+// CreateMailinglistConfirmation calls CreateMailinglistConfirmationFunc.
+func (mock *MockAPI) CreateMailinglistConfirmation(ctx context.Context, i *fastly.CreateMailinglistConfirmationInput) error {
+	if mock.CreateMailinglistConfirmationFunc == nil {
+		panic("fastlytest: MockAPI.CreateMailinglistConfirmation called but CreateMailinglistConfirmationFunc is nil")
+	}
+	return mock.CreateMailinglistConfirmationFunc(ctx, i)
+}
+
+// CreateManagedLogging calls CreateManagedLoggingFunc.
+func (mock *MockAPI) CreateManagedLogging(ctx context.Context, i *fastly.CreateManagedLoggingInput) (*fastly.ManagedLogging, error) {
+	if mock.CreateManagedLoggingFunc == nil {
+		panic("fastlytest: MockAPI.CreateManagedLogging called but CreateManagedLoggingFunc is nil")
+	}
+	return mock.CreateManagedLoggingFunc(ctx, i)
+}
+
+// CreateNewRelic calls CreateNewRelicFunc.
+func (mock *MockAPI) CreateNewRelic(ctx context.Context, i *fastly.CreateNewRelicInput) (*fastly.NewRelic, error) {
+	if mock.CreateNewRelicFunc == nil {
+		panic("fastlytest: MockAPI.CreateNewRelic called but CreateNewRelicFunc is nil")
+	}
+	return mock.CreateNewRelicFunc(ctx, i)
+}
+
+// CreateNewRelicOTLP calls CreateNewRelicOTLPFunc.
+func (mock *MockAPI) CreateNewRelicOTLP(ctx context.Context, i *fastly.CreateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	if mock.CreateNewRelicOTLPFunc == nil {
+		panic("fastlytest: MockAPI.CreateNewRelicOTLP called but CreateNewRelicOTLPFunc is nil")
+	}
+	return mock.CreateNewRelicOTLPFunc(ctx, i)
+}
+
+// CreateObservabilityCustomDashboard calls CreateObservabilityCustomDashboardFunc.
+func (mock *MockAPI) CreateObservabilityCustomDashboard(ctx context.Context, i *fastly.CreateObservabilityCustomDashboardInput) (*fastly.ObservabilityCustomDashboard, error) {
+	if mock.CreateObservabilityCustomDashboardFunc == nil {
+		panic("fastlytest: MockAPI.CreateObservabilityCustomDashboard called but CreateObservabilityCustomDashboardFunc is nil")
+	}
+	return mock.CreateObservabilityCustomDashboardFunc(ctx, i)
+}
+
+// CreateOpenstack calls CreateOpenstackFunc.
+func (mock *MockAPI) CreateOpenstack(ctx context.Context, i *fastly.CreateOpenstackInput) (*fastly.Openstack, error) {
+	if mock.CreateOpenstackFunc == nil {
+		panic("fastlytest: MockAPI.CreateOpenstack called but CreateOpenstackFunc is nil")
+	}
+	return mock.CreateOpenstackFunc(ctx, i)
+}
+
+// CreatePapertrail calls CreatePapertrailFunc.
+func (mock *MockAPI) CreatePapertrail(ctx context.Context, i *fastly.CreatePapertrailInput) (*fastly.Papertrail, error) {
+	if mock.CreatePapertrailFunc == nil {
+		panic("fastlytest: MockAPI.CreatePapertrail called but CreatePapertrailFunc is nil")
+	}
+	return mock.CreatePapertrailFunc(ctx, i)
+}
+
+// CreatePool calls CreatePoolFunc.
+func (mock *MockAPI) CreatePool(ctx context.Context, i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	if mock.CreatePoolFunc == nil {
+		panic("fastlytest: MockAPI.CreatePool called but CreatePoolFunc is nil")
+	}
+	return mock.CreatePoolFunc(ctx, i)
+}
+
+// CreatePrivateKey calls CreatePrivateKeyFunc.
+func (mock *MockAPI) CreatePrivateKey(ctx context.Context, i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	if mock.CreatePrivateKeyFunc == nil {
+		panic("fastlytest: MockAPI.CreatePrivateKey called but CreatePrivateKeyFunc is nil")
+	}
+	return mock.CreatePrivateKeyFunc(ctx, i)
+}
+
+// CreatePubsub calls CreatePubsubFunc.
+func (mock *MockAPI) CreatePubsub(ctx context.Context, i *fastly.CreatePubsubInput) (*fastly.Pubsub, error) {
+	if mock.CreatePubsubFunc == nil {
+		panic("fastlytest: MockAPI.CreatePubsub called but CreatePubsubFunc is nil")
+	}
+	return mock.CreatePubsubFunc(ctx, i)
+}
+
+// CreateRequestSetting calls CreateRequestSettingFunc.
+func (mock *MockAPI) CreateRequestSetting(ctx context.Context, i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	if mock.CreateRequestSettingFunc == nil {
+		panic("fastlytest: MockAPI.CreateRequestSetting called but CreateRequestSettingFunc is nil")
+	}
+	return mock.CreateRequestSettingFunc(ctx, i)
+}
+
+// CreateResource calls CreateResourceFunc.
+func (mock *MockAPI) CreateResource(ctx context.Context, i *fastly.CreateResourceInput) (*fastly.Resource, error) {
+	if mock.CreateResourceFunc == nil {
+		panic("fastlytest: MockAPI.CreateResource called but CreateResourceFunc is nil")
+	}
+	return mock.CreateResourceFunc(ctx, i)
+}
+
+// CreateResponseObject calls CreateResponseObjectFunc.
+func (mock *MockAPI) CreateResponseObject(ctx context.Context, i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	if mock.CreateResponseObjectFunc == nil {
+		panic("fastlytest: MockAPI.CreateResponseObject called but CreateResponseObjectFunc is nil")
+	}
+	return mock.CreateResponseObjectFunc(ctx, i)
+}
+
+// CreateS3 calls CreateS3Func.
+func (mock *MockAPI) CreateS3(ctx context.Context, i *fastly.CreateS3Input) (*fastly.S3, error) {
+	if mock.CreateS3Func == nil {
+		panic("fastlytest: MockAPI.CreateS3 called but CreateS3Func is nil")
+	}
+	return mock.CreateS3Func(ctx, i)
+}
+
+// CreateSFTP calls CreateSFTPFunc.
+func (mock *MockAPI) CreateSFTP(ctx context.Context, i *fastly.CreateSFTPInput) (*fastly.SFTP, error) {
+	if mock.CreateSFTPFunc == nil {
+		panic("fastlytest: MockAPI.CreateSFTP called but CreateSFTPFunc is nil")
+	}
+	return mock.CreateSFTPFunc(ctx, i)
+}
+
+// CreateScalyr calls CreateScalyrFunc.
+func (mock *MockAPI) CreateScalyr(ctx context.Context, i *fastly.CreateScalyrInput) (*fastly.Scalyr, error) {
+	if mock.CreateScalyrFunc == nil {
+		panic("fastlytest: MockAPI.CreateScalyr called but CreateScalyrFunc is nil")
+	}
+	return mock.CreateScalyrFunc(ctx, i)
+}
+
+// CreateSecret calls CreateSecretFunc.
+func (mock *MockAPI) CreateSecret(ctx context.Context, i *fastly.CreateSecretInput) (*fastly.Secret, error) {
+	if mock.CreateSecretFunc == nil {
+		panic("fastlytest: MockAPI.CreateSecret called but CreateSecretFunc is nil")
+	}
+	return mock.CreateSecretFunc(ctx, i)
+}
+
+// CreateSecretStore calls CreateSecretStoreFunc.
+func (mock *MockAPI) CreateSecretStore(ctx context.Context, i *fastly.CreateSecretStoreInput) (*fastly.SecretStore, error) {
+	if mock.CreateSecretStoreFunc == nil {
+		panic("fastlytest: MockAPI.CreateSecretStore called but CreateSecretStoreFunc is nil")
+	}
+	return mock.CreateSecretStoreFunc(ctx, i)
+}
+
+// CreateServer calls CreateServerFunc.
+func (mock *MockAPI) CreateServer(ctx context.Context, i *fastly.CreateServerInput) (*fastly.Server, error) {
+	if mock.CreateServerFunc == nil {
+		panic("fastlytest: MockAPI.CreateServer called but CreateServerFunc is nil")
+	}
+	return mock.CreateServerFunc(ctx, i)
+}
+
+// CreateService calls CreateServiceFunc.
+func (mock *MockAPI) CreateService(ctx context.Context, i *fastly.CreateServiceInput) (*fastly.Service, error) {
+	if mock.CreateServiceFunc == nil {
+		panic("fastlytest: MockAPI.CreateService called but CreateServiceFunc is nil")
+	}
+	return mock.CreateServiceFunc(ctx, i)
+}
+
+// CreateServiceAuthorization calls CreateServiceAuthorizationFunc.
+func (mock *MockAPI) CreateServiceAuthorization(ctx context.Context, i *fastly.CreateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	if mock.CreateServiceAuthorizationFunc == nil {
+		panic("fastlytest: MockAPI.CreateServiceAuthorization called but CreateServiceAuthorizationFunc is nil")
+	}
+	return mock.CreateServiceAuthorizationFunc(ctx, i)
+}
+
+// CreateSnippet calls CreateSnippetFunc.
+func (mock *MockAPI) CreateSnippet(ctx context.Context, i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
+	if mock.CreateSnippetFunc == nil {
+		panic("fastlytest: MockAPI.CreateSnippet called but CreateSnippetFunc is nil")
+	}
+	return mock.CreateSnippetFunc(ctx, i)
+}
+
+// CreateSplunk calls CreateSplunkFunc.
+func (mock *MockAPI) CreateSplunk(ctx context.Context, i *fastly.CreateSplunkInput) (*fastly.Splunk, error) {
+	if mock.CreateSplunkFunc == nil {
+		panic("fastlytest: MockAPI.CreateSplunk called but CreateSplunkFunc is nil")
+	}
+	return mock.CreateSplunkFunc(ctx, i)
+}
+
+// CreateSumologic calls CreateSumologicFunc.
+func (mock *MockAPI) CreateSumologic(ctx context.Context, i *fastly.CreateSumologicInput) (*fastly.Sumologic, error) {
+	if mock.CreateSumologicFunc == nil {
+		panic("fastlytest: MockAPI.CreateSumologic called but CreateSumologicFunc is nil")
+	}
+	return mock.CreateSumologicFunc(ctx, i)
+}
+
+// CreateSyslog calls CreateSyslogFunc.
+func (mock *MockAPI) CreateSyslog(ctx context.Context, i *fastly.CreateSyslogInput) (*fastly.Syslog, error) {
+	if mock.CreateSyslogFunc == nil {
+		panic("fastlytest: MockAPI.CreateSyslog called but CreateSyslogFunc is nil")
+	}
+	return mock.CreateSyslogFunc(ctx, i)
+}
+
+// CreateTLSActivation calls CreateTLSActivationFunc.
+func (mock *MockAPI) CreateTLSActivation(ctx context.Context, i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	if mock.CreateTLSActivationFunc == nil {
+		panic("fastlytest: MockAPI.CreateTLSActivation called but CreateTLSActivationFunc is nil")
+	}
+	return mock.CreateTLSActivationFunc(ctx, i)
+}
+
+// CreateTLSMutualAuthentication calls CreateTLSMutualAuthenticationFunc.
+func (mock *MockAPI) CreateTLSMutualAuthentication(ctx context.Context, i *fastly.CreateTLSMutualAuthenticationInput) (*fastly.TLSMutualAuthentication, error) {
+	if mock.CreateTLSMutualAuthenticationFunc == nil {
+		panic("fastlytest: MockAPI.CreateTLSMutualAuthentication called but CreateTLSMutualAuthenticationFunc is nil")
+	}
+	return mock.CreateTLSMutualAuthenticationFunc(ctx, i)
+}
+
+// CreateTLSSubscription calls CreateTLSSubscriptionFunc.
+func (mock *MockAPI) CreateTLSSubscription(ctx context.Context, i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	if mock.CreateTLSSubscriptionFunc == nil {
+		panic("fastlytest: MockAPI.CreateTLSSubscription called but CreateTLSSubscriptionFunc is nil")
+	}
+	return mock.CreateTLSSubscriptionFunc(ctx, i)
+}
+
+// CreateToken calls CreateTokenFunc.
+func (mock *MockAPI) CreateToken(ctx context.Context, i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	if mock.CreateTokenFunc == nil {
+		panic("fastlytest: MockAPI.CreateToken called but CreateTokenFunc is nil")
+	}
+	return mock.CreateTokenFunc(ctx, i)
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *MockAPI) CreateUser(ctx context.Context, i *fastly.CreateUserInput) (*fastly.User, error) {
+	if mock.CreateUserFunc == nil {
+		panic("fastlytest: MockAPI.CreateUser called but CreateUserFunc is nil")
+	}
+	return mock.CreateUserFunc(ctx, i)
+}
+
+// CreateVCL calls CreateVCLFunc.
+func (mock *MockAPI) CreateVCL(ctx context.Context, i *fastly.CreateVCLInput) (*fastly.VCL, error) {
+	if mock.CreateVCLFunc == nil {
+		panic("fastlytest: MockAPI.CreateVCL called but CreateVCLFunc is nil")
+	}
+	return mock.CreateVCLFunc(ctx, i)
+}
+
+// CreateVersion calls CreateVersionFunc.
+func (mock *MockAPI) CreateVersion(ctx context.Context, i *fastly.CreateVersionInput) (*fastly.Version, error) {
+	if mock.CreateVersionFunc == nil {
+		panic("fastlytest: MockAPI.CreateVersion called but CreateVersionFunc is nil")
+	}
+	return mock.CreateVersionFunc(ctx, i)
+}
+
+// DeactivateVersion calls DeactivateVersionFunc.
+func (mock *MockAPI) DeactivateVersion(ctx context.Context, i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
+	if mock.DeactivateVersionFunc == nil {
+		panic("fastlytest: MockAPI.DeactivateVersion called but DeactivateVersionFunc is nil")
+	}
+	return mock.DeactivateVersionFunc(ctx, i)
+}
+
+// Delete calls DeleteFunc.
+func (mock *MockAPI) Delete(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.DeleteFunc == nil {
+		panic("fastlytest: MockAPI.Delete called but DeleteFunc is nil")
+	}
+	return mock.DeleteFunc(ctx, p, ro)
+}
+
+// DeleteACL calls DeleteACLFunc.
+func (mock *MockAPI) DeleteACL(ctx context.Context, i *fastly.DeleteACLInput) error {
+	if mock.DeleteACLFunc == nil {
+		panic("fastlytest: MockAPI.DeleteACL called but DeleteACLFunc is nil")
+	}
+	return mock.DeleteACLFunc(ctx, i)
+}
+
+// DeleteACLEntry calls DeleteACLEntryFunc.
+func (mock *MockAPI) DeleteACLEntry(ctx context.Context, i *fastly.DeleteACLEntryInput) error {
+	if mock.DeleteACLEntryFunc == nil {
+		panic("fastlytest: MockAPI.DeleteACLEntry called but DeleteACLEntryFunc is nil")
+	}
+	return mock.DeleteACLEntryFunc(ctx, i)
+}
+
+// DeleteAlertDefinition calls DeleteAlertDefinitionFunc.
+func (mock *MockAPI) DeleteAlertDefinition(ctx context.Context, i *fastly.DeleteAlertDefinitionInput) error {
+	if mock.DeleteAlertDefinitionFunc == nil {
+		panic("fastlytest: MockAPI.DeleteAlertDefinition called but DeleteAlertDefinitionFunc is nil")
+	}
+	return mock.DeleteAlertDefinitionFunc(ctx, i)
+}
+
+// DeleteAutomationToken calls DeleteAutomationTokenFunc.
+func (mock *MockAPI) DeleteAutomationToken(ctx context.Context, i *fastly.DeleteAutomationTokenInput) error {
+	if mock.DeleteAutomationTokenFunc == nil {
+		panic("fastlytest: MockAPI.DeleteAutomationToken called but DeleteAutomationTokenFunc is nil")
+	}
+	return mock.DeleteAutomationTokenFunc(ctx, i)
+}
+
+// DeleteBackend calls DeleteBackendFunc.
+func (mock *MockAPI) DeleteBackend(ctx context.Context, i *fastly.DeleteBackendInput) error {
+	if mock.DeleteBackendFunc == nil {
+		panic("fastlytest: MockAPI.DeleteBackend called but DeleteBackendFunc is nil")
+	}
+	return mock.DeleteBackendFunc(ctx, i)
+}
+
+// DeleteBigQuery calls DeleteBigQueryFunc.
+func (mock *MockAPI) DeleteBigQuery(ctx context.Context, i *fastly.DeleteBigQueryInput) error {
+	if mock.DeleteBigQueryFunc == nil {
+		panic("fastlytest: MockAPI.DeleteBigQuery called but DeleteBigQueryFunc is nil")
+	}
+	return mock.DeleteBigQueryFunc(ctx, i)
+}
+
+// DeleteBlobStorage calls DeleteBlobStorageFunc.
+func (mock *MockAPI) DeleteBlobStorage(ctx context.Context, i *fastly.DeleteBlobStorageInput) error {
+	if mock.DeleteBlobStorageFunc == nil {
+		panic("fastlytest: MockAPI.DeleteBlobStorage called but DeleteBlobStorageFunc is nil")
+	}
+	return mock.DeleteBlobStorageFunc(ctx, i)
+}
+
+// DeleteBulkCertificate calls DeleteBulkCertificateFunc.
+func (mock *MockAPI) DeleteBulkCertificate(ctx context.Context, i *fastly.DeleteBulkCertificateInput) error {
+	if mock.DeleteBulkCertificateFunc == nil {
+		panic("fastlytest: MockAPI.DeleteBulkCertificate called but DeleteBulkCertificateFunc is nil")
+	}
+	return mock.DeleteBulkCertificateFunc(ctx, i)
+}
+
+// DeleteCacheSetting calls DeleteCacheSettingFunc.
+func (mock *MockAPI) DeleteCacheSetting(ctx context.Context, i *fastly.DeleteCacheSettingInput) error {
+	if mock.DeleteCacheSettingFunc == nil {
+		panic("fastlytest: MockAPI.DeleteCacheSetting called but DeleteCacheSettingFunc is nil")
+	}
+	return mock.DeleteCacheSettingFunc(ctx, i)
+}
+
+// DeleteCloudfiles calls DeleteCloudfilesFunc.
+func (mock *MockAPI) DeleteCloudfiles(ctx context.Context, i *fastly.DeleteCloudfilesInput) error {
+	if mock.DeleteCloudfilesFunc == nil {
+		panic("fastlytest: MockAPI.DeleteCloudfiles called but DeleteCloudfilesFunc is nil")
+	}
+	return mock.DeleteCloudfilesFunc(ctx, i)
+}
+
+// DeleteCondition calls DeleteConditionFunc.
+func (mock *MockAPI) DeleteCondition(ctx context.Context, i *fastly.DeleteConditionInput) error {
+	if mock.DeleteConditionFunc == nil {
+		panic("fastlytest: MockAPI.DeleteCondition called but DeleteConditionFunc is nil")
+	}
+	return mock.DeleteConditionFunc(ctx, i)
+}
+
+// DeleteConfigStore calls DeleteConfigStoreFunc.
+func (mock *MockAPI) DeleteConfigStore(ctx context.Context, i *fastly.DeleteConfigStoreInput) error {
+	if mock.DeleteConfigStoreFunc == nil {
+		panic("fastlytest: MockAPI.DeleteConfigStore called but DeleteConfigStoreFunc is nil")
+	}
+	return mock.DeleteConfigStoreFunc(ctx, i)
+}
+
+// DeleteConfigStoreItem calls DeleteConfigStoreItemFunc.
+func (mock *MockAPI) DeleteConfigStoreItem(ctx context.Context, i *fastly.DeleteConfigStoreItemInput) error {
+	if mock.DeleteConfigStoreItemFunc == nil {
+		panic("fastlytest: MockAPI.DeleteConfigStoreItem called but DeleteConfigStoreItemFunc is nil")
+	}
+	return mock.DeleteConfigStoreItemFunc(ctx, i)
+}
+
+// DeleteCustomTLSCertificate calls DeleteCustomTLSCertificateFunc.
+func (mock *MockAPI) DeleteCustomTLSCertificate(ctx context.Context, i *fastly.DeleteCustomTLSCertificateInput) error {
+	if mock.DeleteCustomTLSCertificateFunc == nil {
+		panic("fastlytest: MockAPI.DeleteCustomTLSCertificate called but DeleteCustomTLSCertificateFunc is nil")
+	}
+	return mock.DeleteCustomTLSCertificateFunc(ctx, i)
+}
+
+// DeleteDatadog calls DeleteDatadogFunc.
+func (mock *MockAPI) DeleteDatadog(ctx context.Context, i *fastly.DeleteDatadogInput) error {
+	if mock.DeleteDatadogFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDatadog called but DeleteDatadogFunc is nil")
+	}
+	return mock.DeleteDatadogFunc(ctx, i)
+}
+
+// DeleteDictionary calls DeleteDictionaryFunc.
+func (mock *MockAPI) DeleteDictionary(ctx context.Context, i *fastly.DeleteDictionaryInput) error {
+	if mock.DeleteDictionaryFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDictionary called but DeleteDictionaryFunc is nil")
+	}
+	return mock.DeleteDictionaryFunc(ctx, i)
+}
+
+// DeleteDictionaryItem calls DeleteDictionaryItemFunc.
+func (mock *MockAPI) DeleteDictionaryItem(ctx context.Context, i *fastly.DeleteDictionaryItemInput) error {
+	if mock.DeleteDictionaryItemFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDictionaryItem called but DeleteDictionaryItemFunc is nil")
+	}
+	return mock.DeleteDictionaryItemFunc(ctx, i)
+}
+
+// DeleteDigitalOcean calls DeleteDigitalOceanFunc.
+func (mock *MockAPI) DeleteDigitalOcean(ctx context.Context, i *fastly.DeleteDigitalOceanInput) error {
+	if mock.DeleteDigitalOceanFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDigitalOcean called but DeleteDigitalOceanFunc is nil")
+	}
+	return mock.DeleteDigitalOceanFunc(ctx, i)
+}
+
+// DeleteDirector calls DeleteDirectorFunc.
+func (mock *MockAPI) DeleteDirector(ctx context.Context, i *fastly.DeleteDirectorInput) error {
+	if mock.DeleteDirectorFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDirector called but DeleteDirectorFunc is nil")
+	}
+	return mock.DeleteDirectorFunc(ctx, i)
+}
+
+// DeleteDirectorBackend calls DeleteDirectorBackendFunc.
+func (mock *MockAPI) DeleteDirectorBackend(ctx context.Context, i *fastly.DeleteDirectorBackendInput) error {
+	if mock.DeleteDirectorBackendFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDirectorBackend called but DeleteDirectorBackendFunc is nil")
+	}
+	return mock.DeleteDirectorBackendFunc(ctx, i)
+}
+
+// DeleteDomain calls DeleteDomainFunc.
+func (mock *MockAPI) DeleteDomain(ctx context.Context, i *fastly.DeleteDomainInput) error {
+	if mock.DeleteDomainFunc == nil {
+		panic("fastlytest: MockAPI.DeleteDomain called but DeleteDomainFunc is nil")
+	}
+	return mock.DeleteDomainFunc(ctx, i)
+}
+
+// DeleteERL calls DeleteERLFunc.
+func (mock *MockAPI) DeleteERL(ctx context.Context, i *fastly.DeleteERLInput) error {
+	if mock.DeleteERLFunc == nil {
+		panic("fastlytest: MockAPI.DeleteERL called but DeleteERLFunc is nil")
+	}
+	return mock.DeleteERLFunc(ctx, i)
+}
+
+// DeleteElasticsearch calls DeleteElasticsearchFunc.
+func (mock *MockAPI) DeleteElasticsearch(ctx context.Context, i *fastly.DeleteElasticsearchInput) error {
+	if mock.DeleteElasticsearchFunc == nil {
+		panic("fastlytest: MockAPI.DeleteElasticsearch called but DeleteElasticsearchFunc is nil")
+	}
+	return mock.DeleteElasticsearchFunc(ctx, i)
+}
+
+// DeleteFTP calls DeleteFTPFunc.
+func (mock *MockAPI) DeleteFTP(ctx context.Context, i *fastly.DeleteFTPInput) error {
+	if mock.DeleteFTPFunc == nil {
+		panic("fastlytest: MockAPI.DeleteFTP called but DeleteFTPFunc is nil")
+	}
+	return mock.DeleteFTPFunc(ctx, i)
+}
+
+// DeleteGCS calls DeleteGCSFunc.
+func (mock *MockAPI) DeleteGCS(ctx context.Context, i *fastly.DeleteGCSInput) error {
+	if mock.DeleteGCSFunc == nil {
+		panic("fastlytest: MockAPI.DeleteGCS called but DeleteGCSFunc is nil")
+	}
+	return mock.DeleteGCSFunc(ctx, i)
+}
+
+// DeleteGrafanaCloudLogs calls DeleteGrafanaCloudLogsFunc.
+func (mock *MockAPI) DeleteGrafanaCloudLogs(ctx context.Context, i *fastly.DeleteGrafanaCloudLogsInput) error {
+	if mock.DeleteGrafanaCloudLogsFunc == nil {
+		panic("fastlytest: MockAPI.DeleteGrafanaCloudLogs called but DeleteGrafanaCloudLogsFunc is nil")
+	}
+	return mock.DeleteGrafanaCloudLogsFunc(ctx, i)
+}
+
+// DeleteGzip calls DeleteGzipFunc.
+func (mock *MockAPI) DeleteGzip(ctx context.Context, i *fastly.DeleteGzipInput) error {
+	if mock.DeleteGzipFunc == nil {
+		panic("fastlytest: MockAPI.DeleteGzip called but DeleteGzipFunc is nil")
+	}
+	return mock.DeleteGzipFunc(ctx, i)
+}
+
+// DeleteHTTPS calls DeleteHTTPSFunc.
+func (mock *MockAPI) DeleteHTTPS(ctx context.Context, i *fastly.DeleteHTTPSInput) error {
+	if mock.DeleteHTTPSFunc == nil {
+		panic("fastlytest: MockAPI.DeleteHTTPS called but DeleteHTTPSFunc is nil")
+	}
+	return mock.DeleteHTTPSFunc(ctx, i)
+}
+
+// DeleteHeader calls DeleteHeaderFunc.
+func (mock *MockAPI) DeleteHeader(ctx context.Context, i *fastly.DeleteHeaderInput) error {
+	if mock.DeleteHeaderFunc == nil {
+		panic("fastlytest: MockAPI.DeleteHeader called but DeleteHeaderFunc is nil")
+	}
+	return mock.DeleteHeaderFunc(ctx, i)
+}
+
+// DeleteHealthCheck calls DeleteHealthCheckFunc.
+func (mock *MockAPI) DeleteHealthCheck(ctx context.Context, i *fastly.DeleteHealthCheckInput) error {
+	if mock.DeleteHealthCheckFunc == nil {
+		panic("fastlytest: MockAPI.DeleteHealthCheck called but DeleteHealthCheckFunc is nil")
+	}
+	return mock.DeleteHealthCheckFunc(ctx, i)
+}
+
+// DeleteHeroku calls DeleteHerokuFunc.
+func (mock *MockAPI) DeleteHeroku(ctx context.Context, i *fastly.DeleteHerokuInput) error {
+	if mock.DeleteHerokuFunc == nil {
+		panic("fastlytest: MockAPI.DeleteHeroku called but DeleteHerokuFunc is nil")
+	}
+	return mock.DeleteHerokuFunc(ctx, i)
+}
+
+// DeleteHoneycomb calls DeleteHoneycombFunc.
+func (mock *MockAPI) DeleteHoneycomb(ctx context.Context, i *fastly.DeleteHoneycombInput) error {
+	if mock.DeleteHoneycombFunc == nil {
+		panic("fastlytest: MockAPI.DeleteHoneycomb called but DeleteHoneycombFunc is nil")
+	}
+	return mock.DeleteHoneycombFunc(ctx, i)
+}
+
+// DeleteIntegration calls DeleteIntegrationFunc.
+func (mock *MockAPI) DeleteIntegration(ctx context.Context, i *fastly.DeleteIntegrationInput) error {
+	if mock.DeleteIntegrationFunc == nil {
+		panic("fastlytest: MockAPI.DeleteIntegration called but DeleteIntegrationFunc is nil")
+	}
+	return mock.DeleteIntegrationFunc(ctx, i)
+}
+
+// DeleteJSONAPI calls DeleteJSONAPIFunc.
+func (mock *MockAPI) DeleteJSONAPI(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.DeleteJSONAPIFunc == nil {
+		panic("fastlytest: MockAPI.DeleteJSONAPI called but DeleteJSONAPIFunc is nil")
+	}
+	return mock.DeleteJSONAPIFunc(ctx, p, i, ro)
+}
+
+// DeleteJSONAPIBulk calls DeleteJSONAPIBulkFunc.
+func (mock *MockAPI) DeleteJSONAPIBulk(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.DeleteJSONAPIBulkFunc == nil {
+		panic("fastlytest: MockAPI.DeleteJSONAPIBulk called but DeleteJSONAPIBulkFunc is nil")
+	}
+	return mock.DeleteJSONAPIBulkFunc(ctx, p, i, ro)
+}
+
+// DeleteKVStore calls DeleteKVStoreFunc.
+func (mock *MockAPI) DeleteKVStore(ctx context.Context, i *fastly.DeleteKVStoreInput) error {
+	if mock.DeleteKVStoreFunc == nil {
+		panic("fastlytest: MockAPI.DeleteKVStore called but DeleteKVStoreFunc is nil")
+	}
+	return mock.DeleteKVStoreFunc(ctx, i)
+}
+
+// DeleteKVStoreKey calls DeleteKVStoreKeyFunc.
+func (mock *MockAPI) DeleteKVStoreKey(ctx context.Context, i *fastly.DeleteKVStoreKeyInput) error {
+	if mock.DeleteKVStoreKeyFunc == nil {
+		panic("fastlytest: MockAPI.DeleteKVStoreKey called but DeleteKVStoreKeyFunc is nil")
+	}
+	return mock.DeleteKVStoreKeyFunc(ctx, i)
+}
+
+// DeleteKafka calls DeleteKafkaFunc.
+func (mock *MockAPI) DeleteKafka(ctx context.Context, i *fastly.DeleteKafkaInput) error {
+	if mock.DeleteKafkaFunc == nil {
+		panic("fastlytest: MockAPI.DeleteKafka called but DeleteKafkaFunc is nil")
+	}
+	return mock.DeleteKafkaFunc(ctx, i)
+}
+
+// DeleteKinesis calls DeleteKinesisFunc.
+func (mock *MockAPI) DeleteKinesis(ctx context.Context, i *fastly.DeleteKinesisInput) error {
+	if mock.DeleteKinesisFunc == nil {
+		panic("fastlytest: MockAPI.DeleteKinesis called but DeleteKinesisFunc is nil")
+	}
+	return mock.DeleteKinesisFunc(ctx, i)
+}
+
+// DeleteLogentries calls DeleteLogentriesFunc.
+func (mock *MockAPI) DeleteLogentries(ctx context.Context, i *fastly.DeleteLogentriesInput) error {
+	if mock.DeleteLogentriesFunc == nil {
+		panic("fastlytest: MockAPI.DeleteLogentries called but DeleteLogentriesFunc is nil")
+	}
+	return mock.DeleteLogentriesFunc(ctx, i)
+}
+
+// DeleteLoggly calls DeleteLogglyFunc.
+func (mock *MockAPI) DeleteLoggly(ctx context.Context, i *fastly.DeleteLogglyInput) error {
+	if mock.DeleteLogglyFunc == nil {
+		panic("fastlytest: MockAPI.DeleteLoggly called but DeleteLogglyFunc is nil")
+	}
+	return mock.DeleteLogglyFunc(ctx, i)
+}
+
+// DeleteLogshuttle calls DeleteLogshuttleFunc.
+func (mock *MockAPI) DeleteLogshuttle(ctx context.Context, i *fastly.DeleteLogshuttleInput) error {
+	if mock.DeleteLogshuttleFunc == nil {
+		panic("fastlytest: MockAPI.DeleteLogshuttle called but DeleteLogshuttleFunc is nil")
+	}
+	return mock.DeleteLogshuttleFunc(ctx, i)
+}
+
+// DeleteManagedLogging calls DeleteManagedLoggingFunc.
+func (mock *MockAPI) DeleteManagedLogging(ctx context.Context, i *fastly.DeleteManagedLoggingInput) error {
+	if mock.DeleteManagedLoggingFunc == nil {
+		panic("fastlytest: MockAPI.DeleteManagedLogging called but DeleteManagedLoggingFunc is nil")
+	}
+	return mock.DeleteManagedLoggingFunc(ctx, i)
+}
+
+// DeleteNewRelic calls DeleteNewRelicFunc.
+func (mock *MockAPI) DeleteNewRelic(ctx context.Context, i *fastly.DeleteNewRelicInput) error {
+	if mock.DeleteNewRelicFunc == nil {
+		panic("fastlytest: MockAPI.DeleteNewRelic called but DeleteNewRelicFunc is nil")
+	}
+	return mock.DeleteNewRelicFunc(ctx, i)
+}
+
+// DeleteNewRelicOTLP calls DeleteNewRelicOTLPFunc.
+func (mock *MockAPI) DeleteNewRelicOTLP(ctx context.Context, i *fastly.DeleteNewRelicOTLPInput) error {
+	if mock.DeleteNewRelicOTLPFunc == nil {
+		panic("fastlytest: MockAPI.DeleteNewRelicOTLP called but DeleteNewRelicOTLPFunc is nil")
+	}
+	return mock.DeleteNewRelicOTLPFunc(ctx, i)
+}
+
+// DeleteObservabilityCustomDashboard calls DeleteObservabilityCustomDashboardFunc.
+func (mock *MockAPI) DeleteObservabilityCustomDashboard(ctx context.Context, i *fastly.DeleteObservabilityCustomDashboardInput) error {
+	if mock.DeleteObservabilityCustomDashboardFunc == nil {
+		panic("fastlytest: MockAPI.DeleteObservabilityCustomDashboard called but DeleteObservabilityCustomDashboardFunc is nil")
+	}
+	return mock.DeleteObservabilityCustomDashboardFunc(ctx, i)
+}
+
+// DeleteOpenstack calls DeleteOpenstackFunc.
+func (mock *MockAPI) DeleteOpenstack(ctx context.Context, i *fastly.DeleteOpenstackInput) error {
+	if mock.DeleteOpenstackFunc == nil {
+		panic("fastlytest: MockAPI.DeleteOpenstack called but DeleteOpenstackFunc is nil")
+	}
+	return mock.DeleteOpenstackFunc(ctx, i)
+}
+
+// DeletePapertrail calls DeletePapertrailFunc.
+func (mock *MockAPI) DeletePapertrail(ctx context.Context, i *fastly.DeletePapertrailInput) error {
+	if mock.DeletePapertrailFunc == nil {
+		panic("fastlytest: MockAPI.DeletePapertrail called but DeletePapertrailFunc is nil")
+	}
+	return mock.DeletePapertrailFunc(ctx, i)
+}
+
+// DeletePool calls DeletePoolFunc.
+func (mock *MockAPI) DeletePool(ctx context.Context, i *fastly.DeletePoolInput) error {
+	if mock.DeletePoolFunc == nil {
+		panic("fastlytest: MockAPI.DeletePool called but DeletePoolFunc is nil")
+	}
+	return mock.DeletePoolFunc(ctx, i)
+}
+
+// DeletePrivateKey calls DeletePrivateKeyFunc.
+func (mock *MockAPI) DeletePrivateKey(ctx context.Context, i *fastly.DeletePrivateKeyInput) error {
+	if mock.DeletePrivateKeyFunc == nil {
+		panic("fastlytest: MockAPI.DeletePrivateKey called but DeletePrivateKeyFunc is nil")
+	}
+	return mock.DeletePrivateKeyFunc(ctx, i)
+}
+
+// DeletePubsub calls DeletePubsubFunc.
+func (mock *MockAPI) DeletePubsub(ctx context.Context, i *fastly.DeletePubsubInput) error {
+	if mock.DeletePubsubFunc == nil {
+		panic("fastlytest: MockAPI.DeletePubsub called but DeletePubsubFunc is nil")
+	}
+	return mock.DeletePubsubFunc(ctx, i)
+}
+
+// DeleteRequestSetting calls DeleteRequestSettingFunc.
+func (mock *MockAPI) DeleteRequestSetting(ctx context.Context, i *fastly.DeleteRequestSettingInput) error {
+	if mock.DeleteRequestSettingFunc == nil {
+		panic("fastlytest: MockAPI.DeleteRequestSetting called but DeleteRequestSettingFunc is nil")
+	}
+	return mock.DeleteRequestSettingFunc(ctx, i)
+}
+
+// DeleteResource calls DeleteResourceFunc.
+func (mock *MockAPI) DeleteResource(ctx context.Context, i *fastly.DeleteResourceInput) error {
+	if mock.DeleteResourceFunc == nil {
+		panic("fastlytest: MockAPI.DeleteResource called but DeleteResourceFunc is nil")
+	}
+	return mock.DeleteResourceFunc(ctx, i)
+}
+
+// DeleteResponseObject calls DeleteResponseObjectFunc.
+func (mock *MockAPI) DeleteResponseObject(ctx context.Context, i *fastly.DeleteResponseObjectInput) error {
+	if mock.DeleteResponseObjectFunc == nil {
+		panic("fastlytest: MockAPI.DeleteResponseObject called but DeleteResponseObjectFunc is nil")
+	}
+	return mock.DeleteResponseObjectFunc(ctx, i)
+}
+
+// DeleteS3 calls DeleteS3Func.
+func (mock *MockAPI) DeleteS3(ctx context.Context, i *fastly.DeleteS3Input) error {
+	if mock.DeleteS3Func == nil {
+		panic("fastlytest: MockAPI.DeleteS3 called but DeleteS3Func is nil")
+	}
+	return mock.DeleteS3Func(ctx, i)
+}
+
+// DeleteSFTP calls DeleteSFTPFunc.
+func (mock *MockAPI) DeleteSFTP(ctx context.Context, i *fastly.DeleteSFTPInput) error {
+	if mock.DeleteSFTPFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSFTP called but DeleteSFTPFunc is nil")
+	}
+	return mock.DeleteSFTPFunc(ctx, i)
+}
+
+// DeleteScalyr calls DeleteScalyrFunc.
+func (mock *MockAPI) DeleteScalyr(ctx context.Context, i *fastly.DeleteScalyrInput) error {
+	if mock.DeleteScalyrFunc == nil {
+		panic("fastlytest: MockAPI.DeleteScalyr called but DeleteScalyrFunc is nil")
+	}
+	return mock.DeleteScalyrFunc(ctx, i)
+}
+
+// DeleteSecret calls DeleteSecretFunc.
+func (mock *MockAPI) DeleteSecret(ctx context.Context, i *fastly.DeleteSecretInput) error {
+	if mock.DeleteSecretFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSecret called but DeleteSecretFunc is nil")
+	}
+	return mock.DeleteSecretFunc(ctx, i)
+}
+
+// DeleteSecretStore calls DeleteSecretStoreFunc.
+func (mock *MockAPI) DeleteSecretStore(ctx context.Context, i *fastly.DeleteSecretStoreInput) error {
+	if mock.DeleteSecretStoreFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSecretStore called but DeleteSecretStoreFunc is nil")
+	}
+	return mock.DeleteSecretStoreFunc(ctx, i)
+}
+
+// DeleteServer calls DeleteServerFunc.
+func (mock *MockAPI) DeleteServer(ctx context.Context, i *fastly.DeleteServerInput) error {
+	if mock.DeleteServerFunc == nil {
+		panic("fastlytest: MockAPI.DeleteServer called but DeleteServerFunc is nil")
+	}
+	return mock.DeleteServerFunc(ctx, i)
+}
+
+// DeleteService calls DeleteServiceFunc.
+func (mock *MockAPI) DeleteService(ctx context.Context, i *fastly.DeleteServiceInput) error {
+	if mock.DeleteServiceFunc == nil {
+		panic("fastlytest: MockAPI.DeleteService called but DeleteServiceFunc is nil")
+	}
+	return mock.DeleteServiceFunc(ctx, i)
+}
+
+// DeleteServiceAuthorization calls DeleteServiceAuthorizationFunc.
+func (mock *MockAPI) DeleteServiceAuthorization(ctx context.Context, i *fastly.DeleteServiceAuthorizationInput) error {
+	if mock.DeleteServiceAuthorizationFunc == nil {
+		panic("fastlytest: MockAPI.DeleteServiceAuthorization called but DeleteServiceAuthorizationFunc is nil")
+	}
+	return mock.DeleteServiceAuthorizationFunc(ctx, i)
+}
+
+// DeleteSnippet calls DeleteSnippetFunc.
+func (mock *MockAPI) DeleteSnippet(ctx context.Context, i *fastly.DeleteSnippetInput) error {
+	if mock.DeleteSnippetFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSnippet called but DeleteSnippetFunc is nil")
+	}
+	return mock.DeleteSnippetFunc(ctx, i)
+}
+
+// DeleteSplunk calls DeleteSplunkFunc.
+func (mock *MockAPI) DeleteSplunk(ctx context.Context, i *fastly.DeleteSplunkInput) error {
+	if mock.DeleteSplunkFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSplunk called but DeleteSplunkFunc is nil")
+	}
+	return mock.DeleteSplunkFunc(ctx, i)
+}
+
+// DeleteSumologic calls DeleteSumologicFunc.
+func (mock *MockAPI) DeleteSumologic(ctx context.Context, i *fastly.DeleteSumologicInput) error {
+	if mock.DeleteSumologicFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSumologic called but DeleteSumologicFunc is nil")
+	}
+	return mock.DeleteSumologicFunc(ctx, i)
+}
+
+// DeleteSyslog calls DeleteSyslogFunc.
+func (mock *MockAPI) DeleteSyslog(ctx context.Context, i *fastly.DeleteSyslogInput) error {
+	if mock.DeleteSyslogFunc == nil {
+		panic("fastlytest: MockAPI.DeleteSyslog called but DeleteSyslogFunc is nil")
+	}
+	return mock.DeleteSyslogFunc(ctx, i)
+}
+
+// DeleteTLSActivation calls DeleteTLSActivationFunc.
+func (mock *MockAPI) DeleteTLSActivation(ctx context.Context, i *fastly.DeleteTLSActivationInput) error {
+	if mock.DeleteTLSActivationFunc == nil {
+		panic("fastlytest: MockAPI.DeleteTLSActivation called but DeleteTLSActivationFunc is nil")
+	}
+	return mock.DeleteTLSActivationFunc(ctx, i)
+}
+
+// DeleteTLSMutualAuthentication calls DeleteTLSMutualAuthenticationFunc.
+func (mock *MockAPI) DeleteTLSMutualAuthentication(ctx context.Context, i *fastly.DeleteTLSMutualAuthenticationInput) error {
+	if mock.DeleteTLSMutualAuthenticationFunc == nil {
+		panic("fastlytest: MockAPI.DeleteTLSMutualAuthentication called but DeleteTLSMutualAuthenticationFunc is nil")
+	}
+	return mock.DeleteTLSMutualAuthenticationFunc(ctx, i)
+}
+
+// DeleteTLSSubscription calls DeleteTLSSubscriptionFunc.
+func (mock *MockAPI) DeleteTLSSubscription(ctx context.Context, i *fastly.DeleteTLSSubscriptionInput) error {
+	if mock.DeleteTLSSubscriptionFunc == nil {
+		panic("fastlytest: MockAPI.DeleteTLSSubscription called but DeleteTLSSubscriptionFunc is nil")
+	}
+	return mock.DeleteTLSSubscriptionFunc(ctx, i)
+}
+
+// DeleteToken calls DeleteTokenFunc.
+func (mock *MockAPI) DeleteToken(ctx context.Context, i *fastly.DeleteTokenInput) error {
+	if mock.DeleteTokenFunc == nil {
+		panic("fastlytest: MockAPI.DeleteToken called but DeleteTokenFunc is nil")
+	}
+	return mock.DeleteTokenFunc(ctx, i)
+}
+
+// DeleteTokenSelf calls DeleteTokenSelfFunc.
+func (mock *MockAPI) DeleteTokenSelf(ctx context.Context) error {
+	if mock.DeleteTokenSelfFunc == nil {
+		panic("fastlytest: MockAPI.DeleteTokenSelf called but DeleteTokenSelfFunc is nil")
+	}
+	return mock.DeleteTokenSelfFunc(ctx)
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *MockAPI) DeleteUser(ctx context.Context, i *fastly.DeleteUserInput) error {
+	if mock.DeleteUserFunc == nil {
+		panic("fastlytest: MockAPI.DeleteUser called but DeleteUserFunc is nil")
+	}
+	return mock.DeleteUserFunc(ctx, i)
+}
+
+// DeleteVCL calls DeleteVCLFunc.
+func (mock *MockAPI) DeleteVCL(ctx context.Context, i *fastly.DeleteVCLInput) error {
+	if mock.DeleteVCLFunc == nil {
+		panic("fastlytest: MockAPI.DeleteVCL called but DeleteVCLFunc is nil")
+	}
+	return mock.DeleteVCLFunc(ctx, i)
+}
+
+// DiffVersions calls DiffVersionsFunc.
+func (mock *MockAPI) DiffVersions(ctx context.Context, i *fastly.DiffVersionsInput) (*fastly.VersionDiff, error) {
+	if mock.DiffVersionsFunc == nil {
+		panic("fastlytest: MockAPI.DiffVersions called but DiffVersionsFunc is nil")
+	}
+	return mock.DiffVersionsFunc(ctx, i)
+}
+
+// DisableHTTP3 calls DisableHTTP3Func.
+func (mock *MockAPI) DisableHTTP3(ctx context.Context, i *fastly.DisableHTTP3Input) error {
+	if mock.DisableHTTP3Func == nil {
+		panic("fastlytest: MockAPI.DisableHTTP3 called but DisableHTTP3Func is nil")
+	}
+	return mock.DisableHTTP3Func(ctx, i)
+}
+
+// EdgeCheck calls EdgeCheckFunc.
+func (mock *MockAPI) EdgeCheck(ctx context.Context, i *fastly.EdgeCheckInput) ([]*fastly.EdgeCheck, error) {
+	if mock.EdgeCheckFunc == nil {
+		panic("fastlytest: MockAPI.EdgeCheck called but EdgeCheckFunc is nil")
+	}
+	return mock.EdgeCheckFunc(ctx, i)
+}
+
+// EnableHTTP3 calls EnableHTTP3Func.
+func (mock *MockAPI) EnableHTTP3(ctx context.Context, i *fastly.EnableHTTP3Input) (*fastly.HTTP3, error) {
+	if mock.EnableHTTP3Func == nil {
+		panic("fastlytest: MockAPI.EnableHTTP3 called but EnableHTTP3Func is nil")
+	}
+	return mock.EnableHTTP3Func(ctx, i)
+}
+
+// ExportServiceVersion calls ExportServiceVersionFunc.
+func (mock *MockAPI) ExportServiceVersion(ctx context.Context, i *fastly.ExportServiceVersionInput) (*fastly.ServiceVersionSnapshot, error) {
+	if mock.ExportServiceVersionFunc == nil {
+		panic("fastlytest: MockAPI.ExportServiceVersion called but ExportServiceVersionFunc is nil")
+	}
+	return mock.ExportServiceVersionFunc(ctx, i)
+}
+
+// ForEachService calls ForEachServiceFunc.
+func (mock *MockAPI) ForEachService(ctx context.Context, i *fastly.ForEachServiceInput) (*fastly.ForEachServiceReport, error) {
+	if mock.ForEachServiceFunc == nil {
+		panic("fastlytest: MockAPI.ForEachService called but ForEachServiceFunc is nil")
+	}
+	return mock.ForEachServiceFunc(ctx, i)
+}
+
+// Get calls GetFunc.
+func (mock *MockAPI) Get(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.GetFunc == nil {
+		panic("fastlytest: MockAPI.Get called but GetFunc is nil")
+	}
+	return mock.GetFunc(ctx, p, ro)
+}
+
+// GetACL calls GetACLFunc.
+func (mock *MockAPI) GetACL(ctx context.Context, i *fastly.GetACLInput) (*fastly.ACL, error) {
+	if mock.GetACLFunc == nil {
+		panic("fastlytest: MockAPI.GetACL called but GetACLFunc is nil")
+	}
+	return mock.GetACLFunc(ctx, i)
+}
+
+// GetACLEntries calls GetACLEntriesFunc.
+func (mock *MockAPI) GetACLEntries(ctx context.Context, i *fastly.GetACLEntriesInput) *fastly.ListPaginator[fastly.ACLEntry] {
+	if mock.GetACLEntriesFunc == nil {
+		panic("fastlytest: MockAPI.GetACLEntries called but GetACLEntriesFunc is nil")
+	}
+	return mock.GetACLEntriesFunc(ctx, i)
+}
+
+// GetACLEntry calls GetACLEntryFunc.
+func (mock *MockAPI) GetACLEntry(ctx context.Context, i *fastly.GetACLEntryInput) (*fastly.ACLEntry, error) {
+	if mock.GetACLEntryFunc == nil {
+		panic("fastlytest: MockAPI.GetACLEntry called but GetACLEntryFunc is nil")
+	}
+	return mock.GetACLEntryFunc(ctx, i)
+}
+
+// GetAPIEvent calls GetAPIEventFunc.
+func (mock *MockAPI) GetAPIEvent(ctx context.Context, i *fastly.GetAPIEventInput) (*fastly.Event, error) {
+	if mock.GetAPIEventFunc == nil {
+		panic("fastlytest: MockAPI.GetAPIEvent called but GetAPIEventFunc is nil")
+	}
+	return mock.GetAPIEventFunc(ctx, i)
+}
+
+// GetAPIEvents calls GetAPIEventsFunc.
+func (mock *MockAPI) GetAPIEvents(ctx context.Context, i *fastly.GetAPIEventsFilterInput) (fastly.GetAPIEventsResponse, error) {
+	if mock.GetAPIEventsFunc == nil {
+		panic("fastlytest: MockAPI.GetAPIEvents called but GetAPIEventsFunc is nil")
+	}
+	return mock.GetAPIEventsFunc(ctx, i)
+}
+
+// GetAggregateJSON calls GetAggregateJSONFunc.
+func (mock *MockAPI) GetAggregateJSON(ctx context.Context, i *fastly.GetAggregateInput, dst any) error {
+	if mock.GetAggregateJSONFunc == nil {
+		panic("fastlytest: MockAPI.GetAggregateJSON called but GetAggregateJSONFunc is nil")
+	}
+	return mock.GetAggregateJSONFunc(ctx, i, dst)
+}
+
+// GetAlertDefinition calls GetAlertDefinitionFunc.
+func (mock *MockAPI) GetAlertDefinition(ctx context.Context, i *fastly.GetAlertDefinitionInput) (*fastly.AlertDefinition, error) {
+	if mock.GetAlertDefinitionFunc == nil {
+		panic("fastlytest: MockAPI.GetAlertDefinition called but GetAlertDefinitionFunc is nil")
+	}
+	return mock.GetAlertDefinitionFunc(ctx, i)
+}
+
+// GetAutomationToken calls GetAutomationTokenFunc.
+func (mock *MockAPI) GetAutomationToken(ctx context.Context, i *fastly.GetAutomationTokenInput) (*fastly.AutomationToken, error) {
+	if mock.GetAutomationTokenFunc == nil {
+		panic("fastlytest: MockAPI.GetAutomationToken called but GetAutomationTokenFunc is nil")
+	}
+	return mock.GetAutomationTokenFunc(ctx, i)
+}
+
+// GetAutomationTokens calls GetAutomationTokensFunc.
+func (mock *MockAPI) GetAutomationTokens(ctx context.Context, i *fastly.GetAutomationTokensInput) *fastly.ListPaginator[fastly.AutomationTokenPaginator] {
+	if mock.GetAutomationTokensFunc == nil {
+		panic("fastlytest: MockAPI.GetAutomationTokens called but GetAutomationTokensFunc is nil")
+	}
+	return mock.GetAutomationTokensFunc(ctx, i)
+}
+
+// GetBackend calls GetBackendFunc.
+func (mock *MockAPI) GetBackend(ctx context.Context, i *fastly.GetBackendInput) (*fastly.Backend, error) {
+	if mock.GetBackendFunc == nil {
+		panic("fastlytest: MockAPI.GetBackend called but GetBackendFunc is nil")
+	}
+	return mock.GetBackendFunc(ctx, i)
+}
+
+// GetBigQuery calls GetBigQueryFunc.
+func (mock *MockAPI) GetBigQuery(ctx context.Context, i *fastly.GetBigQueryInput) (*fastly.BigQuery, error) {
+	if mock.GetBigQueryFunc == nil {
+		panic("fastlytest: MockAPI.GetBigQuery called but GetBigQueryFunc is nil")
+	}
+	return mock.GetBigQueryFunc(ctx, i)
+}
+
+// GetBilling calls GetBillingFunc.
+func (mock *MockAPI) GetBilling(ctx context.Context, i *fastly.GetBillingInput) (*fastly.Billing, error) {
+	if mock.GetBillingFunc == nil {
+		panic("fastlytest: MockAPI.GetBilling called but GetBillingFunc is nil")
+	}
+	return mock.GetBillingFunc(ctx, i)
+}
+
+// GetBlobStorage calls GetBlobStorageFunc.
+func (mock *MockAPI) GetBlobStorage(ctx context.Context, i *fastly.GetBlobStorageInput) (*fastly.BlobStorage, error) {
+	if mock.GetBlobStorageFunc == nil {
+		panic("fastlytest: MockAPI.GetBlobStorage called but GetBlobStorageFunc is nil")
+	}
+	return mock.GetBlobStorageFunc(ctx, i)
+}
+
+// GetBulkCertificate calls GetBulkCertificateFunc.
+func (mock *MockAPI) GetBulkCertificate(ctx context.Context, i *fastly.GetBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	if mock.GetBulkCertificateFunc == nil {
+		panic("fastlytest: MockAPI.GetBulkCertificate called but GetBulkCertificateFunc is nil")
+	}
+	return mock.GetBulkCertificateFunc(ctx, i)
+}
+
+// GetCacheSetting calls GetCacheSettingFunc.
+func (mock *MockAPI) GetCacheSetting(ctx context.Context, i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	if mock.GetCacheSettingFunc == nil {
+		panic("fastlytest: MockAPI.GetCacheSetting called but GetCacheSettingFunc is nil")
+	}
+	return mock.GetCacheSettingFunc(ctx, i)
+}
+
+// GetCloudfiles calls GetCloudfilesFunc.
+func (mock *MockAPI) GetCloudfiles(ctx context.Context, i *fastly.GetCloudfilesInput) (*fastly.Cloudfiles, error) {
+	if mock.GetCloudfilesFunc == nil {
+		panic("fastlytest: MockAPI.GetCloudfiles called but GetCloudfilesFunc is nil")
+	}
+	return mock.GetCloudfilesFunc(ctx, i)
+}
+
+// GetCondition calls GetConditionFunc.
+func (mock *MockAPI) GetCondition(ctx context.Context, i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	if mock.GetConditionFunc == nil {
+		panic("fastlytest: MockAPI.GetCondition called but GetConditionFunc is nil")
+	}
+	return mock.GetConditionFunc(ctx, i)
+}
+
+// GetConfigStore calls GetConfigStoreFunc.
+func (mock *MockAPI) GetConfigStore(ctx context.Context, i *fastly.GetConfigStoreInput) (*fastly.ConfigStore, error) {
+	if mock.GetConfigStoreFunc == nil {
+		panic("fastlytest: MockAPI.GetConfigStore called but GetConfigStoreFunc is nil")
+	}
+	return mock.GetConfigStoreFunc(ctx, i)
+}
+
+// GetConfigStoreItem calls GetConfigStoreItemFunc.
+func (mock *MockAPI) GetConfigStoreItem(ctx context.Context, i *fastly.GetConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	if mock.GetConfigStoreItemFunc == nil {
+		panic("fastlytest: MockAPI.GetConfigStoreItem called but GetConfigStoreItemFunc is nil")
+	}
+	return mock.GetConfigStoreItemFunc(ctx, i)
+}
+
+// GetConfigStoreMetadata calls GetConfigStoreMetadataFunc.
+func (mock *MockAPI) GetConfigStoreMetadata(ctx context.Context, i *fastly.GetConfigStoreMetadataInput) (*fastly.ConfigStoreMetadata, error) {
+	if mock.GetConfigStoreMetadataFunc == nil {
+		panic("fastlytest: MockAPI.GetConfigStoreMetadata called but GetConfigStoreMetadataFunc is nil")
+	}
+	return mock.GetConfigStoreMetadataFunc(ctx, i)
+}
+
+// GetCurrentUser calls GetCurrentUserFunc.
+func (mock *MockAPI) GetCurrentUser(ctx context.Context) (*fastly.User, error) {
+	if mock.GetCurrentUserFunc == nil {
+		panic("fastlytest: MockAPI.GetCurrentUser called but GetCurrentUserFunc is nil")
+	}
+	return mock.GetCurrentUserFunc(ctx)
+}
+
+// GetCustomTLSCertificate calls GetCustomTLSCertificateFunc.
+func (mock *MockAPI) GetCustomTLSCertificate(ctx context.Context, i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	if mock.GetCustomTLSCertificateFunc == nil {
+		panic("fastlytest: MockAPI.GetCustomTLSCertificate called but GetCustomTLSCertificateFunc is nil")
+	}
+	return mock.GetCustomTLSCertificateFunc(ctx, i)
+}
+
+// GetCustomTLSConfiguration calls GetCustomTLSConfigurationFunc.
+func (mock *MockAPI) GetCustomTLSConfiguration(ctx context.Context, i *fastly.GetCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error) {
+	if mock.GetCustomTLSConfigurationFunc == nil {
+		panic("fastlytest: MockAPI.GetCustomTLSConfiguration called but GetCustomTLSConfigurationFunc is nil")
+	}
+	return mock.GetCustomTLSConfigurationFunc(ctx, i)
+}
+
+// GetDatadog calls GetDatadogFunc.
+func (mock *MockAPI) GetDatadog(ctx context.Context, i *fastly.GetDatadogInput) (*fastly.Datadog, error) {
+	if mock.GetDatadogFunc == nil {
+		panic("fastlytest: MockAPI.GetDatadog called but GetDatadogFunc is nil")
+	}
+	return mock.GetDatadogFunc(ctx, i)
+}
+
+// GetDictionary calls GetDictionaryFunc.
+func (mock *MockAPI) GetDictionary(ctx context.Context, i *fastly.GetDictionaryInput) (*fastly.Dictionary, error) {
+	if mock.GetDictionaryFunc == nil {
+		panic("fastlytest: MockAPI.GetDictionary called but GetDictionaryFunc is nil")
+	}
+	return mock.GetDictionaryFunc(ctx, i)
+}
+
+// GetDictionaryInfo calls GetDictionaryInfoFunc.
+func (mock *MockAPI) GetDictionaryInfo(ctx context.Context, i *fastly.GetDictionaryInfoInput) (*fastly.DictionaryInfo, error) {
+	if mock.GetDictionaryInfoFunc == nil {
+		panic("fastlytest: MockAPI.GetDictionaryInfo called but GetDictionaryInfoFunc is nil")
+	}
+	return mock.GetDictionaryInfoFunc(ctx, i)
+}
+
+// GetDictionaryItem calls GetDictionaryItemFunc.
+func (mock *MockAPI) GetDictionaryItem(ctx context.Context, i *fastly.GetDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	if mock.GetDictionaryItemFunc == nil {
+		panic("fastlytest: MockAPI.GetDictionaryItem called but GetDictionaryItemFunc is nil")
+	}
+	return mock.GetDictionaryItemFunc(ctx, i)
+}
+
+// GetDictionaryItems calls GetDictionaryItemsFunc.
+func (mock *MockAPI) GetDictionaryItems(ctx context.Context, i *fastly.GetDictionaryItemsInput) *fastly.ListPaginator[fastly.DictionaryItem] {
+	if mock.GetDictionaryItemsFunc == nil {
+		panic("fastlytest: MockAPI.GetDictionaryItems called but GetDictionaryItemsFunc is nil")
+	}
+	return mock.GetDictionaryItemsFunc(ctx, i)
+}
+
+// GetDiff calls GetDiffFunc.
+func (mock *MockAPI) GetDiff(ctx context.Context, i *fastly.GetDiffInput) (*fastly.Diff, error) {
+	if mock.GetDiffFunc == nil {
+		panic("fastlytest: MockAPI.GetDiff called but GetDiffFunc is nil")
+	}
+	return mock.GetDiffFunc(ctx, i)
+}
+
+// GetDigitalOcean calls GetDigitalOceanFunc.
+func (mock *MockAPI) GetDigitalOcean(ctx context.Context, i *fastly.GetDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	if mock.GetDigitalOceanFunc == nil {
+		panic("fastlytest: MockAPI.GetDigitalOcean called but GetDigitalOceanFunc is nil")
+	}
+	return mock.GetDigitalOceanFunc(ctx, i)
+}
+
+// GetDirector calls GetDirectorFunc.
+func (mock *MockAPI) GetDirector(ctx context.Context, i *fastly.GetDirectorInput) (*fastly.Director, error) {
+	if mock.GetDirectorFunc == nil {
+		panic("fastlytest: MockAPI.GetDirector called but GetDirectorFunc is nil")
+	}
+	return mock.GetDirectorFunc(ctx, i)
+}
+
+// GetDirectorBackend calls GetDirectorBackendFunc.
+func (mock *MockAPI) GetDirectorBackend(ctx context.Context, i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	if mock.GetDirectorBackendFunc == nil {
+		panic("fastlytest: MockAPI.GetDirectorBackend called but GetDirectorBackendFunc is nil")
+	}
+	return mock.GetDirectorBackendFunc(ctx, i)
+}
+
+// GetDomain calls GetDomainFunc.
+func (mock *MockAPI) GetDomain(ctx context.Context, i *fastly.GetDomainInput) (*fastly.Domain, error) {
+	if mock.GetDomainFunc == nil {
+		panic("fastlytest: MockAPI.GetDomain called but GetDomainFunc is nil")
+	}
+	return mock.GetDomainFunc(ctx, i)
+}
+
+// GetDomainMetricsForService calls GetDomainMetricsForServiceFunc.
+func (mock *MockAPI) GetDomainMetricsForService(ctx context.Context, i *fastly.GetDomainMetricsInput) (*fastly.DomainInspector, error) {
+	if mock.GetDomainMetricsForServiceFunc == nil {
+		panic("fastlytest: MockAPI.GetDomainMetricsForService called but GetDomainMetricsForServiceFunc is nil")
+	}
+	return mock.GetDomainMetricsForServiceFunc(ctx, i)
+}
+
+// GetDomainMetricsForServiceJSON calls GetDomainMetricsForServiceJSONFunc.
+func (mock *MockAPI) GetDomainMetricsForServiceJSON(ctx context.Context, i *fastly.GetDomainMetricsInput, dst any) error {
+	if mock.GetDomainMetricsForServiceJSONFunc == nil {
+		panic("fastlytest: MockAPI.GetDomainMetricsForServiceJSON called but GetDomainMetricsForServiceJSONFunc is nil")
+	}
+	return mock.GetDomainMetricsForServiceJSONFunc(ctx, i, dst)
+}
+
+// GetDynamicSnippet calls GetDynamicSnippetFunc.
+func (mock *MockAPI) GetDynamicSnippet(ctx context.Context, i *fastly.GetDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	if mock.GetDynamicSnippetFunc == nil {
+		panic("fastlytest: MockAPI.GetDynamicSnippet called but GetDynamicSnippetFunc is nil")
+	}
+	return mock.GetDynamicSnippetFunc(ctx, i)
+}
+
+// GetERL calls GetERLFunc.
+func (mock *MockAPI) GetERL(ctx context.Context, i *fastly.GetERLInput) (*fastly.ERL, error) {
+	if mock.GetERLFunc == nil {
+		panic("fastlytest: MockAPI.GetERL called but GetERLFunc is nil")
+	}
+	return mock.GetERLFunc(ctx, i)
+}
+
+// GetElasticsearch calls GetElasticsearchFunc.
+func (mock *MockAPI) GetElasticsearch(ctx context.Context, i *fastly.GetElasticsearchInput) (*fastly.Elasticsearch, error) {
+	if mock.GetElasticsearchFunc == nil {
+		panic("fastlytest: MockAPI.GetElasticsearch called but GetElasticsearchFunc is nil")
+	}
+	return mock.GetElasticsearchFunc(ctx, i)
+}
+
+// GetFTP calls GetFTPFunc.
+func (mock *MockAPI) GetFTP(ctx context.Context, i *fastly.GetFTPInput) (*fastly.FTP, error) {
+	if mock.GetFTPFunc == nil {
+		panic("fastlytest: MockAPI.GetFTP called but GetFTPFunc is nil")
+	}
+	return mock.GetFTPFunc(ctx, i)
+}
+
+// GetGCS calls GetGCSFunc.
+func (mock *MockAPI) GetGCS(ctx context.Context, i *fastly.GetGCSInput) (*fastly.GCS, error) {
+	if mock.GetGCSFunc == nil {
+		panic("fastlytest: MockAPI.GetGCS called but GetGCSFunc is nil")
+	}
+	return mock.GetGCSFunc(ctx, i)
+}
+
+// GetGeneratedVCL calls GetGeneratedVCLFunc.
+func (mock *MockAPI) GetGeneratedVCL(ctx context.Context, i *fastly.GetGeneratedVCLInput) (*fastly.VCL, error) {
+	if mock.GetGeneratedVCLFunc == nil {
+		panic("fastlytest: MockAPI.GetGeneratedVCL called but GetGeneratedVCLFunc is nil")
+	}
+	return mock.GetGeneratedVCLFunc(ctx, i)
+}
+
+// GetGrafanaCloudLogs calls GetGrafanaCloudLogsFunc.
+func (mock *MockAPI) GetGrafanaCloudLogs(ctx context.Context, i *fastly.GetGrafanaCloudLogsInput) (*fastly.GrafanaCloudLogs, error) {
+	if mock.GetGrafanaCloudLogsFunc == nil {
+		panic("fastlytest: MockAPI.GetGrafanaCloudLogs called but GetGrafanaCloudLogsFunc is nil")
+	}
+	return mock.GetGrafanaCloudLogsFunc(ctx, i)
+}
+
+// GetGzip calls GetGzipFunc.
+func (mock *MockAPI) GetGzip(ctx context.Context, i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	if mock.GetGzipFunc == nil {
+		panic("fastlytest: MockAPI.GetGzip called but GetGzipFunc is nil")
+	}
+	return mock.GetGzipFunc(ctx, i)
+}
+
+// GetHTTP3 calls GetHTTP3Func.
+func (mock *MockAPI) GetHTTP3(ctx context.Context, i *fastly.GetHTTP3Input) (*fastly.HTTP3, error) {
+	if mock.GetHTTP3Func == nil {
+		panic("fastlytest: MockAPI.GetHTTP3 called but GetHTTP3Func is nil")
+	}
+	return mock.GetHTTP3Func(ctx, i)
+}
+
+// GetHTTPS calls GetHTTPSFunc.
+func (mock *MockAPI) GetHTTPS(ctx context.Context, i *fastly.GetHTTPSInput) (*fastly.HTTPS, error) {
+	if mock.GetHTTPSFunc == nil {
+		panic("fastlytest: MockAPI.GetHTTPS called but GetHTTPSFunc is nil")
+	}
+	return mock.GetHTTPSFunc(ctx, i)
+}
+
+// GetHeader calls GetHeaderFunc.
+func (mock *MockAPI) GetHeader(ctx context.Context, i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	if mock.GetHeaderFunc == nil {
+		panic("fastlytest: MockAPI.GetHeader called but GetHeaderFunc is nil")
+	}
+	return mock.GetHeaderFunc(ctx, i)
+}
+
+// GetHealthCheck calls GetHealthCheckFunc.
+func (mock *MockAPI) GetHealthCheck(ctx context.Context, i *fastly.GetHealthCheckInput) (*fastly.HealthCheck, error) {
+	if mock.GetHealthCheckFunc == nil {
+		panic("fastlytest: MockAPI.GetHealthCheck called but GetHealthCheckFunc is nil")
+	}
+	return mock.GetHealthCheckFunc(ctx, i)
+}
+
+// GetHeroku calls GetHerokuFunc.
+func (mock *MockAPI) GetHeroku(ctx context.Context, i *fastly.GetHerokuInput) (*fastly.Heroku, error) {
+	if mock.GetHerokuFunc == nil {
+		panic("fastlytest: MockAPI.GetHeroku called but GetHerokuFunc is nil")
+	}
+	return mock.GetHerokuFunc(ctx, i)
+}
+
+// GetHoneycomb calls GetHoneycombFunc.
+func (mock *MockAPI) GetHoneycomb(ctx context.Context, i *fastly.GetHoneycombInput) (*fastly.Honeycomb, error) {
+	if mock.GetHoneycombFunc == nil {
+		panic("fastlytest: MockAPI.GetHoneycomb called but GetHoneycombFunc is nil")
+	}
+	return mock.GetHoneycombFunc(ctx, i)
+}
+
+// GetImageOptimizerDefaultSettings calls GetImageOptimizerDefaultSettingsFunc.
+func (mock *MockAPI) GetImageOptimizerDefaultSettings(ctx context.Context, i *fastly.GetImageOptimizerDefaultSettingsInput) (*fastly.ImageOptimizerDefaultSettings, error) {
+	if mock.GetImageOptimizerDefaultSettingsFunc == nil {
+		panic("fastlytest: MockAPI.GetImageOptimizerDefaultSettings called but GetImageOptimizerDefaultSettingsFunc is nil")
+	}
+	return mock.GetImageOptimizerDefaultSettingsFunc(ctx, i)
+}
+
+// GetIntegration calls GetIntegrationFunc.
+func (mock *MockAPI) GetIntegration(ctx context.Context, i *fastly.GetIntegrationInput) (*fastly.Integration, error) {
+	if mock.GetIntegrationFunc == nil {
+		panic("fastlytest: MockAPI.GetIntegration called but GetIntegrationFunc is nil")
+	}
+	return mock.GetIntegrationFunc(ctx, i)
+}
+
+// GetIntegrationTypes calls GetIntegrationTypesFunc.
+func (mock *MockAPI) GetIntegrationTypes(ctx context.Context) (*[]fastly.IntegrationType, error) {
+	if mock.GetIntegrationTypesFunc == nil {
+		panic("fastlytest: MockAPI.GetIntegrationTypes called but GetIntegrationTypesFunc is nil")
+	}
+	return mock.GetIntegrationTypesFunc(ctx)
+}
+
+// GetJSON calls GetJSONFunc.
+func (mock *MockAPI) GetJSON(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.GetJSONFunc == nil {
+		panic("fastlytest: MockAPI.GetJSON called but GetJSONFunc is nil")
+	}
+	return mock.GetJSONFunc(ctx, p, ro)
+}
+
+// GetKVStore calls GetKVStoreFunc.
+func (mock *MockAPI) GetKVStore(ctx context.Context, i *fastly.GetKVStoreInput) (*fastly.KVStore, error) {
+	if mock.GetKVStoreFunc == nil {
+		panic("fastlytest: MockAPI.GetKVStore called but GetKVStoreFunc is nil")
+	}
+	return mock.GetKVStoreFunc(ctx, i)
+}
+
+// GetKVStoreItem calls GetKVStoreItemFunc.
+func (mock *MockAPI) GetKVStoreItem(ctx context.Context, i *fastly.GetKVStoreItemInput) (fastly.GetKVStoreItemOutput, error) {
+	if mock.GetKVStoreItemFunc == nil {
+		panic("fastlytest: MockAPI.GetKVStoreItem called but GetKVStoreItemFunc is nil")
+	}
+	return mock.GetKVStoreItemFunc(ctx, i)
+}
+
+// GetKVStoreKey calls GetKVStoreKeyFunc.
+func (mock *MockAPI) GetKVStoreKey(ctx context.Context, i *fastly.GetKVStoreKeyInput) (string, error) {
+	if mock.GetKVStoreKeyFunc == nil {
+		panic("fastlytest: MockAPI.GetKVStoreKey called but GetKVStoreKeyFunc is nil")
+	}
+	return mock.GetKVStoreKeyFunc(ctx, i)
+}
+
+// GetKafka calls GetKafkaFunc.
+func (mock *MockAPI) GetKafka(ctx context.Context, i *fastly.GetKafkaInput) (*fastly.Kafka, error) {
+	if mock.GetKafkaFunc == nil {
+		panic("fastlytest: MockAPI.GetKafka called but GetKafkaFunc is nil")
+	}
+	return mock.GetKafkaFunc(ctx, i)
+}
+
+// GetKinesis calls GetKinesisFunc.
+func (mock *MockAPI) GetKinesis(ctx context.Context, i *fastly.GetKinesisInput) (*fastly.Kinesis, error) {
+	if mock.GetKinesisFunc == nil {
+		panic("fastlytest: MockAPI.GetKinesis called but GetKinesisFunc is nil")
+	}
+	return mock.GetKinesisFunc(ctx, i)
+}
+
+// GetLogInsights calls GetLogInsightsFunc.
+func (mock *MockAPI) GetLogInsights(ctx context.Context, i *fastly.GetLogInsightsInput) (*fastly.LogInsightsResponse, error) {
+	if mock.GetLogInsightsFunc == nil {
+		panic("fastlytest: MockAPI.GetLogInsights called but GetLogInsightsFunc is nil")
+	}
+	return mock.GetLogInsightsFunc(ctx, i)
+}
+
+// GetLogRecords calls GetLogRecordsFunc.
+func (mock *MockAPI) GetLogRecords(ctx context.Context, i *fastly.GetLogRecordsInput) (*fastly.LogRecordsResponse, error) {
+	if mock.GetLogRecordsFunc == nil {
+		panic("fastlytest: MockAPI.GetLogRecords called but GetLogRecordsFunc is nil")
+	}
+	return mock.GetLogRecordsFunc(ctx, i)
+}
+
+// GetLogentries calls GetLogentriesFunc.
+func (mock *MockAPI) GetLogentries(ctx context.Context, i *fastly.GetLogentriesInput) (*fastly.Logentries, error) {
+	if mock.GetLogentriesFunc == nil {
+		panic("fastlytest: MockAPI.GetLogentries called but GetLogentriesFunc is nil")
+	}
+	return mock.GetLogentriesFunc(ctx, i)
+}
+
+// GetLoggingEndpointErrors calls GetLoggingEndpointErrorsFunc.
+func (mock *MockAPI) GetLoggingEndpointErrors(ctx context.Context, i *fastly.LoggingEndpointErrorsInput) (*fastly.LoggingEndpointErrorsResponse, error) {
+	if mock.GetLoggingEndpointErrorsFunc == nil {
+		panic("fastlytest: MockAPI.GetLoggingEndpointErrors called but GetLoggingEndpointErrorsFunc is nil")
+	}
+	return mock.GetLoggingEndpointErrorsFunc(ctx, i)
+}
+
+// GetLoggly calls GetLogglyFunc.
+func (mock *MockAPI) GetLoggly(ctx context.Context, i *fastly.GetLogglyInput) (*fastly.Loggly, error) {
+	if mock.GetLogglyFunc == nil {
+		panic("fastlytest: MockAPI.GetLoggly called but GetLogglyFunc is nil")
+	}
+	return mock.GetLogglyFunc(ctx, i)
+}
+
+// GetLogshuttle calls GetLogshuttleFunc.
+func (mock *MockAPI) GetLogshuttle(ctx context.Context, i *fastly.GetLogshuttleInput) (*fastly.Logshuttle, error) {
+	if mock.GetLogshuttleFunc == nil {
+		panic("fastlytest: MockAPI.GetLogshuttle called but GetLogshuttleFunc is nil")
+	}
+	return mock.GetLogshuttleFunc(ctx, i)
+}
+
+// GetNewRelic calls GetNewRelicFunc.
+func (mock *MockAPI) GetNewRelic(ctx context.Context, i *fastly.GetNewRelicInput) (*fastly.NewRelic, error) {
+	if mock.GetNewRelicFunc == nil {
+		panic("fastlytest: MockAPI.GetNewRelic called but GetNewRelicFunc is nil")
+	}
+	return mock.GetNewRelicFunc(ctx, i)
+}
+
+// GetNewRelicOTLP calls GetNewRelicOTLPFunc.
+func (mock *MockAPI) GetNewRelicOTLP(ctx context.Context, i *fastly.GetNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	if mock.GetNewRelicOTLPFunc == nil {
+		panic("fastlytest: MockAPI.GetNewRelicOTLP called but GetNewRelicOTLPFunc is nil")
+	}
+	return mock.GetNewRelicOTLPFunc(ctx, i)
+}
+
+// GetObservabilityCustomDashboard calls GetObservabilityCustomDashboardFunc.
+func (mock *MockAPI) GetObservabilityCustomDashboard(ctx context.Context, i *fastly.GetObservabilityCustomDashboardInput) (*fastly.ObservabilityCustomDashboard, error) {
+	if mock.GetObservabilityCustomDashboardFunc == nil {
+		panic("fastlytest: MockAPI.GetObservabilityCustomDashboard called but GetObservabilityCustomDashboardFunc is nil")
+	}
+	return mock.GetObservabilityCustomDashboardFunc(ctx, i)
+}
+
+// GetOpenstack calls GetOpenstackFunc.
+func (mock *MockAPI) GetOpenstack(ctx context.Context, i *fastly.GetOpenstackInput) (*fastly.Openstack, error) {
+	if mock.GetOpenstackFunc == nil {
+		panic("fastlytest: MockAPI.GetOpenstack called but GetOpenstackFunc is nil")
+	}
+	return mock.GetOpenstackFunc(ctx, i)
+}
+
+// GetOriginMetricsForService calls GetOriginMetricsForServiceFunc.
+func (mock *MockAPI) GetOriginMetricsForService(ctx context.Context, i *fastly.GetOriginMetricsInput) (*fastly.OriginInspector, error) {
+	if mock.GetOriginMetricsForServiceFunc == nil {
+		panic("fastlytest: MockAPI.GetOriginMetricsForService called but GetOriginMetricsForServiceFunc is nil")
+	}
+	return mock.GetOriginMetricsForServiceFunc(ctx, i)
+}
+
+// GetOriginMetricsForServiceJSON calls GetOriginMetricsForServiceJSONFunc.
+func (mock *MockAPI) GetOriginMetricsForServiceJSON(ctx context.Context, i *fastly.GetOriginMetricsInput, dst any) error {
+	if mock.GetOriginMetricsForServiceJSONFunc == nil {
+		panic("fastlytest: MockAPI.GetOriginMetricsForServiceJSON called but GetOriginMetricsForServiceJSONFunc is nil")
+	}
+	return mock.GetOriginMetricsForServiceJSONFunc(ctx, i, dst)
+}
+
+// GetPackage calls GetPackageFunc.
+func (mock *MockAPI) GetPackage(ctx context.Context, i *fastly.GetPackageInput) (*fastly.Package, error) {
+	if mock.GetPackageFunc == nil {
+		panic("fastlytest: MockAPI.GetPackage called but GetPackageFunc is nil")
+	}
+	return mock.GetPackageFunc(ctx, i)
+}
+
+// GetPapertrail calls GetPapertrailFunc.
+func (mock *MockAPI) GetPapertrail(ctx context.Context, i *fastly.GetPapertrailInput) (*fastly.Papertrail, error) {
+	if mock.GetPapertrailFunc == nil {
+		panic("fastlytest: MockAPI.GetPapertrail called but GetPapertrailFunc is nil")
+	}
+	return mock.GetPapertrailFunc(ctx, i)
+}
+
+// GetPool calls GetPoolFunc.
+func (mock *MockAPI) GetPool(ctx context.Context, i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	if mock.GetPoolFunc == nil {
+		panic("fastlytest: MockAPI.GetPool called but GetPoolFunc is nil")
+	}
+	return mock.GetPoolFunc(ctx, i)
+}
+
+// GetPrivateKey calls GetPrivateKeyFunc.
+func (mock *MockAPI) GetPrivateKey(ctx context.Context, i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error) {
+	if mock.GetPrivateKeyFunc == nil {
+		panic("fastlytest: MockAPI.GetPrivateKey called but GetPrivateKeyFunc is nil")
+	}
+	return mock.GetPrivateKeyFunc(ctx, i)
+}
+
+// GetPubsub calls GetPubsubFunc.
+func (mock *MockAPI) GetPubsub(ctx context.Context, i *fastly.GetPubsubInput) (*fastly.Pubsub, error) {
+	if mock.GetPubsubFunc == nil {
+		panic("fastlytest: MockAPI.GetPubsub called but GetPubsubFunc is nil")
+	}
+	return mock.GetPubsubFunc(ctx, i)
+}
+
+// GetRegions calls GetRegionsFunc.
+func (mock *MockAPI) GetRegions(ctx context.Context) (*fastly.RegionsResponse, error) {
+	if mock.GetRegionsFunc == nil {
+		panic("fastlytest: MockAPI.GetRegions called but GetRegionsFunc is nil")
+	}
+	return mock.GetRegionsFunc(ctx)
+}
+
+// GetRequestSetting calls GetRequestSettingFunc.
+func (mock *MockAPI) GetRequestSetting(ctx context.Context, i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	if mock.GetRequestSettingFunc == nil {
+		panic("fastlytest: MockAPI.GetRequestSetting called but GetRequestSettingFunc is nil")
+	}
+	return mock.GetRequestSettingFunc(ctx, i)
+}
+
+// GetResource calls GetResourceFunc.
+func (mock *MockAPI) GetResource(ctx context.Context, i *fastly.GetResourceInput) (*fastly.Resource, error) {
+	if mock.GetResourceFunc == nil {
+		panic("fastlytest: MockAPI.GetResource called but GetResourceFunc is nil")
+	}
+	return mock.GetResourceFunc(ctx, i)
+}
+
+// GetResponseObject calls GetResponseObjectFunc.
+func (mock *MockAPI) GetResponseObject(ctx context.Context, i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	if mock.GetResponseObjectFunc == nil {
+		panic("fastlytest: MockAPI.GetResponseObject called but GetResponseObjectFunc is nil")
+	}
+	return mock.GetResponseObjectFunc(ctx, i)
+}
+
+// GetS3 calls GetS3Func.
+func (mock *MockAPI) GetS3(ctx context.Context, i *fastly.GetS3Input) (*fastly.S3, error) {
+	if mock.GetS3Func == nil {
+		panic("fastlytest: MockAPI.GetS3 called but GetS3Func is nil")
+	}
+	return mock.GetS3Func(ctx, i)
+}
+
+// GetSFTP calls GetSFTPFunc.
+func (mock *MockAPI) GetSFTP(ctx context.Context, i *fastly.GetSFTPInput) (*fastly.SFTP, error) {
+	if mock.GetSFTPFunc == nil {
+		panic("fastlytest: MockAPI.GetSFTP called but GetSFTPFunc is nil")
+	}
+	return mock.GetSFTPFunc(ctx, i)
+}
+
+// GetScalyr calls GetScalyrFunc.
+func (mock *MockAPI) GetScalyr(ctx context.Context, i *fastly.GetScalyrInput) (*fastly.Scalyr, error) {
+	if mock.GetScalyrFunc == nil {
+		panic("fastlytest: MockAPI.GetScalyr called but GetScalyrFunc is nil")
+	}
+	return mock.GetScalyrFunc(ctx, i)
+}
+
+// GetSecret calls GetSecretFunc.
+func (mock *MockAPI) GetSecret(ctx context.Context, i *fastly.GetSecretInput) (*fastly.Secret, error) {
+	if mock.GetSecretFunc == nil {
+		panic("fastlytest: MockAPI.GetSecret called but GetSecretFunc is nil")
+	}
+	return mock.GetSecretFunc(ctx, i)
+}
+
+// GetSecretStore calls GetSecretStoreFunc.
+func (mock *MockAPI) GetSecretStore(ctx context.Context, i *fastly.GetSecretStoreInput) (*fastly.SecretStore, error) {
+	if mock.GetSecretStoreFunc == nil {
+		panic("fastlytest: MockAPI.GetSecretStore called but GetSecretStoreFunc is nil")
+	}
+	return mock.GetSecretStoreFunc(ctx, i)
+}
+
+// GetServer calls GetServerFunc.
+func (mock *MockAPI) GetServer(ctx context.Context, i *fastly.GetServerInput) (*fastly.Server, error) {
+	if mock.GetServerFunc == nil {
+		panic("fastlytest: MockAPI.GetServer called but GetServerFunc is nil")
+	}
+	return mock.GetServerFunc(ctx, i)
+}
+
+// GetService calls GetServiceFunc.
+func (mock *MockAPI) GetService(ctx context.Context, i *fastly.GetServiceInput) (*fastly.Service, error) {
+	if mock.GetServiceFunc == nil {
+		panic("fastlytest: MockAPI.GetService called but GetServiceFunc is nil")
+	}
+	return mock.GetServiceFunc(ctx, i)
+}
+
+// GetServiceAuthorization calls GetServiceAuthorizationFunc.
+func (mock *MockAPI) GetServiceAuthorization(ctx context.Context, i *fastly.GetServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	if mock.GetServiceAuthorizationFunc == nil {
+		panic("fastlytest: MockAPI.GetServiceAuthorization called but GetServiceAuthorizationFunc is nil")
+	}
+	return mock.GetServiceAuthorizationFunc(ctx, i)
+}
+
+// GetServiceConfig calls GetServiceConfigFunc.
+func (mock *MockAPI) GetServiceConfig(ctx context.Context, i *fastly.GetServiceConfigInput) (*fastly.ServiceConfig, error) {
+	if mock.GetServiceConfigFunc == nil {
+		panic("fastlytest: MockAPI.GetServiceConfig called but GetServiceConfigFunc is nil")
+	}
+	return mock.GetServiceConfigFunc(ctx, i)
+}
+
+// GetServiceDetails calls GetServiceDetailsFunc.
+func (mock *MockAPI) GetServiceDetails(ctx context.Context, i *fastly.GetServiceDetailsInput) (*fastly.ServiceDetail, error) {
+	if mock.GetServiceDetailsFunc == nil {
+		panic("fastlytest: MockAPI.GetServiceDetails called but GetServiceDetailsFunc is nil")
+	}
+	return mock.GetServiceDetailsFunc(ctx, i)
+}
+
+// GetServices calls GetServicesFunc.
+func (mock *MockAPI) GetServices(ctx context.Context, i *fastly.GetServicesInput) *fastly.ListPaginator[fastly.Service] {
+	if mock.GetServicesFunc == nil {
+		panic("fastlytest: MockAPI.GetServices called but GetServicesFunc is nil")
+	}
+	return mock.GetServicesFunc(ctx, i)
+}
+
+// GetSettings calls GetSettingsFunc.
+func (mock *MockAPI) GetSettings(ctx context.Context, i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	if mock.GetSettingsFunc == nil {
+		panic("fastlytest: MockAPI.GetSettings called but GetSettingsFunc is nil")
+	}
+	return mock.GetSettingsFunc(ctx, i)
+}
+
+// GetSigningKey calls GetSigningKeyFunc.
+func (mock *MockAPI) GetSigningKey(ctx context.Context) (ed25519.PublicKey, error) {
+	if mock.GetSigningKeyFunc == nil {
+		panic("fastlytest: MockAPI.GetSigningKey called but GetSigningKeyFunc is nil")
+	}
+	return mock.GetSigningKeyFunc(ctx)
+}
+
+// GetSnippet calls GetSnippetFunc.
+func (mock *MockAPI) GetSnippet(ctx context.Context, i *fastly.GetSnippetInput) (*fastly.Snippet, error) {
+	if mock.GetSnippetFunc == nil {
+		panic("fastlytest: MockAPI.GetSnippet called but GetSnippetFunc is nil")
+	}
+	return mock.GetSnippetFunc(ctx, i)
+}
+
+// GetSplunk calls GetSplunkFunc.
+func (mock *MockAPI) GetSplunk(ctx context.Context, i *fastly.GetSplunkInput) (*fastly.Splunk, error) {
+	if mock.GetSplunkFunc == nil {
+		panic("fastlytest: MockAPI.GetSplunk called but GetSplunkFunc is nil")
+	}
+	return mock.GetSplunkFunc(ctx, i)
+}
+
+// GetStats calls GetStatsFunc.
+func (mock *MockAPI) GetStats(ctx context.Context, i *fastly.GetStatsInput) (*fastly.StatsResponse, error) {
+	if mock.GetStatsFunc == nil {
+		panic("fastlytest: MockAPI.GetStats called but GetStatsFunc is nil")
+	}
+	return mock.GetStatsFunc(ctx, i)
+}
+
+// GetStatsField calls GetStatsFieldFunc.
+func (mock *MockAPI) GetStatsField(ctx context.Context, i *fastly.GetStatsInput) (*fastly.StatsFieldResponse, error) {
+	if mock.GetStatsFieldFunc == nil {
+		panic("fastlytest: MockAPI.GetStatsField called but GetStatsFieldFunc is nil")
+	}
+	return mock.GetStatsFieldFunc(ctx, i)
+}
+
+// GetStatsJSON calls GetStatsJSONFunc.
+func (mock *MockAPI) GetStatsJSON(ctx context.Context, i *fastly.GetStatsInput, dst any) error {
+	if mock.GetStatsJSONFunc == nil {
+		panic("fastlytest: MockAPI.GetStatsJSON called but GetStatsJSONFunc is nil")
+	}
+	return mock.GetStatsJSONFunc(ctx, i, dst)
+}
+
+// GetSumologic calls GetSumologicFunc.
+func (mock *MockAPI) GetSumologic(ctx context.Context, i *fastly.GetSumologicInput) (*fastly.Sumologic, error) {
+	if mock.GetSumologicFunc == nil {
+		panic("fastlytest: MockAPI.GetSumologic called but GetSumologicFunc is nil")
+	}
+	return mock.GetSumologicFunc(ctx, i)
+}
+
+// GetSyslog calls GetSyslogFunc.
+func (mock *MockAPI) GetSyslog(ctx context.Context, i *fastly.GetSyslogInput) (*fastly.Syslog, error) {
+	if mock.GetSyslogFunc == nil {
+		panic("fastlytest: MockAPI.GetSyslog called but GetSyslogFunc is nil")
+	}
+	return mock.GetSyslogFunc(ctx, i)
+}
+
+// GetTLSActivation calls GetTLSActivationFunc.
+func (mock *MockAPI) GetTLSActivation(ctx context.Context, i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+	if mock.GetTLSActivationFunc == nil {
+		panic("fastlytest: MockAPI.GetTLSActivation called but GetTLSActivationFunc is nil")
+	}
+	return mock.GetTLSActivationFunc(ctx, i)
+}
+
+// GetTLSMutualAuthentication calls GetTLSMutualAuthenticationFunc.
+func (mock *MockAPI) GetTLSMutualAuthentication(ctx context.Context, i *fastly.GetTLSMutualAuthenticationInput) (*fastly.TLSMutualAuthentication, error) {
+	if mock.GetTLSMutualAuthenticationFunc == nil {
+		panic("fastlytest: MockAPI.GetTLSMutualAuthentication called but GetTLSMutualAuthenticationFunc is nil")
+	}
+	return mock.GetTLSMutualAuthenticationFunc(ctx, i)
+}
+
+// GetTLSSubscription calls GetTLSSubscriptionFunc.
+func (mock *MockAPI) GetTLSSubscription(ctx context.Context, i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	if mock.GetTLSSubscriptionFunc == nil {
+		panic("fastlytest: MockAPI.GetTLSSubscription called but GetTLSSubscriptionFunc is nil")
+	}
+	return mock.GetTLSSubscriptionFunc(ctx, i)
+}
+
+// GetTokenSelf calls GetTokenSelfFunc.
+func (mock *MockAPI) GetTokenSelf(ctx context.Context) (*fastly.Token, error) {
+	if mock.GetTokenSelfFunc == nil {
+		panic("fastlytest: MockAPI.GetTokenSelf called but GetTokenSelfFunc is nil")
+	}
+	return mock.GetTokenSelfFunc(ctx)
+}
+
+// GetUsage calls GetUsageFunc.
+func (mock *MockAPI) GetUsage(ctx context.Context, i *fastly.GetUsageInput) (*fastly.UsageResponse, error) {
+	if mock.GetUsageFunc == nil {
+		panic("fastlytest: MockAPI.GetUsage called but GetUsageFunc is nil")
+	}
+	return mock.GetUsageFunc(ctx, i)
+}
+
+// GetUsageByService calls GetUsageByServiceFunc.
+func (mock *MockAPI) GetUsageByService(ctx context.Context, i *fastly.GetUsageInput) (*fastly.UsageByServiceResponse, error) {
+	if mock.GetUsageByServiceFunc == nil {
+		panic("fastlytest: MockAPI.GetUsageByService called but GetUsageByServiceFunc is nil")
+	}
+	return mock.GetUsageByServiceFunc(ctx, i)
+}
+
+// GetUser calls GetUserFunc.
+func (mock *MockAPI) GetUser(ctx context.Context, i *fastly.GetUserInput) (*fastly.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("fastlytest: MockAPI.GetUser called but GetUserFunc is nil")
+	}
+	return mock.GetUserFunc(ctx, i)
+}
+
+// GetVCL calls GetVCLFunc.
+func (mock *MockAPI) GetVCL(ctx context.Context, i *fastly.GetVCLInput) (*fastly.VCL, error) {
+	if mock.GetVCLFunc == nil {
+		panic("fastlytest: MockAPI.GetVCL called but GetVCLFunc is nil")
+	}
+	return mock.GetVCLFunc(ctx, i)
+}
+
+// GetVersion calls GetVersionFunc.
+func (mock *MockAPI) GetVersion(ctx context.Context, i *fastly.GetVersionInput) (*fastly.Version, error) {
+	if mock.GetVersionFunc == nil {
+		panic("fastlytest: MockAPI.GetVersion called but GetVersionFunc is nil")
+	}
+	return mock.GetVersionFunc(ctx, i)
+}
+
+// GetVersionInventory calls GetVersionInventoryFunc.
+func (mock *MockAPI) GetVersionInventory(ctx context.Context, i *fastly.GetVersionInventoryInput) (*fastly.VersionInventory, error) {
+	if mock.GetVersionInventoryFunc == nil {
+		panic("fastlytest: MockAPI.GetVersionInventory called but GetVersionInventoryFunc is nil")
+	}
+	return mock.GetVersionInventoryFunc(ctx, i)
+}
+
+// GetWebhookSigningKey calls GetWebhookSigningKeyFunc.
+func (mock *MockAPI) GetWebhookSigningKey(ctx context.Context, i *fastly.GetWebhookSigningKeyInput) (*fastly.WebhookSigningKeyResponse, error) {
+	if mock.GetWebhookSigningKeyFunc == nil {
+		panic("fastlytest: MockAPI.GetWebhookSigningKey called but GetWebhookSigningKeyFunc is nil")
+	}
+	return mock.GetWebhookSigningKeyFunc(ctx, i)
+}
+
+// Head calls HeadFunc.
+func (mock *MockAPI) Head(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.HeadFunc == nil {
+		panic("fastlytest: MockAPI.Head called but HeadFunc is nil")
+	}
+	return mock.HeadFunc(ctx, p, ro)
+}
+
+// IPs calls IPsFunc.
+func (mock *MockAPI) IPs(ctx context.Context) (fastly.IPAddrs, error) {
+	if mock.IPsFunc == nil {
+		panic("fastlytest: MockAPI.IPs called but IPsFunc is nil")
+	}
+	return mock.IPsFunc(ctx)
+}
+
+// IPsV6 calls IPsV6Func.
+func (mock *MockAPI) IPsV6(ctx context.Context) (fastly.IPAddrs, error) {
+	if mock.IPsV6Func == nil {
+		panic("fastlytest: MockAPI.IPsV6 called but IPsV6Func is nil")
+	}
+	return mock.IPsV6Func(ctx)
+}
+
+// InsertKVStoreKey calls InsertKVStoreKeyFunc.
+func (mock *MockAPI) InsertKVStoreKey(ctx context.Context, i *fastly.InsertKVStoreKeyInput) error {
+	if mock.InsertKVStoreKeyFunc == nil {
+		panic("fastlytest: MockAPI.InsertKVStoreKey called but InsertKVStoreKeyFunc is nil")
+	}
+	return mock.InsertKVStoreKeyFunc(ctx, i)
+}
+
+// LatestVersion calls LatestVersionFunc.
+func (mock *MockAPI) LatestVersion(ctx context.Context, i *fastly.LatestVersionInput) (*fastly.Version, error) {
+	if mock.LatestVersionFunc == nil {
+		panic("fastlytest: MockAPI.LatestVersion called but LatestVersionFunc is nil")
+	}
+	return mock.LatestVersionFunc(ctx, i)
+}
+
+// ListACLEntries calls ListACLEntriesFunc.
+func (mock *MockAPI) ListACLEntries(ctx context.Context, i *fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error) {
+	if mock.ListACLEntriesFunc == nil {
+		panic("fastlytest: MockAPI.ListACLEntries called but ListACLEntriesFunc is nil")
+	}
+	return mock.ListACLEntriesFunc(ctx, i)
+}
+
+// ListACLs calls ListACLsFunc.
+func (mock *MockAPI) ListACLs(ctx context.Context, i *fastly.ListACLsInput) ([]*fastly.ACL, error) {
+	if mock.ListACLsFunc == nil {
+		panic("fastlytest: MockAPI.ListACLs called but ListACLsFunc is nil")
+	}
+	return mock.ListACLsFunc(ctx, i)
+}
+
+// ListAlertDefinitions calls ListAlertDefinitionsFunc.
+func (mock *MockAPI) ListAlertDefinitions(ctx context.Context, i *fastly.ListAlertDefinitionsInput) (*fastly.AlertDefinitionsResponse, error) {
+	if mock.ListAlertDefinitionsFunc == nil {
+		panic("fastlytest: MockAPI.ListAlertDefinitions called but ListAlertDefinitionsFunc is nil")
+	}
+	return mock.ListAlertDefinitionsFunc(ctx, i)
+}
+
+// ListAlertHistory calls ListAlertHistoryFunc.
+func (mock *MockAPI) ListAlertHistory(ctx context.Context, i *fastly.ListAlertHistoryInput) (*fastly.AlertHistoryResponse, error) {
+	if mock.ListAlertHistoryFunc == nil {
+		panic("fastlytest: MockAPI.ListAlertHistory called but ListAlertHistoryFunc is nil")
+	}
+	return mock.ListAlertHistoryFunc(ctx, i)
+}
+
+// ListAutomationTokens calls ListAutomationTokensFunc.
+func (mock *MockAPI) ListAutomationTokens(ctx context.Context) ([]*fastly.AutomationToken, error) {
+	if mock.ListAutomationTokensFunc == nil {
+		panic("fastlytest: MockAPI.ListAutomationTokens called but ListAutomationTokensFunc is nil")
+	}
+	return mock.ListAutomationTokensFunc(ctx)
+}
+
+// ListBackends calls ListBackendsFunc.
+func (mock *MockAPI) ListBackends(ctx context.Context, i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	if mock.ListBackendsFunc == nil {
+		panic("fastlytest: MockAPI.ListBackends called but ListBackendsFunc is nil")
+	}
+	return mock.ListBackendsFunc(ctx, i)
+}
+
+// ListBigQueries calls ListBigQueriesFunc.
+func (mock *MockAPI) ListBigQueries(ctx context.Context, i *fastly.ListBigQueriesInput) ([]*fastly.BigQuery, error) {
+	if mock.ListBigQueriesFunc == nil {
+		panic("fastlytest: MockAPI.ListBigQueries called but ListBigQueriesFunc is nil")
+	}
+	return mock.ListBigQueriesFunc(ctx, i)
+}
+
+// ListBlobStorages calls ListBlobStoragesFunc.
+func (mock *MockAPI) ListBlobStorages(ctx context.Context, i *fastly.ListBlobStoragesInput) ([]*fastly.BlobStorage, error) {
+	if mock.ListBlobStoragesFunc == nil {
+		panic("fastlytest: MockAPI.ListBlobStorages called but ListBlobStoragesFunc is nil")
+	}
+	return mock.ListBlobStoragesFunc(ctx, i)
+}
+
+// ListBulkCertificates calls ListBulkCertificatesFunc.
+func (mock *MockAPI) ListBulkCertificates(ctx context.Context, i *fastly.ListBulkCertificatesInput) ([]*fastly.BulkCertificate, error) {
+	if mock.ListBulkCertificatesFunc == nil {
+		panic("fastlytest: MockAPI.ListBulkCertificates called but ListBulkCertificatesFunc is nil")
+	}
+	return mock.ListBulkCertificatesFunc(ctx, i)
+}
+
+// ListCacheSettings calls ListCacheSettingsFunc.
+func (mock *MockAPI) ListCacheSettings(ctx context.Context, i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	if mock.ListCacheSettingsFunc == nil {
+		panic("fastlytest: MockAPI.ListCacheSettings called but ListCacheSettingsFunc is nil")
+	}
+	return mock.ListCacheSettingsFunc(ctx, i)
+}
+
+// ListCloudfiles calls ListCloudfilesFunc.
+func (mock *MockAPI) ListCloudfiles(ctx context.Context, i *fastly.ListCloudfilesInput) ([]*fastly.Cloudfiles, error) {
+	if mock.ListCloudfilesFunc == nil {
+		panic("fastlytest: MockAPI.ListCloudfiles called but ListCloudfilesFunc is nil")
+	}
+	return mock.ListCloudfilesFunc(ctx, i)
+}
+
+// ListConditions calls ListConditionsFunc.
+func (mock *MockAPI) ListConditions(ctx context.Context, i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	if mock.ListConditionsFunc == nil {
+		panic("fastlytest: MockAPI.ListConditions called but ListConditionsFunc is nil")
+	}
+	return mock.ListConditionsFunc(ctx, i)
+}
+
+// ListConfigStoreItems calls ListConfigStoreItemsFunc.
+func (mock *MockAPI) ListConfigStoreItems(ctx context.Context, i *fastly.ListConfigStoreItemsInput) ([]*fastly.ConfigStoreItem, error) {
+	if mock.ListConfigStoreItemsFunc == nil {
+		panic("fastlytest: MockAPI.ListConfigStoreItems called but ListConfigStoreItemsFunc is nil")
+	}
+	return mock.ListConfigStoreItemsFunc(ctx, i)
+}
+
+// ListConfigStoreServices calls ListConfigStoreServicesFunc.
+func (mock *MockAPI) ListConfigStoreServices(ctx context.Context, i *fastly.ListConfigStoreServicesInput) ([]*fastly.Service, error) {
+	if mock.ListConfigStoreServicesFunc == nil {
+		panic("fastlytest: MockAPI.ListConfigStoreServices called but ListConfigStoreServicesFunc is nil")
+	}
+	return mock.ListConfigStoreServicesFunc(ctx, i)
+}
+
+// ListConfigStores calls ListConfigStoresFunc.
+func (mock *MockAPI) ListConfigStores(ctx context.Context, i *fastly.ListConfigStoresInput) ([]*fastly.ConfigStore, error) {
+	if mock.ListConfigStoresFunc == nil {
+		panic("fastlytest: MockAPI.ListConfigStores called but ListConfigStoresFunc is nil")
+	}
+	return mock.ListConfigStoresFunc(ctx, i)
+}
+
+// ListCustomTLSCertificates calls ListCustomTLSCertificatesFunc.
+func (mock *MockAPI) ListCustomTLSCertificates(ctx context.Context, i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	if mock.ListCustomTLSCertificatesFunc == nil {
+		panic("fastlytest: MockAPI.ListCustomTLSCertificates called but ListCustomTLSCertificatesFunc is nil")
+	}
+	return mock.ListCustomTLSCertificatesFunc(ctx, i)
+}
+
+// ListCustomTLSConfigurations calls ListCustomTLSConfigurationsFunc.
+func (mock *MockAPI) ListCustomTLSConfigurations(ctx context.Context, i *fastly.ListCustomTLSConfigurationsInput) ([]*fastly.CustomTLSConfiguration, error) {
+	if mock.ListCustomTLSConfigurationsFunc == nil {
+		panic("fastlytest: MockAPI.ListCustomTLSConfigurations called but ListCustomTLSConfigurationsFunc is nil")
+	}
+	return mock.ListCustomTLSConfigurationsFunc(ctx, i)
+}
+
+// ListCustomerTokens calls ListCustomerTokensFunc.
+func (mock *MockAPI) ListCustomerTokens(ctx context.Context, i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error) {
+	if mock.ListCustomerTokensFunc == nil {
+		panic("fastlytest: MockAPI.ListCustomerTokens called but ListCustomerTokensFunc is nil")
+	}
+	return mock.ListCustomerTokensFunc(ctx, i)
+}
+
+// ListCustomerUsers calls ListCustomerUsersFunc.
+func (mock *MockAPI) ListCustomerUsers(ctx context.Context, i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	if mock.ListCustomerUsersFunc == nil {
+		panic("fastlytest: MockAPI.ListCustomerUsers called but ListCustomerUsersFunc is nil")
+	}
+	return mock.ListCustomerUsersFunc(ctx, i)
+}
+
+// ListDatadog calls ListDatadogFunc.
+func (mock *MockAPI) ListDatadog(ctx context.Context, i *fastly.ListDatadogInput) ([]*fastly.Datadog, error) {
+	if mock.ListDatadogFunc == nil {
+		panic("fastlytest: MockAPI.ListDatadog called but ListDatadogFunc is nil")
+	}
+	return mock.ListDatadogFunc(ctx, i)
+}
+
+// ListDictionaries calls ListDictionariesFunc.
+func (mock *MockAPI) ListDictionaries(ctx context.Context, i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+	if mock.ListDictionariesFunc == nil {
+		panic("fastlytest: MockAPI.ListDictionaries called but ListDictionariesFunc is nil")
+	}
+	return mock.ListDictionariesFunc(ctx, i)
+}
+
+// ListDictionaryItems calls ListDictionaryItemsFunc.
+func (mock *MockAPI) ListDictionaryItems(ctx context.Context, i *fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error) {
+	if mock.ListDictionaryItemsFunc == nil {
+		panic("fastlytest: MockAPI.ListDictionaryItems called but ListDictionaryItemsFunc is nil")
+	}
+	return mock.ListDictionaryItemsFunc(ctx, i)
+}
+
+// ListDigitalOceans calls ListDigitalOceansFunc.
+func (mock *MockAPI) ListDigitalOceans(ctx context.Context, i *fastly.ListDigitalOceansInput) ([]*fastly.DigitalOcean, error) {
+	if mock.ListDigitalOceansFunc == nil {
+		panic("fastlytest: MockAPI.ListDigitalOceans called but ListDigitalOceansFunc is nil")
+	}
+	return mock.ListDigitalOceansFunc(ctx, i)
+}
+
+// ListDirectors calls ListDirectorsFunc.
+func (mock *MockAPI) ListDirectors(ctx context.Context, i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	if mock.ListDirectorsFunc == nil {
+		panic("fastlytest: MockAPI.ListDirectors called but ListDirectorsFunc is nil")
+	}
+	return mock.ListDirectorsFunc(ctx, i)
+}
+
+// ListDomains calls ListDomainsFunc.
+func (mock *MockAPI) ListDomains(ctx context.Context, i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+	if mock.ListDomainsFunc == nil {
+		panic("fastlytest: MockAPI.ListDomains called but ListDomainsFunc is nil")
+	}
+	return mock.ListDomainsFunc(ctx, i)
+}
+
+// ListERLs calls ListERLsFunc.
+func (mock *MockAPI) ListERLs(ctx context.Context, i *fastly.ListERLsInput) ([]*fastly.ERL, error) {
+	if mock.ListERLsFunc == nil {
+		panic("fastlytest: MockAPI.ListERLs called but ListERLsFunc is nil")
+	}
+	return mock.ListERLsFunc(ctx, i)
+}
+
+// ListElasticsearch calls ListElasticsearchFunc.
+func (mock *MockAPI) ListElasticsearch(ctx context.Context, i *fastly.ListElasticsearchInput) ([]*fastly.Elasticsearch, error) {
+	if mock.ListElasticsearchFunc == nil {
+		panic("fastlytest: MockAPI.ListElasticsearch called but ListElasticsearchFunc is nil")
+	}
+	return mock.ListElasticsearchFunc(ctx, i)
+}
+
+// ListFTPs calls ListFTPsFunc.
+func (mock *MockAPI) ListFTPs(ctx context.Context, i *fastly.ListFTPsInput) ([]*fastly.FTP, error) {
+	if mock.ListFTPsFunc == nil {
+		panic("fastlytest: MockAPI.ListFTPs called but ListFTPsFunc is nil")
+	}
+	return mock.ListFTPsFunc(ctx, i)
+}
+
+// ListGCSs calls ListGCSsFunc.
+func (mock *MockAPI) ListGCSs(ctx context.Context, i *fastly.ListGCSsInput) ([]*fastly.GCS, error) {
+	if mock.ListGCSsFunc == nil {
+		panic("fastlytest: MockAPI.ListGCSs called but ListGCSsFunc is nil")
+	}
+	return mock.ListGCSsFunc(ctx, i)
+}
+
+// ListGrafanaCloudLogs calls ListGrafanaCloudLogsFunc.
+func (mock *MockAPI) ListGrafanaCloudLogs(ctx context.Context, i *fastly.ListGrafanaCloudLogsInput) ([]*fastly.GrafanaCloudLogs, error) {
+	if mock.ListGrafanaCloudLogsFunc == nil {
+		panic("fastlytest: MockAPI.ListGrafanaCloudLogs called but ListGrafanaCloudLogsFunc is nil")
+	}
+	return mock.ListGrafanaCloudLogsFunc(ctx, i)
+}
+
+// ListGzips calls ListGzipsFunc.
+func (mock *MockAPI) ListGzips(ctx context.Context, i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	if mock.ListGzipsFunc == nil {
+		panic("fastlytest: MockAPI.ListGzips called but ListGzipsFunc is nil")
+	}
+	return mock.ListGzipsFunc(ctx, i)
+}
+
+// ListHTTPS calls ListHTTPSFunc.
+func (mock *MockAPI) ListHTTPS(ctx context.Context, i *fastly.ListHTTPSInput) ([]*fastly.HTTPS, error) {
+	if mock.ListHTTPSFunc == nil {
+		panic("fastlytest: MockAPI.ListHTTPS called but ListHTTPSFunc is nil")
+	}
+	return mock.ListHTTPSFunc(ctx, i)
+}
+
+// ListHeaders calls ListHeadersFunc.
+func (mock *MockAPI) ListHeaders(ctx context.Context, i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	if mock.ListHeadersFunc == nil {
+		panic("fastlytest: MockAPI.ListHeaders called but ListHeadersFunc is nil")
+	}
+	return mock.ListHeadersFunc(ctx, i)
+}
+
+// ListHealthChecks calls ListHealthChecksFunc.
+func (mock *MockAPI) ListHealthChecks(ctx context.Context, i *fastly.ListHealthChecksInput) ([]*fastly.HealthCheck, error) {
+	if mock.ListHealthChecksFunc == nil {
+		panic("fastlytest: MockAPI.ListHealthChecks called but ListHealthChecksFunc is nil")
+	}
+	return mock.ListHealthChecksFunc(ctx, i)
+}
+
+// ListHerokus calls ListHerokusFunc.
+func (mock *MockAPI) ListHerokus(ctx context.Context, i *fastly.ListHerokusInput) ([]*fastly.Heroku, error) {
+	if mock.ListHerokusFunc == nil {
+		panic("fastlytest: MockAPI.ListHerokus called but ListHerokusFunc is nil")
+	}
+	return mock.ListHerokusFunc(ctx, i)
+}
+
+// ListHoneycombs calls ListHoneycombsFunc.
+func (mock *MockAPI) ListHoneycombs(ctx context.Context, i *fastly.ListHoneycombsInput) ([]*fastly.Honeycomb, error) {
+	if mock.ListHoneycombsFunc == nil {
+		panic("fastlytest: MockAPI.ListHoneycombs called but ListHoneycombsFunc is nil")
+	}
+	return mock.ListHoneycombsFunc(ctx, i)
+}
+
+// ListKVStoreKeys calls ListKVStoreKeysFunc.
+func (mock *MockAPI) ListKVStoreKeys(ctx context.Context, i *fastly.ListKVStoreKeysInput) (*fastly.ListKVStoreKeysResponse, error) {
+	if mock.ListKVStoreKeysFunc == nil {
+		panic("fastlytest: MockAPI.ListKVStoreKeys called but ListKVStoreKeysFunc is nil")
+	}
+	return mock.ListKVStoreKeysFunc(ctx, i)
+}
+
+// ListKVStores calls ListKVStoresFunc.
+func (mock *MockAPI) ListKVStores(ctx context.Context, i *fastly.ListKVStoresInput) (*fastly.ListKVStoresResponse, error) {
+	if mock.ListKVStoresFunc == nil {
+		panic("fastlytest: MockAPI.ListKVStores called but ListKVStoresFunc is nil")
+	}
+	return mock.ListKVStoresFunc(ctx, i)
+}
+
+// ListKafkas calls ListKafkasFunc.
+func (mock *MockAPI) ListKafkas(ctx context.Context, i *fastly.ListKafkasInput) ([]*fastly.Kafka, error) {
+	if mock.ListKafkasFunc == nil {
+		panic("fastlytest: MockAPI.ListKafkas called but ListKafkasFunc is nil")
+	}
+	return mock.ListKafkasFunc(ctx, i)
+}
+
+// ListKinesis calls ListKinesisFunc.
+func (mock *MockAPI) ListKinesis(ctx context.Context, i *fastly.ListKinesisInput) ([]*fastly.Kinesis, error) {
+	if mock.ListKinesisFunc == nil {
+		panic("fastlytest: MockAPI.ListKinesis called but ListKinesisFunc is nil")
+	}
+	return mock.ListKinesisFunc(ctx, i)
+}
+
+// ListLogentries calls ListLogentriesFunc.
+func (mock *MockAPI) ListLogentries(ctx context.Context, i *fastly.ListLogentriesInput) ([]*fastly.Logentries, error) {
+	if mock.ListLogentriesFunc == nil {
+		panic("fastlytest: MockAPI.ListLogentries called but ListLogentriesFunc is nil")
+	}
+	return mock.ListLogentriesFunc(ctx, i)
+}
+
+// ListLoggly calls ListLogglyFunc.
+func (mock *MockAPI) ListLoggly(ctx context.Context, i *fastly.ListLogglyInput) ([]*fastly.Loggly, error) {
+	if mock.ListLogglyFunc == nil {
+		panic("fastlytest: MockAPI.ListLoggly called but ListLogglyFunc is nil")
+	}
+	return mock.ListLogglyFunc(ctx, i)
+}
+
+// ListLogshuttles calls ListLogshuttlesFunc.
+func (mock *MockAPI) ListLogshuttles(ctx context.Context, i *fastly.ListLogshuttlesInput) ([]*fastly.Logshuttle, error) {
+	if mock.ListLogshuttlesFunc == nil {
+		panic("fastlytest: MockAPI.ListLogshuttles called but ListLogshuttlesFunc is nil")
+	}
+	return mock.ListLogshuttlesFunc(ctx, i)
+}
+
+// ListNewRelic calls ListNewRelicFunc.
+func (mock *MockAPI) ListNewRelic(ctx context.Context, i *fastly.ListNewRelicInput) ([]*fastly.NewRelic, error) {
+	if mock.ListNewRelicFunc == nil {
+		panic("fastlytest: MockAPI.ListNewRelic called but ListNewRelicFunc is nil")
+	}
+	return mock.ListNewRelicFunc(ctx, i)
+}
+
+// ListNewRelicOTLP calls ListNewRelicOTLPFunc.
+func (mock *MockAPI) ListNewRelicOTLP(ctx context.Context, i *fastly.ListNewRelicOTLPInput) ([]*fastly.NewRelicOTLP, error) {
+	if mock.ListNewRelicOTLPFunc == nil {
+		panic("fastlytest: MockAPI.ListNewRelicOTLP called but ListNewRelicOTLPFunc is nil")
+	}
+	return mock.ListNewRelicOTLPFunc(ctx, i)
+}
+
+// ListObservabilityCustomDashboards calls ListObservabilityCustomDashboardsFunc.
+func (mock *MockAPI) ListObservabilityCustomDashboards(ctx context.Context, i *fastly.ListObservabilityCustomDashboardsInput) (*fastly.ListDashboardsResponse, error) {
+	if mock.ListObservabilityCustomDashboardsFunc == nil {
+		panic("fastlytest: MockAPI.ListObservabilityCustomDashboards called but ListObservabilityCustomDashboardsFunc is nil")
+	}
+	return mock.ListObservabilityCustomDashboardsFunc(ctx, i)
+}
+
+// ListOpenstack calls ListOpenstackFunc.
+func (mock *MockAPI) ListOpenstack(ctx context.Context, i *fastly.ListOpenstackInput) ([]*fastly.Openstack, error) {
+	if mock.ListOpenstackFunc == nil {
+		panic("fastlytest: MockAPI.ListOpenstack called but ListOpenstackFunc is nil")
+	}
+	return mock.ListOpenstackFunc(ctx, i)
+}
+
+// ListPapertrails calls ListPapertrailsFunc.
+func (mock *MockAPI) ListPapertrails(ctx context.Context, i *fastly.ListPapertrailsInput) ([]*fastly.Papertrail, error) {
+	if mock.ListPapertrailsFunc == nil {
+		panic("fastlytest: MockAPI.ListPapertrails called but ListPapertrailsFunc is nil")
+	}
+	return mock.ListPapertrailsFunc(ctx, i)
+}
+
+// ListPools calls ListPoolsFunc.
+func (mock *MockAPI) ListPools(ctx context.Context, i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	if mock.ListPoolsFunc == nil {
+		panic("fastlytest: MockAPI.ListPools called but ListPoolsFunc is nil")
+	}
+	return mock.ListPoolsFunc(ctx, i)
+}
+
+// ListPrivateKeys calls ListPrivateKeysFunc.
+func (mock *MockAPI) ListPrivateKeys(ctx context.Context, i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error) {
+	if mock.ListPrivateKeysFunc == nil {
+		panic("fastlytest: MockAPI.ListPrivateKeys called but ListPrivateKeysFunc is nil")
+	}
+	return mock.ListPrivateKeysFunc(ctx, i)
+}
+
+// ListPubsubs calls ListPubsubsFunc.
+func (mock *MockAPI) ListPubsubs(ctx context.Context, i *fastly.ListPubsubsInput) ([]*fastly.Pubsub, error) {
+	if mock.ListPubsubsFunc == nil {
+		panic("fastlytest: MockAPI.ListPubsubs called but ListPubsubsFunc is nil")
+	}
+	return mock.ListPubsubsFunc(ctx, i)
+}
+
+// ListRequestSettings calls ListRequestSettingsFunc.
+func (mock *MockAPI) ListRequestSettings(ctx context.Context, i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	if mock.ListRequestSettingsFunc == nil {
+		panic("fastlytest: MockAPI.ListRequestSettings called but ListRequestSettingsFunc is nil")
+	}
+	return mock.ListRequestSettingsFunc(ctx, i)
+}
+
+// ListResources calls ListResourcesFunc.
+func (mock *MockAPI) ListResources(ctx context.Context, i *fastly.ListResourcesInput) ([]*fastly.Resource, error) {
+	if mock.ListResourcesFunc == nil {
+		panic("fastlytest: MockAPI.ListResources called but ListResourcesFunc is nil")
+	}
+	return mock.ListResourcesFunc(ctx, i)
+}
+
+// ListResponseObjects calls ListResponseObjectsFunc.
+func (mock *MockAPI) ListResponseObjects(ctx context.Context, i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	if mock.ListResponseObjectsFunc == nil {
+		panic("fastlytest: MockAPI.ListResponseObjects called but ListResponseObjectsFunc is nil")
+	}
+	return mock.ListResponseObjectsFunc(ctx, i)
+}
+
+// ListS3s calls ListS3sFunc.
+func (mock *MockAPI) ListS3s(ctx context.Context, i *fastly.ListS3sInput) ([]*fastly.S3, error) {
+	if mock.ListS3sFunc == nil {
+		panic("fastlytest: MockAPI.ListS3s called but ListS3sFunc is nil")
+	}
+	return mock.ListS3sFunc(ctx, i)
+}
+
+// ListSFTPs calls ListSFTPsFunc.
+func (mock *MockAPI) ListSFTPs(ctx context.Context, i *fastly.ListSFTPsInput) ([]*fastly.SFTP, error) {
+	if mock.ListSFTPsFunc == nil {
+		panic("fastlytest: MockAPI.ListSFTPs called but ListSFTPsFunc is nil")
+	}
+	return mock.ListSFTPsFunc(ctx, i)
+}
+
+// ListScalyrs calls ListScalyrsFunc.
+func (mock *MockAPI) ListScalyrs(ctx context.Context, i *fastly.ListScalyrsInput) ([]*fastly.Scalyr, error) {
+	if mock.ListScalyrsFunc == nil {
+		panic("fastlytest: MockAPI.ListScalyrs called but ListScalyrsFunc is nil")
+	}
+	return mock.ListScalyrsFunc(ctx, i)
+}
+
+// ListSecretStores calls ListSecretStoresFunc.
+func (mock *MockAPI) ListSecretStores(ctx context.Context, i *fastly.ListSecretStoresInput) (*fastly.SecretStores, error) {
+	if mock.ListSecretStoresFunc == nil {
+		panic("fastlytest: MockAPI.ListSecretStores called but ListSecretStoresFunc is nil")
+	}
+	return mock.ListSecretStoresFunc(ctx, i)
+}
+
+// ListSecrets calls ListSecretsFunc.
+func (mock *MockAPI) ListSecrets(ctx context.Context, i *fastly.ListSecretsInput) (*fastly.Secrets, error) {
+	if mock.ListSecretsFunc == nil {
+		panic("fastlytest: MockAPI.ListSecrets called but ListSecretsFunc is nil")
+	}
+	return mock.ListSecretsFunc(ctx, i)
+}
+
+// ListServers calls ListServersFunc.
+func (mock *MockAPI) ListServers(ctx context.Context, i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	if mock.ListServersFunc == nil {
+		panic("fastlytest: MockAPI.ListServers called but ListServersFunc is nil")
+	}
+	return mock.ListServersFunc(ctx, i)
+}
+
+// ListServiceAuthorizations calls ListServiceAuthorizationsFunc.
+func (mock *MockAPI) ListServiceAuthorizations(ctx context.Context, i *fastly.ListServiceAuthorizationsInput) (*fastly.ServiceAuthorizations, error) {
+	if mock.ListServiceAuthorizationsFunc == nil {
+		panic("fastlytest: MockAPI.ListServiceAuthorizations called but ListServiceAuthorizationsFunc is nil")
+	}
+	return mock.ListServiceAuthorizationsFunc(ctx, i)
+}
+
+// ListServiceDomains calls ListServiceDomainsFunc.
+func (mock *MockAPI) ListServiceDomains(ctx context.Context, i *fastly.ListServiceDomainInput) (fastly.ServiceDomainsList, error) {
+	if mock.ListServiceDomainsFunc == nil {
+		panic("fastlytest: MockAPI.ListServiceDomains called but ListServiceDomainsFunc is nil")
+	}
+	return mock.ListServiceDomainsFunc(ctx, i)
+}
+
+// ListServices calls ListServicesFunc.
+func (mock *MockAPI) ListServices(ctx context.Context, i *fastly.ListServicesInput) ([]*fastly.Service, error) {
+	if mock.ListServicesFunc == nil {
+		panic("fastlytest: MockAPI.ListServices called but ListServicesFunc is nil")
+	}
+	return mock.ListServicesFunc(ctx, i)
+}
+
+// ListSnippets calls ListSnippetsFunc.
+func (mock *MockAPI) ListSnippets(ctx context.Context, i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error) {
+	if mock.ListSnippetsFunc == nil {
+		panic("fastlytest: MockAPI.ListSnippets called but ListSnippetsFunc is nil")
+	}
+	return mock.ListSnippetsFunc(ctx, i)
+}
+
+// ListSplunks calls ListSplunksFunc.
+func (mock *MockAPI) ListSplunks(ctx context.Context, i *fastly.ListSplunksInput) ([]*fastly.Splunk, error) {
+	if mock.ListSplunksFunc == nil {
+		panic("fastlytest: MockAPI.ListSplunks called but ListSplunksFunc is nil")
+	}
+	return mock.ListSplunksFunc(ctx, i)
+}
+
+// ListSumologics calls ListSumologicsFunc.
+func (mock *MockAPI) ListSumologics(ctx context.Context, i *fastly.ListSumologicsInput) ([]*fastly.Sumologic, error) {
+	if mock.ListSumologicsFunc == nil {
+		panic("fastlytest: MockAPI.ListSumologics called but ListSumologicsFunc is nil")
+	}
+	return mock.ListSumologicsFunc(ctx, i)
+}
+
+// ListSyslogs calls ListSyslogsFunc.
+func (mock *MockAPI) ListSyslogs(ctx context.Context, i *fastly.ListSyslogsInput) ([]*fastly.Syslog, error) {
+	if mock.ListSyslogsFunc == nil {
+		panic("fastlytest: MockAPI.ListSyslogs called but ListSyslogsFunc is nil")
+	}
+	return mock.ListSyslogsFunc(ctx, i)
+}
+
+// ListTLSActivations calls ListTLSActivationsFunc.
+func (mock *MockAPI) ListTLSActivations(ctx context.Context, i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	if mock.ListTLSActivationsFunc == nil {
+		panic("fastlytest: MockAPI.ListTLSActivations called but ListTLSActivationsFunc is nil")
+	}
+	return mock.ListTLSActivationsFunc(ctx, i)
+}
+
+// ListTLSDomains calls ListTLSDomainsFunc.
+func (mock *MockAPI) ListTLSDomains(ctx context.Context, i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	if mock.ListTLSDomainsFunc == nil {
+		panic("fastlytest: MockAPI.ListTLSDomains called but ListTLSDomainsFunc is nil")
+	}
+	return mock.ListTLSDomainsFunc(ctx, i)
+}
+
+// ListTLSMutualAuthentication calls ListTLSMutualAuthenticationFunc.
+func (mock *MockAPI) ListTLSMutualAuthentication(ctx context.Context, i *fastly.ListTLSMutualAuthenticationsInput) ([]*fastly.TLSMutualAuthentication, error) {
+	if mock.ListTLSMutualAuthenticationFunc == nil {
+		panic("fastlytest: MockAPI.ListTLSMutualAuthentication called but ListTLSMutualAuthenticationFunc is nil")
+	}
+	return mock.ListTLSMutualAuthenticationFunc(ctx, i)
+}
+
+// ListTLSSubscriptions calls ListTLSSubscriptionsFunc.
+func (mock *MockAPI) ListTLSSubscriptions(ctx context.Context, i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	if mock.ListTLSSubscriptionsFunc == nil {
+		panic("fastlytest: MockAPI.ListTLSSubscriptions called but ListTLSSubscriptionsFunc is nil")
+	}
+	return mock.ListTLSSubscriptionsFunc(ctx, i)
+}
+
+// ListTokens calls ListTokensFunc.
+func (mock *MockAPI) ListTokens(ctx context.Context, p1_0 *fastly.ListTokensInput) ([]*fastly.Token, error) {
+	if mock.ListTokensFunc == nil {
+		panic("fastlytest: MockAPI.ListTokens called but ListTokensFunc is nil")
+	}
+	return mock.ListTokensFunc(ctx, p1_0)
+}
+
+// ListVCLs calls ListVCLsFunc.
+func (mock *MockAPI) ListVCLs(ctx context.Context, i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+	if mock.ListVCLsFunc == nil {
+		panic("fastlytest: MockAPI.ListVCLs called but ListVCLsFunc is nil")
+	}
+	return mock.ListVCLsFunc(ctx, i)
+}
+
+// ListVersions calls ListVersionsFunc.
+func (mock *MockAPI) ListVersions(ctx context.Context, i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	if mock.ListVersionsFunc == nil {
+		panic("fastlytest: MockAPI.ListVersions called but ListVersionsFunc is nil")
+	}
+	return mock.ListVersionsFunc(ctx, i)
+}
+
+// LockVersion calls LockVersionFunc.
+func (mock *MockAPI) LockVersion(ctx context.Context, i *fastly.LockVersionInput) (*fastly.Version, error) {
+	if mock.LockVersionFunc == nil {
+		panic("fastlytest: MockAPI.LockVersion called but LockVersionFunc is nil")
+	}
+	return mock.LockVersionFunc(ctx, i)
+}
+
+// NewListKVStoreKeysPaginator calls NewListKVStoreKeysPaginatorFunc.
+func (mock *MockAPI) NewListKVStoreKeysPaginator(ctx context.Context, i *fastly.ListKVStoreKeysInput) fastly.PaginatorKVStoreEntries {
+	if mock.NewListKVStoreKeysPaginatorFunc == nil {
+		panic("fastlytest: MockAPI.NewListKVStoreKeysPaginator called but NewListKVStoreKeysPaginatorFunc is nil")
+	}
+	return mock.NewListKVStoreKeysPaginatorFunc(ctx, i)
+}
+
+// NewListKVStoresPaginator calls NewListKVStoresPaginatorFunc.
+func (mock *MockAPI) NewListKVStoresPaginator(ctx context.Context, i *fastly.ListKVStoresInput) *fastly.ListKVStoresPaginator {
+	if mock.NewListKVStoresPaginatorFunc == nil {
+		panic("fastlytest: MockAPI.NewListKVStoresPaginator called but NewListKVStoresPaginatorFunc is nil")
+	}
+	return mock.NewListKVStoresPaginatorFunc(ctx, i)
+}
+
+// Patch calls PatchFunc.
+func (mock *MockAPI) Patch(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PatchFunc == nil {
+		panic("fastlytest: MockAPI.Patch called but PatchFunc is nil")
+	}
+	return mock.PatchFunc(ctx, p, ro)
+}
+
+// PatchForm calls PatchFormFunc.
+func (mock *MockAPI) PatchForm(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PatchFormFunc == nil {
+		panic("fastlytest: MockAPI.PatchForm called but PatchFormFunc is nil")
+	}
+	return mock.PatchFormFunc(ctx, p, i, ro)
+}
+
+// PatchJSON calls PatchJSONFunc.
+func (mock *MockAPI) PatchJSON(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PatchJSONFunc == nil {
+		panic("fastlytest: MockAPI.PatchJSON called but PatchJSONFunc is nil")
+	}
+	return mock.PatchJSONFunc(ctx, p, i, ro)
+}
+
+// PatchJSONAPI calls PatchJSONAPIFunc.
+func (mock *MockAPI) PatchJSONAPI(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PatchJSONAPIFunc == nil {
+		panic("fastlytest: MockAPI.PatchJSONAPI called but PatchJSONAPIFunc is nil")
+	}
+	return mock.PatchJSONAPIFunc(ctx, p, i, ro)
+}
+
+// PlanServiceConfig calls PlanServiceConfigFunc.
+func (mock *MockAPI) PlanServiceConfig(ctx context.Context, i *fastly.PlanServiceConfigInput) (*fastly.ServiceConfigPlan, error) {
+	if mock.PlanServiceConfigFunc == nil {
+		panic("fastlytest: MockAPI.PlanServiceConfig called but PlanServiceConfigFunc is nil")
+	}
+	return mock.PlanServiceConfigFunc(ctx, i)
+}
+
+// Post calls PostFunc.
+func (mock *MockAPI) Post(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PostFunc == nil {
+		panic("fastlytest: MockAPI.Post called but PostFunc is nil")
+	}
+	return mock.PostFunc(ctx, p, ro)
+}
+
+// PostForm calls PostFormFunc.
+func (mock *MockAPI) PostForm(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PostFormFunc == nil {
+		panic("fastlytest: MockAPI.PostForm called but PostFormFunc is nil")
+	}
+	return mock.PostFormFunc(ctx, p, i, ro)
+}
+
+// PostJSON calls PostJSONFunc.
+func (mock *MockAPI) PostJSON(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PostJSONFunc == nil {
+		panic("fastlytest: MockAPI.PostJSON called but PostJSONFunc is nil")
+	}
+	return mock.PostJSONFunc(ctx, p, i, ro)
+}
+
+// PostJSONAPI calls PostJSONAPIFunc.
+func (mock *MockAPI) PostJSONAPI(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PostJSONAPIFunc == nil {
+		panic("fastlytest: MockAPI.PostJSONAPI called but PostJSONAPIFunc is nil")
+	}
+	return mock.PostJSONAPIFunc(ctx, p, i, ro)
+}
+
+// PostJSONAPIBulk calls PostJSONAPIBulkFunc.
+func (mock *MockAPI) PostJSONAPIBulk(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PostJSONAPIBulkFunc == nil {
+		panic("fastlytest: MockAPI.PostJSONAPIBulk called but PostJSONAPIBulkFunc is nil")
+	}
+	return mock.PostJSONAPIBulkFunc(ctx, p, i, ro)
+}
+
+// Purge calls PurgeFunc.
+func (mock *MockAPI) Purge(ctx context.Context, i *fastly.PurgeInput) (*fastly.Purge, error) {
+	if mock.PurgeFunc == nil {
+		panic("fastlytest: MockAPI.Purge called but PurgeFunc is nil")
+	}
+	return mock.PurgeFunc(ctx, i)
+}
+
+// PurgeAll calls PurgeAllFunc.
+func (mock *MockAPI) PurgeAll(ctx context.Context, i *fastly.PurgeAllInput) (*fastly.Purge, error) {
+	if mock.PurgeAllFunc == nil {
+		panic("fastlytest: MockAPI.PurgeAll called but PurgeAllFunc is nil")
+	}
+	return mock.PurgeAllFunc(ctx, i)
+}
+
+// PurgeKey calls PurgeKeyFunc.
+func (mock *MockAPI) PurgeKey(ctx context.Context, i *fastly.PurgeKeyInput) (*fastly.Purge, error) {
+	if mock.PurgeKeyFunc == nil {
+		panic("fastlytest: MockAPI.PurgeKey called but PurgeKeyFunc is nil")
+	}
+	return mock.PurgeKeyFunc(ctx, i)
+}
+
+// PurgeKeys calls PurgeKeysFunc.
+func (mock *MockAPI) PurgeKeys(ctx context.Context, i *fastly.PurgeKeysInput) (map[string]string, error) {
+	if mock.PurgeKeysFunc == nil {
+		panic("fastlytest: MockAPI.PurgeKeys called but PurgeKeysFunc is nil")
+	}
+	return mock.PurgeKeysFunc(ctx, i)
+}
+
+// Put calls PutFunc.
+func (mock *MockAPI) Put(ctx context.Context, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PutFunc == nil {
+		panic("fastlytest: MockAPI.Put called but PutFunc is nil")
+	}
+	return mock.PutFunc(ctx, p, ro)
+}
+
+// PutForm calls PutFormFunc.
+func (mock *MockAPI) PutForm(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PutFormFunc == nil {
+		panic("fastlytest: MockAPI.PutForm called but PutFormFunc is nil")
+	}
+	return mock.PutFormFunc(ctx, p, i, ro)
+}
+
+// PutFormFile calls PutFormFileFunc.
+func (mock *MockAPI) PutFormFile(ctx context.Context, urlPath string, filePath string, fieldName string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PutFormFileFunc == nil {
+		panic("fastlytest: MockAPI.PutFormFile called but PutFormFileFunc is nil")
+	}
+	return mock.PutFormFileFunc(ctx, urlPath, filePath, fieldName, ro)
+}
+
+// PutFormFileFromReader calls PutFormFileFromReaderFunc.
+func (mock *MockAPI) PutFormFileFromReader(ctx context.Context, urlPath string, fileName string, fileBytes io.Reader, fieldName string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PutFormFileFromReaderFunc == nil {
+		panic("fastlytest: MockAPI.PutFormFileFromReader called but PutFormFileFromReaderFunc is nil")
+	}
+	return mock.PutFormFileFromReaderFunc(ctx, urlPath, fileName, fileBytes, fieldName, ro)
+}
+
+// PutJSON calls PutJSONFunc.
+func (mock *MockAPI) PutJSON(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PutJSONFunc == nil {
+		panic("fastlytest: MockAPI.PutJSON called but PutJSONFunc is nil")
+	}
+	return mock.PutJSONFunc(ctx, p, i, ro)
+}
+
+// PutJSONAPI calls PutJSONAPIFunc.
+func (mock *MockAPI) PutJSONAPI(ctx context.Context, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.PutJSONAPIFunc == nil {
+		panic("fastlytest: MockAPI.PutJSONAPI called but PutJSONAPIFunc is nil")
+	}
+	return mock.PutJSONAPIFunc(ctx, p, i, ro)
+}
+
+// RateLimitRemaining calls RateLimitRemainingFunc.
+func (mock *MockAPI) RateLimitRemaining() int {
+	if mock.RateLimitRemainingFunc == nil {
+		panic("fastlytest: MockAPI.RateLimitRemaining called but RateLimitRemainingFunc is nil")
+	}
+	return mock.RateLimitRemainingFunc()
+}
+
+// RateLimitReset calls RateLimitResetFunc.
+func (mock *MockAPI) RateLimitReset() time.Time {
+	if mock.RateLimitResetFunc == nil {
+		panic("fastlytest: MockAPI.RateLimitReset called but RateLimitResetFunc is nil")
+	}
+	return mock.RateLimitResetFunc()
+}
+
+// RawRequest calls RawRequestFunc.
+func (mock *MockAPI) RawRequest(ctx context.Context, verb string, p string, ro fastly.RequestOptions) (*http.Request, error) {
+	if mock.RawRequestFunc == nil {
+		panic("fastlytest: MockAPI.RawRequest called but RawRequestFunc is nil")
+	}
+	return mock.RawRequestFunc(ctx, verb, p, ro)
+}
+
+// Request calls RequestFunc.
+func (mock *MockAPI) Request(ctx context.Context, verb string, p string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestFunc == nil {
+		panic("fastlytest: MockAPI.Request called but RequestFunc is nil")
+	}
+	return mock.RequestFunc(ctx, verb, p, ro)
+}
+
+// RequestForm calls RequestFormFunc.
+func (mock *MockAPI) RequestForm(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestFormFunc == nil {
+		panic("fastlytest: MockAPI.RequestForm called but RequestFormFunc is nil")
+	}
+	return mock.RequestFormFunc(ctx, verb, p, i, ro)
+}
+
+// RequestFormFile calls RequestFormFileFunc.
+func (mock *MockAPI) RequestFormFile(ctx context.Context, verb string, urlPath string, filePath string, fieldName string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestFormFileFunc == nil {
+		panic("fastlytest: MockAPI.RequestFormFile called but RequestFormFileFunc is nil")
+	}
+	return mock.RequestFormFileFunc(ctx, verb, urlPath, filePath, fieldName, ro)
+}
+
+// RequestFormFileFromReader calls RequestFormFileFromReaderFunc.
+func (mock *MockAPI) RequestFormFileFromReader(ctx context.Context, verb string, urlPath string, fileName string, fileBytes io.Reader, fieldName string, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestFormFileFromReaderFunc == nil {
+		panic("fastlytest: MockAPI.RequestFormFileFromReader called but RequestFormFileFromReaderFunc is nil")
+	}
+	return mock.RequestFormFileFromReaderFunc(ctx, verb, urlPath, fileName, fileBytes, fieldName, ro)
+}
+
+// RequestJSON calls RequestJSONFunc.
+func (mock *MockAPI) RequestJSON(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestJSONFunc == nil {
+		panic("fastlytest: MockAPI.RequestJSON called but RequestJSONFunc is nil")
+	}
+	return mock.RequestJSONFunc(ctx, verb, p, i, ro)
+}
+
+// RequestJSONAPI calls RequestJSONAPIFunc.
+func (mock *MockAPI) RequestJSONAPI(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestJSONAPIFunc == nil {
+		panic("fastlytest: MockAPI.RequestJSONAPI called but RequestJSONAPIFunc is nil")
+	}
+	return mock.RequestJSONAPIFunc(ctx, verb, p, i, ro)
+}
+
+// RequestJSONAPIBulk calls RequestJSONAPIBulkFunc.
+func (mock *MockAPI) RequestJSONAPIBulk(ctx context.Context, verb string, p string, i any, ro fastly.RequestOptions) (*http.Response, error) {
+	if mock.RequestJSONAPIBulkFunc == nil {
+		panic("fastlytest: MockAPI.RequestJSONAPIBulk called but RequestJSONAPIBulkFunc is nil")
+	}
+	return mock.RequestJSONAPIBulkFunc(ctx, verb, p, i, ro)
+}
+
+// ResetUserPassword calls ResetUserPasswordFunc.
+func (mock *MockAPI) ResetUserPassword(ctx context.Context, i *fastly.ResetUserPasswordInput) error {
+	if mock.ResetUserPasswordFunc == nil {
+		panic("fastlytest: MockAPI.ResetUserPassword called but ResetUserPasswordFunc is nil")
+	}
+	return mock.ResetUserPasswordFunc(ctx, i)
+}
+
+// RestoreServiceVersion calls RestoreServiceVersionFunc.
+func (mock *MockAPI) RestoreServiceVersion(ctx context.Context, i *fastly.RestoreServiceVersionInput) (*fastly.Version, error) {
+	if mock.RestoreServiceVersionFunc == nil {
+		panic("fastlytest: MockAPI.RestoreServiceVersion called but RestoreServiceVersionFunc is nil")
+	}
+	return mock.RestoreServiceVersionFunc(ctx, i)
+}
+
+// ReuseOrCloneVersion calls ReuseOrCloneVersionFunc.
+func (mock *MockAPI) ReuseOrCloneVersion(ctx context.Context, i *fastly.ReuseOrCloneVersionInput) (*fastly.Version, error) {
+	if mock.ReuseOrCloneVersionFunc == nil {
+		panic("fastlytest: MockAPI.ReuseOrCloneVersion called but ReuseOrCloneVersionFunc is nil")
+	}
+	return mock.ReuseOrCloneVersionFunc(ctx, i)
+}
+
+// RotateWebhookSigningKey calls RotateWebhookSigningKeyFunc.
+func (mock *MockAPI) RotateWebhookSigningKey(ctx context.Context, i *fastly.RotateWebhookSigningKeyInput) (*fastly.WebhookSigningKeyResponse, error) {
+	if mock.RotateWebhookSigningKeyFunc == nil {
+		panic("fastlytest: MockAPI.RotateWebhookSigningKey called but RotateWebhookSigningKeyFunc is nil")
+	}
+	return mock.RotateWebhookSigningKeyFunc(ctx, i)
+}
+
+// SearchIntegrations calls SearchIntegrationsFunc.
+func (mock *MockAPI) SearchIntegrations(ctx context.Context, i *fastly.SearchIntegrationsInput) (*fastly.SearchIntegrationsResponse, error) {
+	if mock.SearchIntegrationsFunc == nil {
+		panic("fastlytest: MockAPI.SearchIntegrations called but SearchIntegrationsFunc is nil")
+	}
+	return mock.SearchIntegrationsFunc(ctx, i)
+}
+
+// SearchService calls SearchServiceFunc.
+func (mock *MockAPI) SearchService(ctx context.Context, i *fastly.SearchServiceInput) (*fastly.Service, error) {
+	if mock.SearchServiceFunc == nil {
+		panic("fastlytest: MockAPI.SearchService called but SearchServiceFunc is nil")
+	}
+	return mock.SearchServiceFunc(ctx, i)
+}
+
+// SimpleGet calls SimpleGetFunc.
+func (mock *MockAPI) SimpleGet(ctx context.Context, target string) (*http.Response, error) {
+	if mock.SimpleGetFunc == nil {
+		panic("fastlytest: MockAPI.SimpleGet called but SimpleGetFunc is nil")
+	}
+	return mock.SimpleGetFunc(ctx, target)
+}
+
+// TestAlertDefinition calls TestAlertDefinitionFunc.
+func (mock *MockAPI) TestAlertDefinition(ctx context.Context, i *fastly.TestAlertDefinitionInput) error {
+	if mock.TestAlertDefinitionFunc == nil {
+		panic("fastlytest: MockAPI.TestAlertDefinition called but TestAlertDefinitionFunc is nil")
+	}
+	return mock.TestAlertDefinitionFunc(ctx, i)
+}
+
+// TokenExpiresWithin calls TokenExpiresWithinFunc.
+func (mock *MockAPI) TokenExpiresWithin(ctx context.Context, d time.Duration) (bool, *fastly.Token, error) {
+	if mock.TokenExpiresWithinFunc == nil {
+		panic("fastlytest: MockAPI.TokenExpiresWithin called but TokenExpiresWithinFunc is nil")
+	}
+	return mock.TokenExpiresWithinFunc(ctx, d)
+}
+
+// UpdateACL calls UpdateACLFunc.
+func (mock *MockAPI) UpdateACL(ctx context.Context, i *fastly.UpdateACLInput) (*fastly.ACL, error) {
+	if mock.UpdateACLFunc == nil {
+		panic("fastlytest: MockAPI.UpdateACL called but UpdateACLFunc is nil")
+	}
+	return mock.UpdateACLFunc(ctx, i)
+}
+
+// UpdateACLEntry calls UpdateACLEntryFunc.
+func (mock *MockAPI) UpdateACLEntry(ctx context.Context, i *fastly.UpdateACLEntryInput) (*fastly.ACLEntry, error) {
+	if mock.UpdateACLEntryFunc == nil {
+		panic("fastlytest: MockAPI.UpdateACLEntry called but UpdateACLEntryFunc is nil")
+	}
+	return mock.UpdateACLEntryFunc(ctx, i)
+}
+
+// UpdateAlertDefinition calls UpdateAlertDefinitionFunc.
+func (mock *MockAPI) UpdateAlertDefinition(ctx context.Context, i *fastly.UpdateAlertDefinitionInput) (*fastly.AlertDefinition, error) {
+	if mock.UpdateAlertDefinitionFunc == nil {
+		panic("fastlytest: MockAPI.UpdateAlertDefinition called but UpdateAlertDefinitionFunc is nil")
+	}
+	return mock.UpdateAlertDefinitionFunc(ctx, i)
+}
+
+// UpdateBackend calls UpdateBackendFunc.
+func (mock *MockAPI) UpdateBackend(ctx context.Context, i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+	if mock.UpdateBackendFunc == nil {
+		panic("fastlytest: MockAPI.UpdateBackend called but UpdateBackendFunc is nil")
+	}
+	return mock.UpdateBackendFunc(ctx, i)
+}
+
+// UpdateBigQuery calls UpdateBigQueryFunc.
+func (mock *MockAPI) UpdateBigQuery(ctx context.Context, i *fastly.UpdateBigQueryInput) (*fastly.BigQuery, error) {
+	if mock.UpdateBigQueryFunc == nil {
+		panic("fastlytest: MockAPI.UpdateBigQuery called but UpdateBigQueryFunc is nil")
+	}
+	return mock.UpdateBigQueryFunc(ctx, i)
+}
+
+// UpdateBlobStorage calls UpdateBlobStorageFunc.
+func (mock *MockAPI) UpdateBlobStorage(ctx context.Context, i *fastly.UpdateBlobStorageInput) (*fastly.BlobStorage, error) {
+	if mock.UpdateBlobStorageFunc == nil {
+		panic("fastlytest: MockAPI.UpdateBlobStorage called but UpdateBlobStorageFunc is nil")
+	}
+	return mock.UpdateBlobStorageFunc(ctx, i)
+}
+
+// UpdateBulkCertificate calls UpdateBulkCertificateFunc.
+func (mock *MockAPI) UpdateBulkCertificate(ctx context.Context, i *fastly.UpdateBulkCertificateInput) (*fastly.BulkCertificate, error) {
+	if mock.UpdateBulkCertificateFunc == nil {
+		panic("fastlytest: MockAPI.UpdateBulkCertificate called but UpdateBulkCertificateFunc is nil")
+	}
+	return mock.UpdateBulkCertificateFunc(ctx, i)
+}
+
+// UpdateCacheSetting calls UpdateCacheSettingFunc.
+func (mock *MockAPI) UpdateCacheSetting(ctx context.Context, i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	if mock.UpdateCacheSettingFunc == nil {
+		panic("fastlytest: MockAPI.UpdateCacheSetting called but UpdateCacheSettingFunc is nil")
+	}
+	return mock.UpdateCacheSettingFunc(ctx, i)
+}
+
+// UpdateCloudfiles calls UpdateCloudfilesFunc.
+func (mock *MockAPI) UpdateCloudfiles(ctx context.Context, i *fastly.UpdateCloudfilesInput) (*fastly.Cloudfiles, error) {
+	if mock.UpdateCloudfilesFunc == nil {
+		panic("fastlytest: MockAPI.UpdateCloudfiles called but UpdateCloudfilesFunc is nil")
+	}
+	return mock.UpdateCloudfilesFunc(ctx, i)
+}
+
+// UpdateCondition calls UpdateConditionFunc.
+func (mock *MockAPI) UpdateCondition(ctx context.Context, i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	if mock.UpdateConditionFunc == nil {
+		panic("fastlytest: MockAPI.UpdateCondition called but UpdateConditionFunc is nil")
+	}
+	return mock.UpdateConditionFunc(ctx, i)
+}
+
+// UpdateConfigStore calls UpdateConfigStoreFunc.
+func (mock *MockAPI) UpdateConfigStore(ctx context.Context, i *fastly.UpdateConfigStoreInput) (*fastly.ConfigStore, error) {
+	if mock.UpdateConfigStoreFunc == nil {
+		panic("fastlytest: MockAPI.UpdateConfigStore called but UpdateConfigStoreFunc is nil")
+	}
+	return mock.UpdateConfigStoreFunc(ctx, i)
+}
+
+// UpdateConfigStoreItem calls UpdateConfigStoreItemFunc.
+func (mock *MockAPI) UpdateConfigStoreItem(ctx context.Context, i *fastly.UpdateConfigStoreItemInput) (*fastly.ConfigStoreItem, error) {
+	if mock.UpdateConfigStoreItemFunc == nil {
+		panic("fastlytest: MockAPI.UpdateConfigStoreItem called but UpdateConfigStoreItemFunc is nil")
+	}
+	return mock.UpdateConfigStoreItemFunc(ctx, i)
+}
+
+// UpdateCustomTLSCertificate calls UpdateCustomTLSCertificateFunc.
+func (mock *MockAPI) UpdateCustomTLSCertificate(ctx context.Context, i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	if mock.UpdateCustomTLSCertificateFunc == nil {
+		panic("fastlytest: MockAPI.UpdateCustomTLSCertificate called but UpdateCustomTLSCertificateFunc is nil")
+	}
+	return mock.UpdateCustomTLSCertificateFunc(ctx, i)
+}
+
+// UpdateCustomTLSConfiguration calls UpdateCustomTLSConfigurationFunc.
+func (mock *MockAPI) UpdateCustomTLSConfiguration(ctx context.Context, i *fastly.UpdateCustomTLSConfigurationInput) (*fastly.CustomTLSConfiguration, error) {
+	if mock.UpdateCustomTLSConfigurationFunc == nil {
+		panic("fastlytest: MockAPI.UpdateCustomTLSConfiguration called but UpdateCustomTLSConfigurationFunc is nil")
+	}
+	return mock.UpdateCustomTLSConfigurationFunc(ctx, i)
+}
+
+// UpdateDatadog calls UpdateDatadogFunc.
+func (mock *MockAPI) UpdateDatadog(ctx context.Context, i *fastly.UpdateDatadogInput) (*fastly.Datadog, error) {
+	if mock.UpdateDatadogFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDatadog called but UpdateDatadogFunc is nil")
+	}
+	return mock.UpdateDatadogFunc(ctx, i)
+}
+
+// UpdateDictionary calls UpdateDictionaryFunc.
+func (mock *MockAPI) UpdateDictionary(ctx context.Context, i *fastly.UpdateDictionaryInput) (*fastly.Dictionary, error) {
+	if mock.UpdateDictionaryFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDictionary called but UpdateDictionaryFunc is nil")
+	}
+	return mock.UpdateDictionaryFunc(ctx, i)
+}
+
+// UpdateDictionaryItem calls UpdateDictionaryItemFunc.
+func (mock *MockAPI) UpdateDictionaryItem(ctx context.Context, i *fastly.UpdateDictionaryItemInput) (*fastly.DictionaryItem, error) {
+	if mock.UpdateDictionaryItemFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDictionaryItem called but UpdateDictionaryItemFunc is nil")
+	}
+	return mock.UpdateDictionaryItemFunc(ctx, i)
+}
+
+// UpdateDigitalOcean calls UpdateDigitalOceanFunc.
+func (mock *MockAPI) UpdateDigitalOcean(ctx context.Context, i *fastly.UpdateDigitalOceanInput) (*fastly.DigitalOcean, error) {
+	if mock.UpdateDigitalOceanFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDigitalOcean called but UpdateDigitalOceanFunc is nil")
+	}
+	return mock.UpdateDigitalOceanFunc(ctx, i)
+}
+
+// UpdateDirector calls UpdateDirectorFunc.
+func (mock *MockAPI) UpdateDirector(ctx context.Context, i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	if mock.UpdateDirectorFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDirector called but UpdateDirectorFunc is nil")
+	}
+	return mock.UpdateDirectorFunc(ctx, i)
+}
+
+// UpdateDomain calls UpdateDomainFunc.
+func (mock *MockAPI) UpdateDomain(ctx context.Context, i *fastly.UpdateDomainInput) (*fastly.Domain, error) {
+	if mock.UpdateDomainFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDomain called but UpdateDomainFunc is nil")
+	}
+	return mock.UpdateDomainFunc(ctx, i)
+}
+
+// UpdateDynamicSnippet calls UpdateDynamicSnippetFunc.
+func (mock *MockAPI) UpdateDynamicSnippet(ctx context.Context, i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+	if mock.UpdateDynamicSnippetFunc == nil {
+		panic("fastlytest: MockAPI.UpdateDynamicSnippet called but UpdateDynamicSnippetFunc is nil")
+	}
+	return mock.UpdateDynamicSnippetFunc(ctx, i)
+}
+
+// UpdateERL calls UpdateERLFunc.
+func (mock *MockAPI) UpdateERL(ctx context.Context, i *fastly.UpdateERLInput) (*fastly.ERL, error) {
+	if mock.UpdateERLFunc == nil {
+		panic("fastlytest: MockAPI.UpdateERL called but UpdateERLFunc is nil")
+	}
+	return mock.UpdateERLFunc(ctx, i)
+}
+
+// UpdateElasticsearch calls UpdateElasticsearchFunc.
+func (mock *MockAPI) UpdateElasticsearch(ctx context.Context, i *fastly.UpdateElasticsearchInput) (*fastly.Elasticsearch, error) {
+	if mock.UpdateElasticsearchFunc == nil {
+		panic("fastlytest: MockAPI.UpdateElasticsearch called but UpdateElasticsearchFunc is nil")
+	}
+	return mock.UpdateElasticsearchFunc(ctx, i)
+}
+
+// UpdateFTP calls UpdateFTPFunc.
+func (mock *MockAPI) UpdateFTP(ctx context.Context, i *fastly.UpdateFTPInput) (*fastly.FTP, error) {
+	if mock.UpdateFTPFunc == nil {
+		panic("fastlytest: MockAPI.UpdateFTP called but UpdateFTPFunc is nil")
+	}
+	return mock.UpdateFTPFunc(ctx, i)
+}
+
+// UpdateGCS calls UpdateGCSFunc.
+func (mock *MockAPI) UpdateGCS(ctx context.Context, i *fastly.UpdateGCSInput) (*fastly.GCS, error) {
+	if mock.UpdateGCSFunc == nil {
+		panic("fastlytest: MockAPI.UpdateGCS called but UpdateGCSFunc is nil")
+	}
+	return mock.UpdateGCSFunc(ctx, i)
+}
+
+// UpdateGrafanaCloudLogs calls UpdateGrafanaCloudLogsFunc.
+func (mock *MockAPI) UpdateGrafanaCloudLogs(ctx context.Context, i *fastly.UpdateGrafanaCloudLogsInput) (*fastly.GrafanaCloudLogs, error) {
+	if mock.UpdateGrafanaCloudLogsFunc == nil {
+		panic("fastlytest: MockAPI.UpdateGrafanaCloudLogs called but UpdateGrafanaCloudLogsFunc is nil")
+	}
+	return mock.UpdateGrafanaCloudLogsFunc(ctx, i)
+}
+
+// UpdateGzip calls UpdateGzipFunc.
+func (mock *MockAPI) UpdateGzip(ctx context.Context, i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	if mock.UpdateGzipFunc == nil {
+		panic("fastlytest: MockAPI.UpdateGzip called but UpdateGzipFunc is nil")
+	}
+	return mock.UpdateGzipFunc(ctx, i)
+}
+
+// UpdateHTTPS calls UpdateHTTPSFunc.
+func (mock *MockAPI) UpdateHTTPS(ctx context.Context, i *fastly.UpdateHTTPSInput) (*fastly.HTTPS, error) {
+	if mock.UpdateHTTPSFunc == nil {
+		panic("fastlytest: MockAPI.UpdateHTTPS called but UpdateHTTPSFunc is nil")
+	}
+	return mock.UpdateHTTPSFunc(ctx, i)
+}
+
+// UpdateHeader calls UpdateHeaderFunc.
+func (mock *MockAPI) UpdateHeader(ctx context.Context, i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	if mock.UpdateHeaderFunc == nil {
+		panic("fastlytest: MockAPI.UpdateHeader called but UpdateHeaderFunc is nil")
+	}
+	return mock.UpdateHeaderFunc(ctx, i)
+}
+
+// UpdateHealthCheck calls UpdateHealthCheckFunc.
+func (mock *MockAPI) UpdateHealthCheck(ctx context.Context, i *fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error) {
+	if mock.UpdateHealthCheckFunc == nil {
+		panic("fastlytest: MockAPI.UpdateHealthCheck called but UpdateHealthCheckFunc is nil")
+	}
+	return mock.UpdateHealthCheckFunc(ctx, i)
+}
+
+// UpdateHeroku calls UpdateHerokuFunc.
+func (mock *MockAPI) UpdateHeroku(ctx context.Context, i *fastly.UpdateHerokuInput) (*fastly.Heroku, error) {
+	if mock.UpdateHerokuFunc == nil {
+		panic("fastlytest: MockAPI.UpdateHeroku called but UpdateHerokuFunc is nil")
+	}
+	return mock.UpdateHerokuFunc(ctx, i)
+}
+
+// UpdateHoneycomb calls UpdateHoneycombFunc.
+func (mock *MockAPI) UpdateHoneycomb(ctx context.Context, i *fastly.UpdateHoneycombInput) (*fastly.Honeycomb, error) {
+	if mock.UpdateHoneycombFunc == nil {
+		panic("fastlytest: MockAPI.UpdateHoneycomb called but UpdateHoneycombFunc is nil")
+	}
+	return mock.UpdateHoneycombFunc(ctx, i)
+}
+
+// UpdateImageOptimizerDefaultSettings calls UpdateImageOptimizerDefaultSettingsFunc.
+func (mock *MockAPI) UpdateImageOptimizerDefaultSettings(ctx context.Context, i *fastly.UpdateImageOptimizerDefaultSettingsInput) (*fastly.ImageOptimizerDefaultSettings, error) {
+	if mock.UpdateImageOptimizerDefaultSettingsFunc == nil {
+		panic("fastlytest: MockAPI.UpdateImageOptimizerDefaultSettings called but UpdateImageOptimizerDefaultSettingsFunc is nil")
+	}
+	return mock.UpdateImageOptimizerDefaultSettingsFunc(ctx, i)
+}
+
+// UpdateIntegration calls UpdateIntegrationFunc.
+func (mock *MockAPI) UpdateIntegration(ctx context.Context, i *fastly.UpdateIntegrationInput) error {
+	if mock.UpdateIntegrationFunc == nil {
+		panic("fastlytest: MockAPI.UpdateIntegration called but UpdateIntegrationFunc is nil")
+	}
+	return mock.UpdateIntegrationFunc(ctx, i)
+}
+
+// UpdateKafka calls UpdateKafkaFunc.
+func (mock *MockAPI) UpdateKafka(ctx context.Context, i *fastly.UpdateKafkaInput) (*fastly.Kafka, error) {
+	if mock.UpdateKafkaFunc == nil {
+		panic("fastlytest: MockAPI.UpdateKafka called but UpdateKafkaFunc is nil")
+	}
+	return mock.UpdateKafkaFunc(ctx, i)
+}
+
+// UpdateKinesis calls UpdateKinesisFunc.
+func (mock *MockAPI) UpdateKinesis(ctx context.Context, i *fastly.UpdateKinesisInput) (*fastly.Kinesis, error) {
+	if mock.UpdateKinesisFunc == nil {
+		panic("fastlytest: MockAPI.UpdateKinesis called but UpdateKinesisFunc is nil")
+	}
+	return mock.UpdateKinesisFunc(ctx, i)
+}
+
+// UpdateLogentries calls UpdateLogentriesFunc.
+func (mock *MockAPI) UpdateLogentries(ctx context.Context, i *fastly.UpdateLogentriesInput) (*fastly.Logentries, error) {
+	if mock.UpdateLogentriesFunc == nil {
+		panic("fastlytest: MockAPI.UpdateLogentries called but UpdateLogentriesFunc is nil")
+	}
+	return mock.UpdateLogentriesFunc(ctx, i)
+}
+
+// UpdateLoggly calls UpdateLogglyFunc.
+func (mock *MockAPI) UpdateLoggly(ctx context.Context, i *fastly.UpdateLogglyInput) (*fastly.Loggly, error) {
+	if mock.UpdateLogglyFunc == nil {
+		panic("fastlytest: MockAPI.UpdateLoggly called but UpdateLogglyFunc is nil")
+	}
+	return mock.UpdateLogglyFunc(ctx, i)
+}
+
+// UpdateLogshuttle calls UpdateLogshuttleFunc.
+func (mock *MockAPI) UpdateLogshuttle(ctx context.Context, i *fastly.UpdateLogshuttleInput) (*fastly.Logshuttle, error) {
+	if mock.UpdateLogshuttleFunc == nil {
+		panic("fastlytest: MockAPI.UpdateLogshuttle called but UpdateLogshuttleFunc is nil")
+	}
+	return mock.UpdateLogshuttleFunc(ctx, i)
+}
+
+// UpdateNewRelic calls UpdateNewRelicFunc.
+func (mock *MockAPI) UpdateNewRelic(ctx context.Context, i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error) {
+	if mock.UpdateNewRelicFunc == nil {
+		panic("fastlytest: MockAPI.UpdateNewRelic called but UpdateNewRelicFunc is nil")
+	}
+	return mock.UpdateNewRelicFunc(ctx, i)
+}
+
+// UpdateNewRelicOTLP calls UpdateNewRelicOTLPFunc.
+func (mock *MockAPI) UpdateNewRelicOTLP(ctx context.Context, i *fastly.UpdateNewRelicOTLPInput) (*fastly.NewRelicOTLP, error) {
+	if mock.UpdateNewRelicOTLPFunc == nil {
+		panic("fastlytest: MockAPI.UpdateNewRelicOTLP called but UpdateNewRelicOTLPFunc is nil")
+	}
+	return mock.UpdateNewRelicOTLPFunc(ctx, i)
+}
+
+// UpdateObservabilityCustomDashboard calls UpdateObservabilityCustomDashboardFunc.
+func (mock *MockAPI) UpdateObservabilityCustomDashboard(ctx context.Context, i *fastly.UpdateObservabilityCustomDashboardInput) (*fastly.ObservabilityCustomDashboard, error) {
+	if mock.UpdateObservabilityCustomDashboardFunc == nil {
+		panic("fastlytest: MockAPI.UpdateObservabilityCustomDashboard called but UpdateObservabilityCustomDashboardFunc is nil")
+	}
+	return mock.UpdateObservabilityCustomDashboardFunc(ctx, i)
+}
+
+// UpdateOpenstack calls UpdateOpenstackFunc.
+func (mock *MockAPI) UpdateOpenstack(ctx context.Context, i *fastly.UpdateOpenstackInput) (*fastly.Openstack, error) {
+	if mock.UpdateOpenstackFunc == nil {
+		panic("fastlytest: MockAPI.UpdateOpenstack called but UpdateOpenstackFunc is nil")
+	}
+	return mock.UpdateOpenstackFunc(ctx, i)
+}
+
+// UpdatePackage calls UpdatePackageFunc.
+func (mock *MockAPI) UpdatePackage(ctx context.Context, i *fastly.UpdatePackageInput) (*fastly.Package, error) {
+	if mock.UpdatePackageFunc == nil {
+		panic("fastlytest: MockAPI.UpdatePackage called but UpdatePackageFunc is nil")
+	}
+	return mock.UpdatePackageFunc(ctx, i)
+}
+
+// UpdatePapertrail calls UpdatePapertrailFunc.
+func (mock *MockAPI) UpdatePapertrail(ctx context.Context, i *fastly.UpdatePapertrailInput) (*fastly.Papertrail, error) {
+	if mock.UpdatePapertrailFunc == nil {
+		panic("fastlytest: MockAPI.UpdatePapertrail called but UpdatePapertrailFunc is nil")
+	}
+	return mock.UpdatePapertrailFunc(ctx, i)
+}
+
+// UpdatePool calls UpdatePoolFunc.
+func (mock *MockAPI) UpdatePool(ctx context.Context, i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	if mock.UpdatePoolFunc == nil {
+		panic("fastlytest: MockAPI.UpdatePool called but UpdatePoolFunc is nil")
+	}
+	return mock.UpdatePoolFunc(ctx, i)
+}
+
+// UpdatePubsub calls UpdatePubsubFunc.
+func (mock *MockAPI) UpdatePubsub(ctx context.Context, i *fastly.UpdatePubsubInput) (*fastly.Pubsub, error) {
+	if mock.UpdatePubsubFunc == nil {
+		panic("fastlytest: MockAPI.UpdatePubsub called but UpdatePubsubFunc is nil")
+	}
+	return mock.UpdatePubsubFunc(ctx, i)
+}
+
+// UpdateRequestSetting calls UpdateRequestSettingFunc.
+func (mock *MockAPI) UpdateRequestSetting(ctx context.Context, i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	if mock.UpdateRequestSettingFunc == nil {
+		panic("fastlytest: MockAPI.UpdateRequestSetting called but UpdateRequestSettingFunc is nil")
+	}
+	return mock.UpdateRequestSettingFunc(ctx, i)
+}
+
+// UpdateResource calls UpdateResourceFunc.
+func (mock *MockAPI) UpdateResource(ctx context.Context, i *fastly.UpdateResourceInput) (*fastly.Resource, error) {
+	if mock.UpdateResourceFunc == nil {
+		panic("fastlytest: MockAPI.UpdateResource called but UpdateResourceFunc is nil")
+	}
+	return mock.UpdateResourceFunc(ctx, i)
+}
+
+// UpdateResponseObject calls UpdateResponseObjectFunc.
+func (mock *MockAPI) UpdateResponseObject(ctx context.Context, i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	if mock.UpdateResponseObjectFunc == nil {
+		panic("fastlytest: MockAPI.UpdateResponseObject called but UpdateResponseObjectFunc is nil")
+	}
+	return mock.UpdateResponseObjectFunc(ctx, i)
+}
+
+// UpdateS3 calls UpdateS3Func.
+func (mock *MockAPI) UpdateS3(ctx context.Context, i *fastly.UpdateS3Input) (*fastly.S3, error) {
+	if mock.UpdateS3Func == nil {
+		panic("fastlytest: MockAPI.UpdateS3 called but UpdateS3Func is nil")
+	}
+	return mock.UpdateS3Func(ctx, i)
+}
+
+// UpdateSFTP calls UpdateSFTPFunc.
+func (mock *MockAPI) UpdateSFTP(ctx context.Context, i *fastly.UpdateSFTPInput) (*fastly.SFTP, error) {
+	if mock.UpdateSFTPFunc == nil {
+		panic("fastlytest: MockAPI.UpdateSFTP called but UpdateSFTPFunc is nil")
+	}
+	return mock.UpdateSFTPFunc(ctx, i)
+}
+
+// UpdateScalyr calls UpdateScalyrFunc.
+func (mock *MockAPI) UpdateScalyr(ctx context.Context, i *fastly.UpdateScalyrInput) (*fastly.Scalyr, error) {
+	if mock.UpdateScalyrFunc == nil {
+		panic("fastlytest: MockAPI.UpdateScalyr called but UpdateScalyrFunc is nil")
+	}
+	return mock.UpdateScalyrFunc(ctx, i)
+}
+
+// UpdateServer calls UpdateServerFunc.
+func (mock *MockAPI) UpdateServer(ctx context.Context, i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	if mock.UpdateServerFunc == nil {
+		panic("fastlytest: MockAPI.UpdateServer called but UpdateServerFunc is nil")
+	}
+	return mock.UpdateServerFunc(ctx, i)
+}
+
+// UpdateService calls UpdateServiceFunc.
+func (mock *MockAPI) UpdateService(ctx context.Context, i *fastly.UpdateServiceInput) (*fastly.Service, error) {
+	if mock.UpdateServiceFunc == nil {
+		panic("fastlytest: MockAPI.UpdateService called but UpdateServiceFunc is nil")
+	}
+	return mock.UpdateServiceFunc(ctx, i)
+}
+
+// UpdateServiceAuthorization calls UpdateServiceAuthorizationFunc.
+func (mock *MockAPI) UpdateServiceAuthorization(ctx context.Context, i *fastly.UpdateServiceAuthorizationInput) (*fastly.ServiceAuthorization, error) {
+	if mock.UpdateServiceAuthorizationFunc == nil {
+		panic("fastlytest: MockAPI.UpdateServiceAuthorization called but UpdateServiceAuthorizationFunc is nil")
+	}
+	return mock.UpdateServiceAuthorizationFunc(ctx, i)
+}
+
+// UpdateSettings calls UpdateSettingsFunc.
+func (mock *MockAPI) UpdateSettings(ctx context.Context, i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	if mock.UpdateSettingsFunc == nil {
+		panic("fastlytest: MockAPI.UpdateSettings called but UpdateSettingsFunc is nil")
+	}
+	return mock.UpdateSettingsFunc(ctx, i)
+}
+
+// UpdateSnippet calls UpdateSnippetFunc.
+func (mock *MockAPI) UpdateSnippet(ctx context.Context, i *fastly.UpdateSnippetInput) (*fastly.Snippet, error) {
+	if mock.UpdateSnippetFunc == nil {
+		panic("fastlytest: MockAPI.UpdateSnippet called but UpdateSnippetFunc is nil")
+	}
+	return mock.UpdateSnippetFunc(ctx, i)
+}
+
+// UpdateSplunk calls UpdateSplunkFunc.
+func (mock *MockAPI) UpdateSplunk(ctx context.Context, i *fastly.UpdateSplunkInput) (*fastly.Splunk, error) {
+	if mock.UpdateSplunkFunc == nil {
+		panic("fastlytest: MockAPI.UpdateSplunk called but UpdateSplunkFunc is nil")
+	}
+	return mock.UpdateSplunkFunc(ctx, i)
+}
+
+// UpdateSumologic calls UpdateSumologicFunc.
+func (mock *MockAPI) UpdateSumologic(ctx context.Context, i *fastly.UpdateSumologicInput) (*fastly.Sumologic, error) {
+	if mock.UpdateSumologicFunc == nil {
+		panic("fastlytest: MockAPI.UpdateSumologic called but UpdateSumologicFunc is nil")
+	}
+	return mock.UpdateSumologicFunc(ctx, i)
+}
+
+// UpdateSyslog calls UpdateSyslogFunc.
+func (mock *MockAPI) UpdateSyslog(ctx context.Context, i *fastly.UpdateSyslogInput) (*fastly.Syslog, error) {
+	if mock.UpdateSyslogFunc == nil {
+		panic("fastlytest: MockAPI.UpdateSyslog called but UpdateSyslogFunc is nil")
+	}
+	return mock.UpdateSyslogFunc(ctx, i)
+}
+
+// UpdateTLSActivation calls UpdateTLSActivationFunc.
+func (mock *MockAPI) UpdateTLSActivation(ctx context.Context, i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	if mock.UpdateTLSActivationFunc == nil {
+		panic("fastlytest: MockAPI.UpdateTLSActivation called but UpdateTLSActivationFunc is nil")
+	}
+	return mock.UpdateTLSActivationFunc(ctx, i)
+}
+
+// UpdateTLSMutualAuthentication calls UpdateTLSMutualAuthenticationFunc.
+func (mock *MockAPI) UpdateTLSMutualAuthentication(ctx context.Context, i *fastly.UpdateTLSMutualAuthenticationInput) (*fastly.TLSMutualAuthentication, error) {
+	if mock.UpdateTLSMutualAuthenticationFunc == nil {
+		panic("fastlytest: MockAPI.UpdateTLSMutualAuthentication called but UpdateTLSMutualAuthenticationFunc is nil")
+	}
+	return mock.UpdateTLSMutualAuthenticationFunc(ctx, i)
+}
+
+// UpdateTLSSubscription calls UpdateTLSSubscriptionFunc.
+func (mock *MockAPI) UpdateTLSSubscription(ctx context.Context, i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	if mock.UpdateTLSSubscriptionFunc == nil {
+		panic("fastlytest: MockAPI.UpdateTLSSubscription called but UpdateTLSSubscriptionFunc is nil")
+	}
+	return mock.UpdateTLSSubscriptionFunc(ctx, i)
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (mock *MockAPI) UpdateUser(ctx context.Context, i *fastly.UpdateUserInput) (*fastly.User, error) {
+	if mock.UpdateUserFunc == nil {
+		panic("fastlytest: MockAPI.UpdateUser called but UpdateUserFunc is nil")
+	}
+	return mock.UpdateUserFunc(ctx, i)
+}
+
+// UpdateVCL calls UpdateVCLFunc.
+func (mock *MockAPI) UpdateVCL(ctx context.Context, i *fastly.UpdateVCLInput) (*fastly.VCL, error) {
+	if mock.UpdateVCLFunc == nil {
+		panic("fastlytest: MockAPI.UpdateVCL called but UpdateVCLFunc is nil")
+	}
+	return mock.UpdateVCLFunc(ctx, i)
+}
+
+// UpdateVersion calls UpdateVersionFunc.
+func (mock *MockAPI) UpdateVersion(ctx context.Context, i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+	if mock.UpdateVersionFunc == nil {
+		panic("fastlytest: MockAPI.UpdateVersion called but UpdateVersionFunc is nil")
+	}
+	return mock.UpdateVersionFunc(ctx, i)
+}
+
+// Use calls UseFunc.
+func (mock *MockAPI) Use(middleware ...fastly.Middleware) {
+	if mock.UseFunc == nil {
+		panic("fastlytest: MockAPI.Use called but UseFunc is nil")
+	}
+	mock.UseFunc(middleware...)
+}
+
+// UserAgent calls UserAgentFunc.
+func (mock *MockAPI) UserAgent() string {
+	if mock.UserAgentFunc == nil {
+		panic("fastlytest: MockAPI.UserAgent called but UserAgentFunc is nil")
+	}
+	return mock.UserAgentFunc()
+}
+
+// ValidateAllDomains calls ValidateAllDomainsFunc.
+func (mock *MockAPI) ValidateAllDomains(ctx context.Context, i *fastly.ValidateAllDomainsInput) ([]*fastly.DomainValidationResult, error) {
+	if mock.ValidateAllDomainsFunc == nil {
+		panic("fastlytest: MockAPI.ValidateAllDomains called but ValidateAllDomainsFunc is nil")
+	}
+	return mock.ValidateAllDomainsFunc(ctx, i)
+}
+
+// ValidateDomain calls ValidateDomainFunc.
+func (mock *MockAPI) ValidateDomain(ctx context.Context, i *fastly.ValidateDomainInput) (*fastly.DomainValidationResult, error) {
+	if mock.ValidateDomainFunc == nil {
+		panic("fastlytest: MockAPI.ValidateDomain called but ValidateDomainFunc is nil")
+	}
+	return mock.ValidateDomainFunc(ctx, i)
+}
+
+// ValidateVersion calls ValidateVersionFunc.
+func (mock *MockAPI) ValidateVersion(ctx context.Context, i *fastly.ValidateVersionInput) (bool, string, error) {
+	if mock.ValidateVersionFunc == nil {
+		panic("fastlytest: MockAPI.ValidateVersion called but ValidateVersionFunc is nil")
+	}
+	return mock.ValidateVersionFunc(ctx, i)
+}
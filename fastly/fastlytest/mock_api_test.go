@@ -0,0 +1,54 @@
+package fastlytest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/computeacls"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestMockAPI(t *testing.T) {
+	t.Parallel()
+
+	mock := &fastlytest.MockAPI{
+		GetServiceFunc: func(_ context.Context, i *fastly.GetServiceInput) (*fastly.Service, error) {
+			return &fastly.Service{ServiceID: fastly.ToPointer(i.ServiceID), Name: fastly.ToPointer("mocked")}, nil
+		},
+	}
+
+	var api fastly.API = mock
+	svc, err := api.GetService(context.TODO(), &fastly.GetServiceInput{ServiceID: "abc"})
+	require.NoError(t, err)
+	require.Equal(t, "mocked", *svc.Name)
+
+	require.PanicsWithValue(t, "fastlytest: MockAPI.DeleteService called but DeleteServiceFunc is nil", func() {
+		_ = api.DeleteService(context.TODO(), &fastly.DeleteServiceInput{ServiceID: "abc"})
+	})
+}
+
+func TestMockAPI_Requester(t *testing.T) {
+	t.Parallel()
+
+	var path string
+	mock := &fastlytest.MockAPI{
+		GetFunc: func(_ context.Context, p string, _ fastly.RequestOptions) (*http.Response, error) {
+			path = p
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"id":"acl1","name":"blocklist"}`)),
+			}, nil
+		},
+	}
+
+	acl, err := computeacls.Describe(context.TODO(), mock, &computeacls.DescribeInput{ComputeACLID: fastly.ToPointer("acl1")})
+	require.NoError(t, err)
+	require.Equal(t, "/resources/acls/acl1", path)
+	require.Equal(t, "blocklist", acl.Name)
+}
@@ -0,0 +1,298 @@
+package fastlytest
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/dnaeon/go-vcr/cassette"
+	"github.com/dnaeon/go-vcr/recorder"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+)
+
+// Masked replaces the values of masked fields in recorded cassettes.
+const Masked = "REDACTED"
+
+// RecorderOption configures a Recorder created with NewRecorder.
+type RecorderOption func(*Recorder)
+
+// WithMode sets the mode of the recorder. By default the recorder replays
+// the cassette if it exists, and records a new one otherwise.
+func WithMode(mode recorder.Mode) RecorderOption {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithTransport sets the transport used to send requests while recording.
+// It defaults to http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithIDs sets IDs to substitute in recorded cassettes, mapping each real
+// ID to its placeholder. See Recorder.SubstituteID.
+func WithIDs(ids map[string]string) RecorderOption {
+	return func(r *Recorder) {
+		maps.Copy(r.ids, ids)
+	}
+}
+
+// WithMaskedFields sets the names of JSON and form fields whose values are
+// replaced by Masked in recorded cassettes, e.g. "secret" or "password".
+// Masked fields are ignored when matching requests during replay.
+func WithMaskedFields(fields ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.masked = append(r.masked, fields...)
+	}
+}
+
+// Recorder is an http.RoundTripper that records API interactions to a
+// go-vcr cassette, and replays them from it.
+//
+// Recorded cassettes are scrubbed as they are saved: the Fastly-Key header
+// is removed, real IDs are replaced by their placeholders, and masked
+// fields are redacted. Responses returned while recording are not
+// affected. During replay, requests are matched on their method, URL and
+// body, ignoring the order of query parameters, form fields and JSON
+// object keys.
+//
+// This structure is safe to use from concurrent goroutines.
+type Recorder struct {
+	rec       *recorder.Recorder
+	mode      recorder.Mode
+	transport http.RoundTripper
+	masked    []string
+
+	mu  sync.Mutex
+	ids map[string]string
+}
+
+// NewRecorder returns a Recorder for the cassette with the given name. The
+// cassette is stored in the file name + ".yaml". The caller must call Stop
+// when finished, to save any recorded interactions.
+func NewRecorder(name string, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		mode: recorder.ModeReplaying,
+		ids:  map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	rec, err := recorder.NewAsMode(name, r.mode, r.transport)
+	if err != nil {
+		return nil, err
+	}
+	rec.SetMatcher(r.match)
+	rec.AddSaveFilter(r.scrub)
+	r.rec = rec
+	return r, nil
+}
+
+// Client returns a new client that sends requests through the recorder.
+// The options are applied after the recorder's HTTP client is set.
+func (r *Recorder) Client(opts ...fastly.Option) (*fastly.Client, error) {
+	opts = append([]fastly.Option{
+		fastly.WithEnvironment(false),
+		fastly.WithHTTPClient(&http.Client{Transport: r}),
+	}, opts...)
+	return fastly.New(opts...)
+}
+
+// Mode returns the mode the recorder is operating in. A recorder created
+// without WithMode is in recorder.ModeRecording if its cassette did not
+// exist.
+func (r *Recorder) Mode() recorder.Mode {
+	return r.rec.Mode()
+}
+
+// SubstituteID replaces the ID real by placeholder in the recorded
+// cassette. It may be called at any time before Stop, e.g. with the ID of a
+// resource created while recording.
+func (r *Recorder) SubstituteID(real, placeholder string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids[real] = placeholder
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.rec.RoundTrip(req)
+}
+
+// Stop saves the cassette if the recorder is recording.
+func (r *Recorder) Stop() error {
+	return r.rec.Stop()
+}
+
+// replacer returns a replacer substituting placeholders for real IDs.
+// Longer IDs are replaced first, in case one ID contains another.
+func (r *Recorder) replacer() *strings.Replacer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := slices.SortedFunc(maps.Keys(r.ids), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), strings.Compare(a, b))
+	})
+	var oldnew []string
+	for _, id := range ids {
+		oldnew = append(oldnew, id, r.ids[id])
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
+// scrub is a go-vcr save filter removing secrets and real IDs from i.
+func (r *Recorder) scrub(i *cassette.Interaction) error {
+	rep := r.replacer()
+
+	i.Request.Headers = scrubHeader(rep, i.Request.Headers)
+	delete(i.Request.Headers, fastly.APIKeyHeader)
+	i.Request.URL = rep.Replace(i.Request.URL)
+	i.Request.Body = r.maskBody(rep.Replace(i.Request.Body), i.Request.Headers.Get("Content-Type"), false)
+	if i.Request.Form != nil {
+		form := url.Values{}
+		for k, vs := range i.Request.Form {
+			for _, v := range vs {
+				form.Add(k, rep.Replace(v))
+			}
+		}
+		r.maskForm(form)
+		i.Request.Form = form
+	}
+
+	i.Response.Headers = scrubHeader(rep, i.Response.Headers)
+	i.Response.Body = r.maskBody(rep.Replace(i.Response.Body), i.Response.Headers.Get("Content-Type"), false)
+	return nil
+}
+
+// scrubHeader returns a copy of h with IDs replaced in its values.
+func scrubHeader(rep *strings.Replacer, h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, vs := range h {
+		for _, v := range vs {
+			out[k] = append(out[k], rep.Replace(v))
+		}
+	}
+	return out
+}
+
+// maskBody masks the fields of a JSON or form-encoded body. Unless
+// canonical is set, the body is returned unchanged if nothing is masked, to
+// preserve its formatting. Other bodies are always returned unchanged.
+func (r *Recorder) maskBody(body, contentType string, canonical bool) string {
+	if body == "" {
+		return body
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(body)
+		if err != nil {
+			return body
+		}
+		if !r.maskForm(form) && !canonical {
+			return body
+		}
+		return form.Encode()
+	}
+
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return body
+	}
+	if !r.maskJSON(v) && !canonical {
+		return body
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+// maskForm masks the fields of form, reporting whether any were found.
+func (r *Recorder) maskForm(form url.Values) bool {
+	var masked bool
+	for _, f := range r.masked {
+		if vs, ok := form[f]; ok {
+			for i := range vs {
+				vs[i] = Masked
+			}
+			masked = true
+		}
+	}
+	return masked
+}
+
+// maskJSON masks the fields of the JSON objects in v, reporting whether any
+// were found.
+func (r *Recorder) maskJSON(v any) bool {
+	var masked bool
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if slices.Contains(r.masked, k) {
+				v[k] = Masked
+				masked = true
+				continue
+			}
+			masked = r.maskJSON(e) || masked
+		}
+	case []any:
+		for _, e := range v {
+			masked = r.maskJSON(e) || masked
+		}
+	}
+	return masked
+}
+
+// normalizeURL returns u with IDs substituted and its query parameters
+// sorted.
+func normalizeURL(rep *strings.Replacer, u string) string {
+	parsed, err := url.Parse(rep.Replace(u))
+	if err != nil {
+		return u
+	}
+	parsed.RawQuery = parsed.Query().Encode()
+	return parsed.String()
+}
+
+// match is a go-vcr matcher comparing requests on their method, normalized
+// URL and normalized body.
+func (r *Recorder) match(req *http.Request, i cassette.Request) bool {
+	if req.Method != i.Method {
+		return false
+	}
+	rep := r.replacer()
+	if normalizeURL(rep, req.URL.String()) != normalizeURL(rep, i.URL) {
+		return false
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return false
+		}
+		// The body may be needed again, either to match another interaction
+		// or to send the request when recording missing interactions.
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	got := r.maskBody(rep.Replace(string(body)), req.Header.Get("Content-Type"), true)
+	want := r.maskBody(rep.Replace(i.Body), i.Headers.Get("Content-Type"), true)
+	return got == want
+}
@@ -0,0 +1,134 @@
+package fastlytest_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	cassette := filepath.Join(t.TempDir(), "cassette")
+
+	// Record against the fake server.
+	r, err := fastlytest.NewRecorder(cassette, fastlytest.WithMaskedFields("item_value"))
+	require.NoError(t, err)
+	require.Equal(t, recorder.ModeRecording, r.Mode())
+	c, err := r.Client(fastly.WithAPIKey(fastlytest.Token), fastly.WithEndpoint(srv.URL))
+	require.NoError(t, err)
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("test"), Comment: fastly.ToPointer("recorded")})
+	require.NoError(t, err)
+	serviceID := *svc.ServiceID
+	r.SubstituteID(serviceID, "SERVICE_ID")
+
+	dict, err := c.CreateDictionary(ctx, &fastly.CreateDictionaryInput{ServiceID: serviceID, ServiceVersion: 1, Name: fastly.ToPointer("d")})
+	require.NoError(t, err)
+	r.SubstituteID(*dict.DictionaryID, "DICTIONARY_ID")
+	item, err := c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{
+		ServiceID:    serviceID,
+		DictionaryID: *dict.DictionaryID,
+		ItemKey:      fastly.ToPointer("key"),
+		ItemValue:    fastly.ToPointer("hunter2"),
+	})
+	require.NoError(t, err)
+	// Responses are not scrubbed while recording.
+	require.Equal(t, "hunter2", *item.ItemValue)
+	require.NoError(t, r.Stop())
+
+	b, err := os.ReadFile(cassette + ".yaml")
+	require.NoError(t, err)
+	recorded := string(b)
+	require.NotContains(t, recorded, fastlytest.Token)
+	require.NotContains(t, recorded, serviceID)
+	require.NotContains(t, recorded, *dict.DictionaryID)
+	require.NotContains(t, recorded, "hunter2")
+	require.Contains(t, recorded, "/service/SERVICE_ID/dictionary/DICTIONARY_ID/item")
+	require.Contains(t, recorded, fastlytest.Masked)
+
+	// Replay without the server, using the placeholder IDs. The form fields
+	// are sent in the same order, but the masked value differs.
+	srv.Close()
+	r, err = fastlytest.NewRecorder(cassette, fastlytest.WithMaskedFields("item_value"))
+	require.NoError(t, err)
+	require.Equal(t, recorder.ModeReplaying, r.Mode())
+	c, err = r.Client(fastly.WithAPIKey("other"), fastly.WithEndpoint(srv.URL))
+	require.NoError(t, err)
+
+	svc, err = c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("test"), Comment: fastly.ToPointer("recorded")})
+	require.NoError(t, err)
+	require.Equal(t, "SERVICE_ID", *svc.ServiceID)
+	_, err = c.CreateDictionary(ctx, &fastly.CreateDictionaryInput{ServiceID: "SERVICE_ID", ServiceVersion: 1, Name: fastly.ToPointer("d")})
+	require.NoError(t, err)
+	item, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{
+		ServiceID:    "SERVICE_ID",
+		DictionaryID: "DICTIONARY_ID",
+		ItemKey:      fastly.ToPointer("key"),
+		ItemValue:    fastly.ToPointer("something else"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, fastlytest.Masked, *item.ItemValue)
+
+	// A request with a different body does not match.
+	_, err = c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("other")})
+	require.Error(t, err)
+	require.NoError(t, r.Stop())
+}
+
+func TestRecorder_MatchNormalizedJSON(t *testing.T) {
+	t.Parallel()
+
+	cassette := filepath.Join(t.TempDir(), "cassette")
+	require.NoError(t, os.WriteFile(cassette+".yaml", []byte(`---
+version: 1
+interactions:
+- request:
+    body: '{"b": 2, "a": {"secret": "x", "c": [1, 2]}}'
+    form: {}
+    headers:
+      Content-Type:
+      - application/json
+    url: https://api.fastly.com/resources?y=2&x=1
+    method: POST
+  response:
+    body: '{"ok": true}'
+    headers:
+      Content-Type:
+      - application/json
+    status: 200 OK
+    code: 200
+    duration: ""
+`), 0o600))
+
+	r, err := fastlytest.NewRecorder(cassette, fastlytest.WithMaskedFields("secret"))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, r.Stop()) })
+
+	send := func(body string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPost, "https://api.fastly.com/resources?x=1&y=2", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		return r.RoundTrip(req)
+	}
+
+	_, err = send(`{"a":{"c":[2,1],"secret":"y"},"b":2}`)
+	require.Error(t, err)
+
+	resp, err := send(`{"a":{"c":[1,2],"secret":"y"},"b":2}`)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+}
@@ -0,0 +1,375 @@
+package fastlytest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+)
+
+// Token is the API token accepted by a Server created without WithTokens.
+const Token = "fastlytest-token"
+
+// CustomerID is the customer ID reported for every resource on a Server.
+const CustomerID = "fastlytestcustomer"
+
+// DefaultRateLimit is the number of modifying requests a Server accepts per
+// hour unless configured otherwise with WithRateLimit.
+const DefaultRateLimit = 1000
+
+// Option configures a Server created with NewServer.
+type Option func(*Server)
+
+// WithTokens sets the API tokens the server accepts in the Fastly-Key
+// header. Requests with any other token are rejected with a 401.
+func WithTokens(tokens ...string) Option {
+	return func(s *Server) {
+		s.tokens = tokens
+	}
+}
+
+// WithRateLimit sets the number of modifying requests the server accepts
+// per hour before responding with a 429. A limit of zero or less disables
+// rate limiting and the Fastly-RateLimit-* headers.
+func WithRateLimit(limit int) Option {
+	return func(s *Server) {
+		s.rateLimit = limit
+	}
+}
+
+// WithClock sets the function used to timestamp resources, e.g. to make
+// created_at and updated_at values deterministic.
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+// Purge records a purge request received by a Server.
+type Purge struct {
+	// ServiceID is the service purged, or empty for a URL purge.
+	ServiceID string
+	// Keys are the surrogate keys purged, if any.
+	Keys []string
+	// URL is the URL purged, if any.
+	URL string
+	// All is true for a purge_all request.
+	All bool
+	// Soft is true if the Fastly-Soft-Purge header was set.
+	Soft bool
+}
+
+// Server is a fake Fastly API served over HTTP. Its state is held in memory
+// and lost when the server is closed.
+//
+// This structure is safe to use from concurrent goroutines.
+type Server struct {
+	*httptest.Server
+
+	// mu serialises all requests, so handlers can access the state below
+	// without further locking.
+	mu        sync.Mutex
+	mux       *http.ServeMux
+	tokens    []string
+	now       func() time.Time
+	rateLimit int
+	remaining int
+	reset     time.Time
+
+	services     map[string]*service
+	dictItems    map[string]map[string]record
+	aclEntries   map[string]map[string]record
+	kvStores     map[string]*kvStore
+	configStores map[string]*configStore
+	secretStores map[string]*secretStore
+	purges       []Purge
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		mux:          http.NewServeMux(),
+		tokens:       []string{Token},
+		now:          time.Now,
+		rateLimit:    DefaultRateLimit,
+		services:     map[string]*service{},
+		dictItems:    map[string]map[string]record{},
+		aclEntries:   map[string]map[string]record{},
+		kvStores:     map[string]*kvStore{},
+		configStores: map[string]*configStore{},
+		secretStores: map[string]*secretStore{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.remaining = s.rateLimit
+
+	s.registerServices()
+	s.registerItems()
+	s.registerStores()
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not supported by fastlytest", r.Method, r.URL.Path))
+	})
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a new client that sends requests to the server using the
+// first accepted token.
+func (s *Server) Client() (*fastly.Client, error) {
+	var token string
+	if len(s.tokens) > 0 {
+		token = s.tokens[0]
+	}
+	return fastly.New(
+		fastly.WithEnvironment(false),
+		fastly.WithAPIKey(token),
+		fastly.WithEndpoint(s.URL),
+	)
+}
+
+// Purges returns the purge requests received so far, oldest first.
+func (s *Server) Purges() []Purge {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.purges)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !slices.Contains(s.tokens, r.Header.Get(fastly.APIKeyHeader)) {
+		writeError(w, http.StatusUnauthorized, "Provided credentials are missing or invalid")
+		return
+	}
+
+	if s.rateLimit > 0 && r.Method != http.MethodGet && r.Method != http.MethodHead {
+		now := s.now()
+		if !now.Before(s.reset) {
+			s.reset = now.Truncate(time.Hour).Add(time.Hour)
+			s.remaining = s.rateLimit
+		}
+		w.Header().Set("Fastly-RateLimit-Reset", strconv.FormatInt(s.reset.Unix(), 10))
+		if s.remaining <= 0 {
+			w.Header().Set("Fastly-RateLimit-Remaining", "0")
+			w.Header().Set("Retry-After", strconv.Itoa(int(s.reset.Sub(now).Seconds())+1))
+			writeError(w, http.StatusTooManyRequests, "You have exceeded your hourly rate limit")
+			return
+		}
+		s.remaining--
+		w.Header().Set("Fastly-RateLimit-Remaining", strconv.Itoa(s.remaining))
+	}
+
+	w.Header().Set("X-Request-Id", newID())
+
+	// URL purges are matched here rather than by the mux, which would clean
+	// the double slash in the purged URL's scheme and redirect the request.
+	if r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/purge/") {
+		s.purgeURL(w, r)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// timestamp returns the current time formatted as the API does.
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+// record is a resource as rendered in JSON responses.
+type record map[string]any
+
+// clone returns a copy of r, including its nested objects and arrays.
+func (r record) clone() record {
+	out := maps.Clone(r)
+	for k, v := range out {
+		switch v := v.(type) {
+		case record:
+			out[k] = v.clone()
+		case []any:
+			out[k] = slices.Clone(v)
+		}
+	}
+	return out
+}
+
+func (r record) str(k string) string {
+	v, _ := r[k].(string)
+	return v
+}
+
+// intFields and boolFields are the form fields of the modelled resources
+// whose values are rendered as JSON numbers and booleans respectively.
+var (
+	intFields = []string{
+		"between_bytes_timeout", "capacity", "check_interval",
+		"connect_timeout", "error_threshold", "expected_response",
+		"feature_revision", "first_byte_timeout", "general.default_ttl",
+		"general.stale_if_error_ttl", "initial", "keepalive_time", "max_conn",
+		"max_lifetime", "max_use", "penalty_box_duration", "port", "quorum",
+		"retries", "rps_limit", "status", "subnet", "tcp_keepalive_interval",
+		"tcp_keepalive_probes", "tcp_keepalive_time", "threshold", "timeout",
+		"weight", "window", "window_size",
+	}
+	boolFields = []string{
+		"auto_loadbalance", "general.stale_if_error", "main", "negated",
+		"prefer_ipv6", "ssl_check_cert", "tcp_keepalive_enable", "use_ssl",
+		"write_only",
+	}
+)
+
+// parseFields parses the fields of a form or JSON request body into
+// r.PostForm, as endpoints which accept either do. JSON values are
+// converted to their form representation, with null as an empty string.
+func parseFields(r *http.Request) error {
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), fastly.JSONMimeType) {
+		return r.ParseForm()
+	}
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	var body map[string]any
+	if err := dec.Decode(&body); err != nil {
+		return err
+	}
+	r.PostForm = url.Values{}
+	for k, v := range body {
+		if v == nil {
+			r.PostForm.Set(k, "")
+		} else {
+			r.PostForm.Set(k, fmt.Sprint(v))
+		}
+	}
+	return nil
+}
+
+// applyForm copies the fields of a form request body into rec. Fields named
+// "field[]" are copied as arrays, and fields named "field[key]" into a
+// nested object, as encoded by go-querystring.
+func applyForm(rec record, form url.Values) {
+	for k, vs := range form {
+		if len(vs) == 0 {
+			continue
+		}
+		if name, ok := strings.CutSuffix(k, "[]"); ok {
+			values := make([]any, len(vs))
+			for i, v := range vs {
+				values[i] = v
+			}
+			rec[name] = values
+			continue
+		}
+		if name, key, ok := strings.Cut(k, "["); ok && strings.HasSuffix(key, "]") {
+			nested, _ := rec[name].(record)
+			if nested == nil {
+				nested = record{}
+				rec[name] = nested
+			}
+			applyForm(nested, url.Values{strings.TrimSuffix(key, "]"): vs})
+			continue
+		}
+		v := vs[len(vs)-1]
+		switch {
+		case slices.Contains(intFields, k):
+			if n, err := strconv.Atoi(v); err == nil {
+				rec[k] = n
+				continue
+			}
+		case slices.Contains(boolFields, k):
+			if b, err := strconv.ParseBool(v); err == nil {
+				rec[k] = b
+				continue
+			}
+		}
+		rec[k] = v
+	}
+}
+
+// newID returns a random identifier in the style of Fastly resource IDs.
+func newID() string {
+	return strings.ToLower(rand.Text())[:22]
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", fastly.JSONMimeType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a JSON:API error body.
+func writeError(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{
+			"title":  http.StatusText(status),
+			"detail": detail,
+			"status": strconv.Itoa(status),
+		}},
+	})
+}
+
+func writeStatusOK(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// writePage writes the page of recs selected by the page and per_page query
+// parameters, with Link headers pointing to the next and last pages.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, recs []record) {
+	q := r.URL.Query()
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = 100
+	}
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+	last := max((len(recs)+perPage-1)/perPage, 1)
+
+	if page < last {
+		link := func(p int) string {
+			u := *r.URL
+			q := u.Query()
+			q.Set("page", strconv.Itoa(p))
+			q.Set("per_page", strconv.Itoa(perPage))
+			u.RawQuery = q.Encode()
+			return s.URL + u.RequestURI()
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next", <%s>; rel="last"`, link(page+1), link(last)))
+	}
+
+	start := min((page-1)*perPage, len(recs))
+	end := min(start+perPage, len(recs))
+	writeJSON(w, http.StatusOK, recs[start:end])
+}
+
+// sortRecords sorts recs by the sort and direction query parameters,
+// defaulting to field. Ties are broken by ID, so that the order is stable
+// across paginated requests.
+func sortRecords(r *http.Request, recs []record, field string) {
+	if f := r.URL.Query().Get("sort"); f != "" {
+		field = f
+	}
+	slices.SortFunc(recs, func(a, b record) int {
+		if c := strings.Compare(fmt.Sprint(a[field]), fmt.Sprint(b[field])); c != 0 {
+			return c
+		}
+		return strings.Compare(a.str("id"), b.str("id"))
+	})
+	if r.URL.Query().Get("direction") == "descend" {
+		slices.Reverse(recs)
+	}
+}
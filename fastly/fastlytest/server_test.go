@@ -0,0 +1,330 @@
+package fastlytest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func newClient(t *testing.T, opts ...fastlytest.Option) (*fastlytest.Server, *fastly.Client) {
+	t.Helper()
+	srv := fastlytest.NewServer(opts...)
+	t.Cleanup(srv.Close)
+	c, err := fastly.NewClientForEndpoint(fastlytest.Token, srv.URL)
+	require.NoError(t, err)
+	return srv, c
+}
+
+func TestServer_Versions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	_, c := newClient(t)
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("test")})
+	require.NoError(t, err)
+	require.Equal(t, "test", *svc.Name)
+	require.Len(t, svc.Versions, 1)
+	id := *svc.ServiceID
+
+	// Activating a version without domains fails.
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.Error(t, err)
+
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("origin"),
+		Address:        fastly.ToPointer("origin.example.com"),
+		Port:           fastly.ToPointer(443),
+		UseSSL:         fastly.ToPointer(fastly.Compatibool(true)),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("origin")})
+	require.ErrorIs(t, err, fastly.ErrConflict)
+
+	v, err := c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.True(t, *v.Active)
+	require.True(t, *v.Locked)
+
+	// The active version is locked.
+	_, err = c.UpdateBackend(ctx, &fastly.UpdateBackendInput{ServiceID: id, ServiceVersion: 1, Name: "origin", Port: fastly.ToPointer(80)})
+	require.ErrorIs(t, err, fastly.ErrVersionLocked)
+
+	v, err = c.CloneVersion(ctx, &fastly.CloneVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, *v.Number)
+	require.False(t, *v.Locked)
+
+	b, err := c.UpdateBackend(ctx, &fastly.UpdateBackendInput{
+		ServiceID:      id,
+		ServiceVersion: 2,
+		Name:           "origin",
+		NewName:        fastly.ToPointer("primary"),
+		Port:           fastly.ToPointer(80),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "primary", *b.Name)
+	require.Equal(t, 80, *b.Port)
+	require.Equal(t, 2, *b.ServiceVersion)
+	require.True(t, *b.UseSSL)
+
+	// The cloned version's changes do not affect the original.
+	b, err = c.GetBackend(ctx, &fastly.GetBackendInput{ServiceID: id, ServiceVersion: 1, Name: "origin"})
+	require.NoError(t, err)
+	require.Equal(t, 443, *b.Port)
+
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 2})
+	require.NoError(t, err)
+	details, err := c.GetServiceDetails(ctx, &fastly.GetServiceDetailsInput{ServiceID: id})
+	require.NoError(t, err)
+	require.Equal(t, 2, *details.ActiveVersion.Number)
+	require.Len(t, details.Versions, 2)
+	require.False(t, *details.Versions[0].Active)
+
+	// A service with an active version cannot be deleted.
+	err = c.DeleteService(ctx, &fastly.DeleteServiceInput{ServiceID: id})
+	require.Error(t, err)
+	_, err = c.DeactivateVersion(ctx, &fastly.DeactivateVersionInput{ServiceID: id, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.NoError(t, c.DeleteService(ctx, &fastly.DeleteServiceInput{ServiceID: id}))
+
+	_, err = c.GetService(ctx, &fastly.GetServiceInput{ServiceID: id})
+	var herr *fastly.HTTPError
+	require.ErrorAs(t, err, &herr)
+	require.True(t, herr.IsNotFound())
+}
+
+func TestServer_Pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	_, c := newClient(t)
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer(name)})
+		require.NoError(t, err)
+	}
+
+	p := c.GetServices(ctx, &fastly.GetServicesInput{PerPage: fastly.ToPointer(2), Sort: fastly.ToPointer("name")})
+	var names []string
+	for p.HasNext() {
+		page, err := p.GetNext()
+		require.NoError(t, err)
+		for _, svc := range page {
+			names = append(names, *svc.Name)
+		}
+	}
+	require.Equal(t, []string{"a", "b", "c"}, names)
+}
+
+func TestServer_DictionaryItemsAndACLEntries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	_, c := newClient(t)
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("test")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+
+	dict, err := c.CreateDictionary(ctx, &fastly.CreateDictionaryInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("config")})
+	require.NoError(t, err)
+	_, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{
+		ServiceID:    id,
+		DictionaryID: *dict.DictionaryID,
+		ItemKey:      fastly.ToPointer("a"),
+		ItemValue:    fastly.ToPointer("1"),
+	})
+	require.NoError(t, err)
+	err = c.BatchModifyDictionaryItems(ctx, &fastly.BatchModifyDictionaryItemsInput{
+		ServiceID:    id,
+		DictionaryID: *dict.DictionaryID,
+		Items: []*fastly.BatchDictionaryItem{
+			{Operation: fastly.ToPointer(fastly.UpdateBatchOperation), ItemKey: fastly.ToPointer("a"), ItemValue: fastly.ToPointer("2")},
+			{Operation: fastly.ToPointer(fastly.CreateBatchOperation), ItemKey: fastly.ToPointer("b"), ItemValue: fastly.ToPointer("3")},
+		},
+	})
+	require.NoError(t, err)
+
+	items, err := c.ListDictionaryItems(ctx, &fastly.ListDictionaryItemsInput{ServiceID: id, DictionaryID: *dict.DictionaryID})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, "a", *items[0].ItemKey)
+	require.Equal(t, "2", *items[0].ItemValue)
+
+	acl, err := c.CreateACL(ctx, &fastly.CreateACLInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("block")})
+	require.NoError(t, err)
+	entry, err := c.CreateACLEntry(ctx, &fastly.CreateACLEntryInput{
+		ServiceID: id,
+		ACLID:     *acl.ACLID,
+		IP:        fastly.ToPointer("192.0.2.0"),
+		Subnet:    fastly.ToPointer(24),
+		Negated:   fastly.ToPointer(fastly.Compatibool(true)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 24, *entry.Subnet)
+	require.True(t, *entry.Negated)
+
+	// The ACL keeps its ID, and so its entries, across clones.
+	_, err = c.CloneVersion(ctx, &fastly.CloneVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	acl2, err := c.GetACL(ctx, &fastly.GetACLInput{ServiceID: id, ServiceVersion: 2, Name: "block"})
+	require.NoError(t, err)
+	require.Equal(t, *acl.ACLID, *acl2.ACLID)
+
+	require.NoError(t, c.DeleteACLEntry(ctx, &fastly.DeleteACLEntryInput{ServiceID: id, ACLID: *acl.ACLID, EntryID: *entry.EntryID}))
+	entries, err := c.ListACLEntries(ctx, &fastly.ListACLEntriesInput{ServiceID: id, ACLID: *acl.ACLID})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestServer_KVStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	_, c := newClient(t)
+
+	st, err := c.CreateKVStore(ctx, &fastly.CreateKVStoreInput{Name: "store"})
+	require.NoError(t, err)
+
+	for _, k := range []string{"a", "b", "c"} {
+		require.NoError(t, c.InsertKVStoreKey(ctx, &fastly.InsertKVStoreKeyInput{StoreID: st.StoreID, Key: k, Value: "value-" + k}))
+	}
+	err = c.InsertKVStoreKey(ctx, &fastly.InsertKVStoreKeyInput{StoreID: st.StoreID, Key: "a", Value: "x", Add: true})
+	var herr *fastly.HTTPError
+	require.ErrorAs(t, err, &herr)
+	require.Equal(t, http.StatusPreconditionFailed, herr.StatusCode)
+
+	item, err := c.GetKVStoreItem(ctx, &fastly.GetKVStoreItemInput{StoreID: st.StoreID, Key: "b"})
+	require.NoError(t, err)
+	v, err := item.ValueAsString()
+	require.NoError(t, err)
+	require.Equal(t, "value-b", v)
+	require.Equal(t, uint64(1), item.Generation)
+
+	var keys []string
+	for k, err := range c.AllKVStoreKeys(ctx, &fastly.ListKVStoreKeysInput{StoreID: st.StoreID, Limit: 2}) {
+		require.NoError(t, err)
+		keys = append(keys, k)
+	}
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+
+	// Failed batch lines are reported by index.
+	err = c.BatchModifyKVStoreKey(ctx, &fastly.BatchModifyKVStoreKeyInput{
+		StoreID: st.StoreID,
+		Body:    strings.NewReader(`{"key":"d","value":"ZA=="}` + "\n" + `{"key":"e","value":"!"}` + "\n"),
+	})
+	require.ErrorAs(t, err, &herr)
+	require.Len(t, herr.BatchErrors, 1)
+	require.Equal(t, 1, herr.BatchErrors[0].Index)
+
+	require.NoError(t, c.DeleteKVStoreKey(ctx, &fastly.DeleteKVStoreKeyInput{StoreID: st.StoreID, Key: "a"}))
+	require.Error(t, c.DeleteKVStoreKey(ctx, &fastly.DeleteKVStoreKeyInput{StoreID: st.StoreID, Key: "a"}))
+	require.NoError(t, c.DeleteKVStoreKey(ctx, &fastly.DeleteKVStoreKeyInput{StoreID: st.StoreID, Key: "a", Force: true}))
+	require.NoError(t, c.DeleteKVStore(ctx, &fastly.DeleteKVStoreInput{StoreID: st.StoreID}))
+}
+
+func TestServer_ConfigAndSecretStores(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	_, c := newClient(t)
+
+	cs, err := c.CreateConfigStore(ctx, &fastly.CreateConfigStoreInput{Name: "config"})
+	require.NoError(t, err)
+	_, err = c.CreateConfigStoreItem(ctx, &fastly.CreateConfigStoreItemInput{StoreID: cs.StoreID, Key: "k", Value: "v"})
+	require.NoError(t, err)
+	_, err = c.UpdateConfigStoreItem(ctx, &fastly.UpdateConfigStoreItemInput{StoreID: cs.StoreID, Key: "missing", Value: "v"})
+	require.Error(t, err)
+	_, err = c.UpdateConfigStoreItem(ctx, &fastly.UpdateConfigStoreItemInput{StoreID: cs.StoreID, Key: "new", Value: "v", Upsert: true})
+	require.NoError(t, err)
+	meta, err := c.GetConfigStoreMetadata(ctx, &fastly.GetConfigStoreMetadataInput{StoreID: cs.StoreID})
+	require.NoError(t, err)
+	require.Equal(t, 2, meta.ItemCount)
+
+	ss, err := c.CreateSecretStore(ctx, &fastly.CreateSecretStoreInput{Name: "secrets"})
+	require.NoError(t, err)
+	secret, err := c.CreateSecret(ctx, &fastly.CreateSecretInput{StoreID: ss.StoreID, Name: "s", Secret: []byte("hunter2")})
+	require.NoError(t, err)
+	require.NotEmpty(t, secret.Digest)
+	require.False(t, secret.Recreated)
+
+	_, err = c.CreateSecret(ctx, &fastly.CreateSecretInput{StoreID: ss.StoreID, Name: "s", Secret: []byte("x")})
+	require.ErrorIs(t, err, fastly.ErrConflict)
+	secret, err = c.CreateSecret(ctx, &fastly.CreateSecretInput{StoreID: ss.StoreID, Name: "s", Secret: []byte("x"), Method: http.MethodPut})
+	require.NoError(t, err)
+	require.True(t, secret.Recreated)
+
+	secrets, err := c.ListSecrets(ctx, &fastly.ListSecretsInput{StoreID: ss.StoreID})
+	require.NoError(t, err)
+	require.Len(t, secrets.Data, 1)
+}
+
+func TestServer_Purges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	srv, c := newClient(t)
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("test")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+
+	_, err = c.PurgeKey(ctx, &fastly.PurgeKeyInput{ServiceID: id, Key: "k1", Soft: true})
+	require.NoError(t, err)
+	ids, err := c.PurgeKeys(ctx, &fastly.PurgeKeysInput{ServiceID: id, Keys: []string{"k2", "k3"}})
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	_, err = c.PurgeAll(ctx, &fastly.PurgeAllInput{ServiceID: id})
+	require.NoError(t, err)
+	_, err = c.Purge(ctx, &fastly.PurgeInput{URL: "https://www.example.com/index.html"})
+	require.NoError(t, err)
+
+	require.Equal(t, []fastlytest.Purge{
+		{ServiceID: id, Keys: []string{"k1"}, Soft: true},
+		{ServiceID: id, Keys: []string{"k2", "k3"}},
+		{ServiceID: id, All: true},
+		{URL: "https://www.example.com/index.html"},
+	}, srv.Purges())
+}
+
+func TestServer_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	srv, _ := newClient(t, fastlytest.WithRateLimit(1))
+
+	c, err := fastly.NewClientForEndpoint("wrong", srv.URL)
+	require.NoError(t, err)
+	_, err = c.GetService(ctx, &fastly.GetServiceInput{ServiceID: "x"})
+	require.ErrorIs(t, err, fastly.ErrUnauthorized)
+
+	c, err = srv.Client()
+	require.NoError(t, err)
+	var meta fastly.ResponseMeta
+	_, err = c.CreateService(fastly.WithResponseMeta(ctx, &meta), &fastly.CreateServiceInput{Name: fastly.ToPointer("a")})
+	require.NoError(t, err)
+	require.Equal(t, 0, *meta.RateLimitRemaining)
+	require.NotEmpty(t, meta.RequestID)
+
+	_, err = c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("b")})
+	require.ErrorIs(t, err, fastly.ErrRateLimited)
+	var herr *fastly.HTTPError
+	require.True(t, errors.As(err, &herr))
+	require.Positive(t, herr.RetryAfter)
+
+	// Reads are not rate limited.
+	_, err = c.ListServices(ctx, &fastly.ListServicesInput{})
+	require.NoError(t, err)
+}
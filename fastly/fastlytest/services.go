@@ -0,0 +1,963 @@
+package fastlytest
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// service is a service and its versions.
+type service struct {
+	rec      record
+	versions []*version // versions[i] has number i+1
+	// dynamicSnippets holds the content of the dynamic snippets of the
+	// service, keyed by snippet ID. Like the real API, the content is shared
+	// by every version and is not returned by the versioned endpoints.
+	dynamicSnippets map[string]record
+}
+
+// version is a service version and the configuration it holds.
+type version struct {
+	number    int
+	active    bool
+	locked    bool
+	comment   string
+	createdAt string
+	updatedAt string
+	// environments holds the names of the environments, e.g. "staging", the
+	// version is active in.
+	environments []string
+
+	// config maps a kind of versioned resource, e.g. "backend", to the
+	// resources of that kind keyed by name.
+	config map[string]map[string]record
+	// settings holds the general settings of the version.
+	settings record
+}
+
+// versionedKinds are the versioned resources modelled by the server. Those
+// mapped to true are identified by an ID which is kept when a version is
+// cloned, except for rate limiters which are given a new one.
+var versionedKinds = map[string]bool{
+	"acl":                      true,
+	"backend":                  false,
+	"cache_settings":           false,
+	"condition":                false,
+	"dictionary":               true,
+	"director":                 false,
+	"domain":                   false,
+	"gzip":                     false,
+	"header":                   false,
+	"healthcheck":              false,
+	"rate-limiters":            true,
+	"request_settings":         false,
+	"resource":                 true,
+	"response_object":          false,
+	"snippet":                  true,
+	"vcl":                      false,
+	"logging/azureblob":        false,
+	"logging/bigquery":         false,
+	"logging/cloudfiles":       false,
+	"logging/datadog":          false,
+	"logging/digitalocean":     false,
+	"logging/elasticsearch":    false,
+	"logging/ftp":              false,
+	"logging/gcs":              false,
+	"logging/grafanacloudlogs": false,
+	"logging/heroku":           false,
+	"logging/honeycomb":        false,
+	"logging/https":            false,
+	"logging/kafka":            false,
+	"logging/kinesis":          false,
+	"logging/logentries":       false,
+	"logging/loggly":           false,
+	"logging/logshuttle":       false,
+	"logging/newrelic":         false,
+	"logging/newrelicotlp":     false,
+	"logging/openstack":        false,
+	"logging/papertrail":       false,
+	"logging/pubsub":           false,
+	"logging/s3":               false,
+	"logging/scalyr":           false,
+	"logging/sftp":             false,
+	"logging/splunk":           false,
+	"logging/sumologic":        false,
+	"logging/syslog":           false,
+}
+
+func (s *Server) registerServices() {
+	s.mux.HandleFunc("GET /service", s.listServices)
+	s.mux.HandleFunc("POST /service", s.createService)
+	s.mux.HandleFunc("GET /service/search", s.searchService)
+	s.mux.HandleFunc("GET /service/{service}", s.getService)
+	s.mux.HandleFunc("PUT /service/{service}", s.updateService)
+	s.mux.HandleFunc("DELETE /service/{service}", s.deleteService)
+	s.mux.HandleFunc("GET /service/{service}/details", s.getServiceDetails)
+	s.mux.HandleFunc("GET /service/{service}/domain", s.listServiceDomains)
+
+	s.mux.HandleFunc("GET /service/{service}/version", s.listVersions)
+	s.mux.HandleFunc("POST /service/{service}/version", s.createVersion)
+	s.mux.HandleFunc("GET /service/{service}/version/{version}", s.getVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}", s.updateVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/clone", s.cloneVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/activate", s.activateVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/activate/{env}", s.activateVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/deactivate", s.deactivateVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/deactivate/{env}", s.deactivateVersion)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/lock", s.lockVersion)
+	s.mux.HandleFunc("GET /service/{service}/version/{version}/validate", s.validateVersion)
+
+	for kind := range versionedKinds {
+		base := "/service/{service}/version/{version}/" + kind
+		s.mux.HandleFunc("GET "+base, s.listVersioned(kind))
+		s.mux.HandleFunc("POST "+base, s.createVersioned(kind))
+		if kind == "rate-limiters" {
+			// Rate limiters are read, updated and deleted by ID alone.
+			continue
+		}
+		s.mux.HandleFunc("GET "+base+"/{name}", s.getVersioned(kind))
+		s.mux.HandleFunc("PUT "+base+"/{name}", s.updateVersioned(kind))
+		s.mux.HandleFunc("DELETE "+base+"/{name}", s.deleteVersioned(kind))
+	}
+	directorBackend := "/service/{service}/version/{version}/director/{name}/backend/{backend}"
+	s.mux.HandleFunc("POST "+directorBackend, s.createDirectorBackend)
+	s.mux.HandleFunc("GET "+directorBackend, s.getDirectorBackend)
+	s.mux.HandleFunc("DELETE "+directorBackend, s.deleteDirectorBackend)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/vcl/{name}/main", s.setMainVCL)
+	s.mux.HandleFunc("GET /service/{service}/snippet/{id}", s.getDynamicSnippet)
+	s.mux.HandleFunc("PUT /service/{service}/snippet/{id}", s.updateDynamicSnippet)
+	s.mux.HandleFunc("GET /rate-limiters/{id}", s.getRateLimiter)
+	s.mux.HandleFunc("PUT /rate-limiters/{id}", s.updateRateLimiter)
+	s.mux.HandleFunc("DELETE /rate-limiters/{id}", s.deleteRateLimiter)
+	s.mux.HandleFunc("GET /service/{service}/version/{version}/settings", s.getSettings)
+	s.mux.HandleFunc("PUT /service/{service}/version/{version}/settings", s.updateSettings)
+
+	s.mux.HandleFunc("POST /service/{service}/purge_all", s.purgeAll)
+	s.mux.HandleFunc("POST /service/{service}/purge", s.purgeKeys)
+	s.mux.HandleFunc("POST /service/{service}/purge/{key}", s.purgeKey)
+}
+
+// serviceJSON renders svc as returned by the service endpoints.
+func (svc *service) serviceJSON() record {
+	rec := svc.rec.clone()
+	rec["version"] = 0
+	if v := svc.activeVersion(); v != nil {
+		rec["version"] = v.number
+	}
+	versions := make([]record, len(svc.versions))
+	for i, v := range svc.versions {
+		versions[i] = v.versionJSON(svc.rec.str("id"))
+	}
+	rec["versions"] = versions
+	return rec
+}
+
+// versionJSON renders v as returned by the version endpoints.
+func (v *version) versionJSON(serviceID string) record {
+	environments := make([]any, len(v.environments))
+	for i, name := range v.environments {
+		environments[i] = record{"active_version": v.number, "name": name, "service_id": serviceID}
+	}
+	return record{
+		"active":       v.active,
+		"comment":      v.comment,
+		"created_at":   v.createdAt,
+		"deleted_at":   nil,
+		"deployed":     false,
+		"environments": environments,
+		"locked":       v.locked,
+		"number":       v.number,
+		"service_id":   serviceID,
+		"staging":      slices.Contains(v.environments, "staging"),
+		"testing":      false,
+		"updated_at":   v.updatedAt,
+	}
+}
+
+func (svc *service) activeVersion() *version {
+	for _, v := range svc.versions {
+		if v.active {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *Server) newVersion(svc *service, config map[string]map[string]record) *version {
+	if config == nil {
+		config = map[string]map[string]record{}
+	}
+	for kind := range versionedKinds {
+		if config[kind] == nil {
+			config[kind] = map[string]record{}
+		}
+	}
+	v := &version{
+		number:    len(svc.versions) + 1,
+		createdAt: s.timestamp(),
+		updatedAt: s.timestamp(),
+		config:    config,
+		settings: record{
+			"general.default_host":       "",
+			"general.default_ttl":        3600,
+			"general.stale_if_error":     false,
+			"general.stale_if_error_ttl": 43200,
+		},
+	}
+	svc.versions = append(svc.versions, v)
+	return v
+}
+
+// lookupService returns the service named by the request path, or writes a
+// 404 and returns nil.
+func (s *Server) lookupService(w http.ResponseWriter, r *http.Request) *service {
+	svc, ok := s.services[r.PathValue("service")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return nil
+	}
+	return svc
+}
+
+// lookupVersion returns the service and version named by the request path,
+// or writes a 404 and returns nils.
+func (s *Server) lookupVersion(w http.ResponseWriter, r *http.Request) (*service, *version) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(r.PathValue("version"))
+	if err != nil || n < 1 || n > len(svc.versions) {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return nil, nil
+	}
+	return svc, svc.versions[n-1]
+}
+
+// lookupEditableVersion is like lookupVersion, but writes a 400 and returns
+// nils if the version is locked.
+func (s *Server) lookupEditableVersion(w http.ResponseWriter, r *http.Request) (*service, *version) {
+	svc, v := s.lookupVersion(w, r)
+	if v != nil && v.locked {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Version %d is locked", v.number))
+		return nil, nil
+	}
+	return svc, v
+}
+
+func (s *Server) listServices(w http.ResponseWriter, r *http.Request) {
+	recs := make([]record, 0, len(s.services))
+	for _, svc := range s.services {
+		recs = append(recs, svc.serviceJSON())
+	}
+	sortRecords(r, recs, "created_at")
+	s.writePage(w, r, recs)
+}
+
+func (s *Server) createService(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Get("name") == "" {
+		writeError(w, http.StatusBadRequest, "Name can't be blank")
+		return
+	}
+	for _, svc := range s.services {
+		if svc.rec.str("name") == r.PostForm.Get("name") {
+			writeError(w, http.StatusConflict, "Duplicate record")
+			return
+		}
+	}
+
+	svc := &service{rec: record{
+		"comment":     "",
+		"created_at":  s.timestamp(),
+		"customer_id": CustomerID,
+		"deleted_at":  nil,
+		"id":          newID(),
+		"type":        "vcl",
+		"updated_at":  s.timestamp(),
+	}, dynamicSnippets: map[string]record{}}
+	applyForm(svc.rec, r.PostForm)
+	s.newVersion(svc, nil)
+	s.services[svc.rec.str("id")] = svc
+
+	writeJSON(w, http.StatusOK, svc.serviceJSON())
+}
+
+func (s *Server) searchService(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	for _, svc := range s.services {
+		if svc.rec.str("name") == name {
+			writeJSON(w, http.StatusOK, svc.serviceJSON())
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Record not found")
+}
+
+func (s *Server) getService(w http.ResponseWriter, r *http.Request) {
+	if svc := s.lookupService(w, r); svc != nil {
+		writeJSON(w, http.StatusOK, svc.serviceJSON())
+	}
+}
+
+func (s *Server) updateService(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	applyForm(svc.rec, r.PostForm)
+	svc.rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, svc.serviceJSON())
+}
+
+func (s *Server) deleteService(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	if svc.activeVersion() != nil {
+		writeError(w, http.StatusBadRequest, "Service has an active version and cannot be deleted")
+		return
+	}
+	delete(s.services, svc.rec.str("id"))
+	writeStatusOK(w)
+}
+
+func (s *Server) getServiceDetails(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("service")]
+	if !ok {
+		// The API responds with a 400 rather than a 404 for this endpoint.
+		writeError(w, http.StatusBadRequest, "Record not found")
+		return
+	}
+
+	rec := svc.serviceJSON()
+	id := svc.rec.str("id")
+	current := svc.activeVersion()
+	if current != nil {
+		rec["active_version"] = current.versionJSON(id)
+	} else {
+		current = svc.versions[len(svc.versions)-1]
+	}
+	if q := r.URL.Query().Get("version"); q != "" {
+		n, err := strconv.Atoi(q)
+		if err != nil || n < 1 || n > len(svc.versions) {
+			writeError(w, http.StatusNotFound, "Record not found")
+			return
+		}
+		current = svc.versions[n-1]
+	}
+	rec["version"] = current.versionJSON(id)
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) listServiceDomains(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	v := svc.activeVersion()
+	if v == nil {
+		v = svc.versions[len(svc.versions)-1]
+	}
+	writeJSON(w, http.StatusOK, sortedRecords(v.config["domain"]))
+}
+
+func (s *Server) listVersions(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	recs := make([]record, len(svc.versions))
+	for i, v := range svc.versions {
+		recs[i] = v.versionJSON(svc.rec.str("id"))
+	}
+	writeJSON(w, http.StatusOK, recs)
+}
+
+func (s *Server) createVersion(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	v := s.newVersion(svc, nil)
+	v.comment = r.PostForm.Get("comment")
+	writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, r *http.Request) {
+	if svc, v := s.lookupVersion(w, r); v != nil {
+		writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+	}
+}
+
+func (s *Server) updateVersion(w http.ResponseWriter, r *http.Request) {
+	svc, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Has("comment") {
+		v.comment = r.PostForm.Get("comment")
+	}
+	v.updatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+}
+
+func (s *Server) cloneVersion(w http.ResponseWriter, r *http.Request) {
+	svc, src := s.lookupVersion(w, r)
+	if src == nil {
+		return
+	}
+	config := make(map[string]map[string]record, len(src.config))
+	for kind, recs := range src.config {
+		config[kind] = make(map[string]record, len(recs))
+		for name, rec := range recs {
+			config[kind][name] = rec.clone()
+		}
+	}
+	v := s.newVersion(svc, config)
+	v.comment = src.comment
+	v.settings = src.settings.clone()
+	for kind, recs := range v.config {
+		for _, rec := range recs {
+			if kind == "rate-limiters" {
+				rec["id"] = newID()
+			}
+			rec["version"] = v.number
+			rec["created_at"] = v.createdAt
+			rec["updated_at"] = v.createdAt
+		}
+	}
+	writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+}
+
+func (s *Server) activateVersion(w http.ResponseWriter, r *http.Request) {
+	svc, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if len(v.config["domain"]) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Version %d has no domains", v.number))
+		return
+	}
+	if env := r.PathValue("env"); env != "" {
+		for _, other := range svc.versions {
+			other.environments = slices.DeleteFunc(other.environments, func(e string) bool { return e == env })
+		}
+		v.environments = append(v.environments, env)
+		slices.Sort(v.environments)
+	} else {
+		for _, other := range svc.versions {
+			other.active = false
+		}
+		v.active = true
+	}
+	v.locked = true
+	v.updatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+}
+
+func (s *Server) deactivateVersion(w http.ResponseWriter, r *http.Request) {
+	svc, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if env := r.PathValue("env"); env != "" {
+		if !slices.Contains(v.environments, env) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Version %d is not active in %s", v.number, env))
+			return
+		}
+		v.environments = slices.DeleteFunc(v.environments, func(e string) bool { return e == env })
+		v.updatedAt = s.timestamp()
+		writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+		return
+	}
+	if !v.active {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Version %d is not active", v.number))
+		return
+	}
+	v.active = false
+	v.updatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+}
+
+func (s *Server) lockVersion(w http.ResponseWriter, r *http.Request) {
+	svc, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	v.locked = true
+	v.updatedAt = s.timestamp()
+	writeJSON(w, http.StatusOK, v.versionJSON(svc.rec.str("id")))
+}
+
+func (s *Server) validateVersion(w http.ResponseWriter, r *http.Request) {
+	_, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if len(v.config["domain"]) == 0 {
+		writeJSON(w, http.StatusOK, record{"status": "error", "msg": "Version has no domains", "errors": []string{"Version has no domains"}})
+		return
+	}
+	writeJSON(w, http.StatusOK, record{"status": "ok", "msg": nil, "errors": []string{}})
+}
+
+// sortedRecords returns the values of recs ordered by name.
+func sortedRecords(recs map[string]record) []record {
+	names := slices.Sorted(maps.Keys(recs))
+	out := make([]record, len(names))
+	for i, name := range names {
+		out[i] = recs[name]
+	}
+	return out
+}
+
+func (s *Server) listVersioned(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, v := s.lookupVersion(w, r); v != nil {
+			writeJSON(w, http.StatusOK, sortedRecords(v.config[kind]))
+		}
+	}
+}
+
+func (s *Server) createVersioned(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		svc, v := s.lookupEditableVersion(w, r)
+		if v == nil {
+			return
+		}
+		if err := parseFields(r); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		name := r.PostForm.Get("name")
+		if name == "" {
+			writeError(w, http.StatusBadRequest, "Name can't be blank")
+			return
+		}
+		if _, ok := v.config[kind][name]; ok {
+			writeError(w, http.StatusConflict, "Duplicate record")
+			return
+		}
+
+		rec := record{
+			"created_at": s.timestamp(),
+			"deleted_at": nil,
+			"service_id": svc.rec.str("id"),
+			"updated_at": s.timestamp(),
+			"version":    v.number,
+		}
+		if versionedKinds[kind] {
+			rec["id"] = newID()
+		}
+		if kind == "director" {
+			rec["backends"] = []any{}
+		}
+		applyForm(rec, r.PostForm)
+		if kind == "snippet" {
+			s.storeDynamicSnippet(svc, rec)
+		}
+		v.config[kind][name] = rec
+		writeJSON(w, http.StatusOK, rec)
+	}
+}
+
+// lookupVersioned returns the resource of the given kind identified by key,
+// and its name. Resource links and rate limiters are identified by their ID
+// rather than their name.
+func lookupVersioned(v *version, kind, key string) (string, record, bool) {
+	if kind != "resource" && kind != "rate-limiters" {
+		rec, ok := v.config[kind][key]
+		return key, rec, ok
+	}
+	for name, rec := range v.config[kind] {
+		if rec.str("id") == key {
+			return name, rec, true
+		}
+	}
+	return "", nil, false
+}
+
+func (s *Server) getVersioned(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, v := s.lookupVersion(w, r)
+		if v == nil {
+			return
+		}
+		_, rec, ok := lookupVersioned(v, kind, r.PathValue("name"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Record not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, rec)
+	}
+}
+
+func (s *Server) updateVersioned(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		svc, v := s.lookupEditableVersion(w, r)
+		if v == nil {
+			return
+		}
+		name, rec, ok := lookupVersioned(v, kind, r.PathValue("name"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Record not found")
+			return
+		}
+		if err := parseFields(r); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if newName := r.PostForm.Get("name"); newName != "" && newName != name {
+			if _, ok := v.config[kind][newName]; ok {
+				writeError(w, http.StatusConflict, "Duplicate record")
+				return
+			}
+			delete(v.config[kind], name)
+			v.config[kind][newName] = rec
+		}
+		applyForm(rec, r.PostForm)
+		if kind == "snippet" {
+			s.storeDynamicSnippet(svc, rec)
+		}
+		rec["updated_at"] = s.timestamp()
+		writeJSON(w, http.StatusOK, rec)
+	}
+}
+
+func (s *Server) deleteVersioned(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, v := s.lookupEditableVersion(w, r)
+		if v == nil {
+			return
+		}
+		name, _, ok := lookupVersioned(v, kind, r.PathValue("name"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "Record not found")
+			return
+		}
+		delete(v.config[kind], name)
+		writeStatusOK(w)
+	}
+}
+
+// storeDynamicSnippet moves the content of rec, if it is a dynamic snippet,
+// to the content shared by the versions of svc.
+func (s *Server) storeDynamicSnippet(svc *service, rec record) {
+	if fmt.Sprint(rec["dynamic"]) != "1" {
+		return
+	}
+	id := rec.str("id")
+	dyn, ok := svc.dynamicSnippets[id]
+	if !ok {
+		dyn = record{
+			"content":    "",
+			"created_at": s.timestamp(),
+			"service_id": svc.rec.str("id"),
+			"snippet_id": id,
+		}
+		svc.dynamicSnippets[id] = dyn
+	}
+	if content, ok := rec["content"]; ok && content != nil {
+		dyn["content"] = content
+		dyn["updated_at"] = s.timestamp()
+	}
+	rec["content"] = nil
+}
+
+func (s *Server) getDynamicSnippet(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	dyn, ok := svc.dynamicSnippets[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, dyn)
+}
+
+func (s *Server) updateDynamicSnippet(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	dyn, ok := svc.dynamicSnippets[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if r.PostForm.Has("content") {
+		dyn["content"] = r.PostForm.Get("content")
+	}
+	dyn["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, dyn)
+}
+
+// lookupDirectorBackend returns the director and backend named by the
+// request path, along with the backend's position in the director, or
+// writes an error and returns nil. The position is -1 if the backend is not
+// in the director.
+func (s *Server) lookupDirectorBackend(w http.ResponseWriter, r *http.Request, v *version) (record, int) {
+	director, ok := v.config["director"][r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return nil, 0
+	}
+	name := r.PathValue("backend")
+	if _, ok := v.config["backend"][name]; !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return nil, 0
+	}
+	backends, _ := director["backends"].([]any)
+	return director, slices.Index(backends, any(name))
+}
+
+// directorBackendJSON renders the membership of a backend in a director.
+func directorBackendJSON(director record, backend string) record {
+	return record{
+		"backend_name":  backend,
+		"created_at":    director["created_at"],
+		"deleted_at":    nil,
+		"director_name": director["name"],
+		"service_id":    director["service_id"],
+		"updated_at":    director["updated_at"],
+		"version":       director["version"],
+	}
+}
+
+func (s *Server) createDirectorBackend(w http.ResponseWriter, r *http.Request) {
+	_, v := s.lookupEditableVersion(w, r)
+	if v == nil {
+		return
+	}
+	director, i := s.lookupDirectorBackend(w, r, v)
+	if director == nil {
+		return
+	}
+	if i >= 0 {
+		writeError(w, http.StatusConflict, "Duplicate record")
+		return
+	}
+	backends, _ := director["backends"].([]any)
+	director["backends"] = append(slices.Clone(backends), r.PathValue("backend"))
+	director["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, directorBackendJSON(director, r.PathValue("backend")))
+}
+
+func (s *Server) getDirectorBackend(w http.ResponseWriter, r *http.Request) {
+	_, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	director, i := s.lookupDirectorBackend(w, r, v)
+	if director == nil {
+		return
+	}
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, directorBackendJSON(director, r.PathValue("backend")))
+}
+
+func (s *Server) deleteDirectorBackend(w http.ResponseWriter, r *http.Request) {
+	_, v := s.lookupEditableVersion(w, r)
+	if v == nil {
+		return
+	}
+	director, i := s.lookupDirectorBackend(w, r, v)
+	if director == nil {
+		return
+	}
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	backends, _ := director["backends"].([]any)
+	director["backends"] = slices.Delete(slices.Clone(backends), i, i+1)
+	director["updated_at"] = s.timestamp()
+	writeStatusOK(w)
+}
+
+func (s *Server) purgeAll(w http.ResponseWriter, r *http.Request) {
+	if svc := s.lookupService(w, r); svc != nil {
+		s.purges = append(s.purges, Purge{ServiceID: svc.rec.str("id"), All: true})
+		writeStatusOK(w)
+	}
+}
+
+func (s *Server) purgeKeys(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	keys := strings.Fields(r.Header.Get("Surrogate-Key"))
+	if len(keys) == 0 {
+		writeError(w, http.StatusBadRequest, "Surrogate-Key header is required")
+		return
+	}
+	s.purges = append(s.purges, Purge{
+		ServiceID: svc.rec.str("id"),
+		Keys:      keys,
+		Soft:      r.Header.Get("Fastly-Soft-Purge") == "1",
+	})
+	ids := make(map[string]string, len(keys))
+	for _, k := range keys {
+		ids[k] = newID()
+	}
+	writeJSON(w, http.StatusOK, ids)
+}
+
+func (s *Server) purgeKey(w http.ResponseWriter, r *http.Request) {
+	svc := s.lookupService(w, r)
+	if svc == nil {
+		return
+	}
+	s.purges = append(s.purges, Purge{
+		ServiceID: svc.rec.str("id"),
+		Keys:      []string{r.PathValue("key")},
+		Soft:      r.Header.Get("Fastly-Soft-Purge") == "1",
+	})
+	writeJSON(w, http.StatusOK, record{"status": "ok", "id": newID()})
+}
+
+func (s *Server) purgeURL(w http.ResponseWriter, r *http.Request) {
+	s.purges = append(s.purges, Purge{
+		URL:  strings.TrimPrefix(r.URL.Path, "/purge/"),
+		Soft: r.Header.Get("Fastly-Soft-Purge") == "1",
+	})
+	writeJSON(w, http.StatusOK, record{"status": "ok", "id": newID()})
+}
+
+func (s *Server) setMainVCL(w http.ResponseWriter, r *http.Request) {
+	_, v := s.lookupEditableVersion(w, r)
+	if v == nil {
+		return
+	}
+	rec, ok := v.config["vcl"][r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	for _, other := range v.config["vcl"] {
+		other["main"] = false
+	}
+	rec["main"] = true
+	rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, rec)
+}
+
+// settingsJSON renders the settings of v as returned by the settings
+// endpoints.
+func (v *version) settingsJSON(serviceID string) record {
+	rec := v.settings.clone()
+	rec["service_id"] = serviceID
+	rec["version"] = v.number
+	return rec
+}
+
+func (s *Server) getSettings(w http.ResponseWriter, r *http.Request) {
+	if svc, v := s.lookupVersion(w, r); v != nil {
+		writeJSON(w, http.StatusOK, v.settingsJSON(svc.rec.str("id")))
+	}
+}
+
+func (s *Server) updateSettings(w http.ResponseWriter, r *http.Request) {
+	svc, v := s.lookupEditableVersion(w, r)
+	if v == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// Other fields, such as the ServiceID sent by UpdateSettings, are
+	// ignored.
+	maps.DeleteFunc(r.PostForm, func(k string, _ []string) bool {
+		_, ok := v.settings[k]
+		return !ok
+	})
+	applyForm(v.settings, r.PostForm)
+	writeJSON(w, http.StatusOK, v.settingsJSON(svc.rec.str("id")))
+}
+
+// lookupRateLimiter returns the rate limiter with the ID named by the
+// request path, the version holding it and its name, or writes a 404 and
+// returns a nil record.
+func (s *Server) lookupRateLimiter(w http.ResponseWriter, r *http.Request) (*version, string, record) {
+	for _, svc := range s.services {
+		for _, v := range svc.versions {
+			if name, rec, ok := lookupVersioned(v, "rate-limiters", r.PathValue("id")); ok {
+				return v, name, rec
+			}
+		}
+	}
+	writeError(w, http.StatusNotFound, "Record not found")
+	return nil, "", nil
+}
+
+func (s *Server) getRateLimiter(w http.ResponseWriter, r *http.Request) {
+	if _, _, rec := s.lookupRateLimiter(w, r); rec != nil {
+		writeJSON(w, http.StatusOK, rec)
+	}
+}
+
+func (s *Server) updateRateLimiter(w http.ResponseWriter, r *http.Request) {
+	v, name, rec := s.lookupRateLimiter(w, r)
+	if rec == nil {
+		return
+	}
+	if v.locked {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Version %d is locked", v.number))
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if newName := r.PostForm.Get("name"); newName != "" && newName != name {
+		delete(v.config["rate-limiters"], name)
+		v.config["rate-limiters"][newName] = rec
+	}
+	applyForm(rec, r.PostForm)
+	rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) deleteRateLimiter(w http.ResponseWriter, r *http.Request) {
+	v, name, rec := s.lookupRateLimiter(w, r)
+	if rec == nil {
+		return
+	}
+	if v.locked {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Version %d is locked", v.number))
+		return
+	}
+	delete(v.config["rate-limiters"], name)
+	writeStatusOK(w)
+}
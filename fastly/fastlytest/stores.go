@@ -0,0 +1,751 @@
+package fastlytest
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// kvStore is a KV store and its items.
+type kvStore struct {
+	rec   record
+	items map[string]*kvItem
+}
+
+// kvItem is the value of a KV store key.
+type kvItem struct {
+	value      []byte
+	metadata   string
+	generation uint64
+}
+
+// configStore is a config store and its items.
+type configStore struct {
+	rec   record
+	items map[string]record
+}
+
+// secretStore is a secret store and its secrets. Only the secret digests
+// are kept, as the API never returns the plaintext.
+type secretStore struct {
+	rec     record
+	secrets map[string]record
+}
+
+func (s *Server) registerStores() {
+	s.mux.HandleFunc("GET /resources/stores/kv", s.listKVStores)
+	s.mux.HandleFunc("POST /resources/stores/kv", s.createKVStore)
+	s.mux.HandleFunc("GET /resources/stores/kv/{store}", s.getKVStore)
+	s.mux.HandleFunc("DELETE /resources/stores/kv/{store}", s.deleteKVStore)
+	s.mux.HandleFunc("GET /resources/stores/kv/{store}/keys", s.listKVStoreKeys)
+	s.mux.HandleFunc("GET /resources/stores/kv/{store}/keys/{key}", s.getKVStoreKey)
+	s.mux.HandleFunc("PUT /resources/stores/kv/{store}/keys/{key}", s.insertKVStoreKey)
+	s.mux.HandleFunc("DELETE /resources/stores/kv/{store}/keys/{key}", s.deleteKVStoreKey)
+	s.mux.HandleFunc("PUT /resources/stores/kv/{store}/batch", s.batchKVStoreKeys)
+
+	s.mux.HandleFunc("GET /resources/stores/config", s.listConfigStores)
+	s.mux.HandleFunc("POST /resources/stores/config", s.createConfigStore)
+	s.mux.HandleFunc("GET /resources/stores/config/{store}", s.getConfigStore)
+	s.mux.HandleFunc("PUT /resources/stores/config/{store}", s.updateConfigStore)
+	s.mux.HandleFunc("DELETE /resources/stores/config/{store}", s.deleteConfigStore)
+	s.mux.HandleFunc("GET /resources/stores/config/{store}/info", s.getConfigStoreInfo)
+	s.mux.HandleFunc("GET /resources/stores/config/{store}/services", s.listConfigStoreServices)
+	s.mux.HandleFunc("GET /resources/stores/config/{store}/items", s.listConfigStoreItems)
+	s.mux.HandleFunc("PATCH /resources/stores/config/{store}/items", s.batchConfigStoreItems)
+	s.mux.HandleFunc("POST /resources/stores/config/{store}/item", s.createConfigStoreItem)
+	s.mux.HandleFunc("GET /resources/stores/config/{store}/item/{key}", s.getConfigStoreItem)
+	s.mux.HandleFunc("PATCH /resources/stores/config/{store}/item/{key}", s.updateConfigStoreItem)
+	s.mux.HandleFunc("PUT /resources/stores/config/{store}/item/{key}", s.updateConfigStoreItem)
+	s.mux.HandleFunc("DELETE /resources/stores/config/{store}/item/{key}", s.deleteConfigStoreItem)
+
+	s.mux.HandleFunc("GET /resources/stores/secret", s.listSecretStores)
+	s.mux.HandleFunc("POST /resources/stores/secret", s.createSecretStore)
+	s.mux.HandleFunc("GET /resources/stores/secret/{store}", s.getSecretStore)
+	s.mux.HandleFunc("DELETE /resources/stores/secret/{store}", s.deleteSecretStore)
+	s.mux.HandleFunc("GET /resources/stores/secret/{store}/secrets", s.listSecrets)
+	s.mux.HandleFunc("POST /resources/stores/secret/{store}/secrets", s.createSecret)
+	s.mux.HandleFunc("PUT /resources/stores/secret/{store}/secrets", s.createSecret)
+	s.mux.HandleFunc("PATCH /resources/stores/secret/{store}/secrets", s.createSecret)
+	s.mux.HandleFunc("GET /resources/stores/secret/{store}/secrets/{name}", s.getSecret)
+	s.mux.HandleFunc("DELETE /resources/stores/secret/{store}/secrets/{name}", s.deleteSecret)
+}
+
+// cursorPage returns the page of items selected by the cursor and limit
+// query parameters, and the cursor of the following page or "" if there is
+// none. Cursors are offsets into items.
+func cursorPage[T any](r *http.Request, items []T) ([]T, string) {
+	q := r.URL.Query()
+	start, err := strconv.Atoi(q.Get("cursor"))
+	if err != nil || start < 0 {
+		start = 0
+	}
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+	start = min(start, len(items))
+	end := min(start+limit, len(items))
+	if end == len(items) {
+		return items[start:end], ""
+	}
+	return items[start:end], strconv.Itoa(end)
+}
+
+// storesByName returns the records of stores, optionally filtered by the
+// name query parameter, ordered by name.
+func storesByName(r *http.Request, recs []record) []record {
+	if name := r.URL.Query().Get("name"); name != "" {
+		recs = slices.DeleteFunc(recs, func(rec record) bool {
+			return rec.str("name") != name
+		})
+	}
+	slices.SortFunc(recs, func(a, b record) int {
+		return strings.Compare(a.str("name"), b.str("name"))
+	})
+	return recs
+}
+
+// decodeName decodes a JSON request body with a required name field.
+func decodeName(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return "", false
+	}
+	if body.Name == "" {
+		writeError(w, http.StatusBadRequest, "Name can't be blank")
+		return "", false
+	}
+	return body.Name, true
+}
+
+func (s *Server) lookupKVStore(w http.ResponseWriter, r *http.Request) *kvStore {
+	st, ok := s.kvStores[r.PathValue("store")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Store not found")
+		return nil
+	}
+	return st
+}
+
+func (s *Server) listKVStores(w http.ResponseWriter, r *http.Request) {
+	recs := make([]record, 0, len(s.kvStores))
+	for _, st := range s.kvStores {
+		recs = append(recs, st.rec)
+	}
+	page, next := cursorPage(r, storesByName(r, recs))
+	writeJSON(w, http.StatusOK, record{
+		"data": page,
+		"meta": map[string]string{"next_cursor": next},
+	})
+}
+
+func (s *Server) createKVStore(w http.ResponseWriter, r *http.Request) {
+	name, ok := decodeName(w, r)
+	if !ok {
+		return
+	}
+	for _, st := range s.kvStores {
+		if st.rec.str("name") == name {
+			writeError(w, http.StatusConflict, "Store already exists")
+			return
+		}
+	}
+	st := &kvStore{
+		rec: record{
+			"created_at": s.timestamp(),
+			"id":         newID(),
+			"name":       name,
+			"updated_at": s.timestamp(),
+		},
+		items: map[string]*kvItem{},
+	}
+	s.kvStores[st.rec.str("id")] = st
+	writeJSON(w, http.StatusOK, st.rec)
+}
+
+func (s *Server) getKVStore(w http.ResponseWriter, r *http.Request) {
+	if st := s.lookupKVStore(w, r); st != nil {
+		writeJSON(w, http.StatusOK, st.rec)
+	}
+}
+
+func (s *Server) deleteKVStore(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupKVStore(w, r)
+	if st == nil {
+		return
+	}
+	delete(s.kvStores, st.rec.str("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listKVStoreKeys(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupKVStore(w, r)
+	if st == nil {
+		return
+	}
+	prefix := r.URL.Query().Get("prefix")
+	keys := slices.DeleteFunc(slices.Sorted(maps.Keys(st.items)), func(k string) bool {
+		return !strings.HasPrefix(k, prefix)
+	})
+	page, next := cursorPage(r, keys)
+	writeJSON(w, http.StatusOK, record{
+		"data": page,
+		"meta": map[string]string{"next_cursor": next, "prefix": prefix},
+	})
+}
+
+func (s *Server) getKVStoreKey(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupKVStore(w, r)
+	if st == nil {
+		return
+	}
+	item, ok := st.items[r.PathValue("key")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Key not found")
+		return
+	}
+	if item.metadata != "" {
+		w.Header().Set("Metadata", item.metadata)
+	}
+	w.Header().Set("Generation", strconv.FormatUint(item.generation, 10))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(item.value)
+}
+
+// putKVItem applies a write to key in st, following the semantics of the
+// insert endpoint's query parameters and headers. It returns the status code
+// and error detail of a failed write.
+func (s *Server) putKVItem(st *kvStore, key string, value []byte, metadata *string, ifGeneration string, add, appendValue, prependValue bool) (int, string) {
+	item, exists := st.items[key]
+	if add && exists {
+		return http.StatusPreconditionFailed, fmt.Sprintf("Key %q already exists", key)
+	}
+	if ifGeneration != "" {
+		want, err := strconv.ParseUint(ifGeneration, 10, 64)
+		if err != nil {
+			return http.StatusBadRequest, "Invalid if-generation-match"
+		}
+		if !exists || item.generation != want {
+			return http.StatusPreconditionFailed, "Generation does not match"
+		}
+	}
+
+	if !exists {
+		item = &kvItem{}
+		st.items[key] = item
+	}
+	switch {
+	case appendValue && exists:
+		item.value = append(slices.Clone(item.value), value...)
+	case prependValue && exists:
+		item.value = append(slices.Clone(value), item.value...)
+	default:
+		item.value = value
+	}
+	if metadata != nil {
+		item.metadata = *metadata
+	}
+	item.generation++
+	return 0, ""
+}
+
+func (s *Server) insertKVStoreKey(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupKVStore(w, r)
+	if st == nil {
+		return
+	}
+	value, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var metadata *string
+	if vs, ok := r.Header["Metadata"]; ok && len(vs) > 0 {
+		metadata = &vs[0]
+	}
+	q := r.URL.Query()
+	status, detail := s.putKVItem(st, r.PathValue("key"), value, metadata,
+		r.Header.Get("If-Generation-Match"),
+		q.Get("add") == "true", q.Get("append") == "true", q.Get("prepend") == "true")
+	if status != 0 {
+		writeError(w, status, detail)
+		return
+	}
+	writeJSON(w, http.StatusOK, record{})
+}
+
+func (s *Server) deleteKVStoreKey(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupKVStore(w, r)
+	if st == nil {
+		return
+	}
+	key := r.PathValue("key")
+	item, ok := st.items[key]
+	if !ok {
+		if r.URL.Query().Get("force") == "true" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeError(w, http.StatusNotFound, "Key not found")
+		return
+	}
+	if g := r.Header.Get("If-Generation-Match"); g != "" && g != strconv.FormatUint(item.generation, 10) {
+		writeError(w, http.StatusPreconditionFailed, "Generation does not match")
+		return
+	}
+	delete(st.items, key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) batchKVStoreKeys(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupKVStore(w, r)
+	if st == nil {
+		return
+	}
+
+	type batchError struct {
+		Index  int    `json:"index"`
+		Code   string `json:"code"`
+		Reason string `json:"reason"`
+	}
+	var errs []batchError
+
+	sc := bufio.NewScanner(r.Body)
+	sc.Buffer(nil, 32<<20)
+	for index := 0; sc.Scan(); index++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		var op struct {
+			Key               string  `json:"key"`
+			Value             string  `json:"value"`
+			Metadata          *string `json:"metadata"`
+			IfGenerationMatch *uint64 `json:"if_generation_match"`
+			Add               bool    `json:"add"`
+			Append            bool    `json:"append"`
+			Prepend           bool    `json:"prepend"`
+		}
+		if err := json.Unmarshal([]byte(line), &op); err != nil {
+			errs = append(errs, batchError{index, "invalid_json", err.Error()})
+			continue
+		}
+		if op.Key == "" {
+			errs = append(errs, batchError{index, "missing_key", "key is required"})
+			continue
+		}
+		value, err := base64.StdEncoding.DecodeString(op.Value)
+		if err != nil {
+			errs = append(errs, batchError{index, "invalid_value", "value must be base64 encoded"})
+			continue
+		}
+		var ifGeneration string
+		if op.IfGenerationMatch != nil {
+			ifGeneration = strconv.FormatUint(*op.IfGenerationMatch, 10)
+		}
+		if status, detail := s.putKVItem(st, op.Key, value, op.Metadata, ifGeneration, op.Add, op.Append, op.Prepend); status != 0 {
+			errs = append(errs, batchError{index, strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "_")), detail})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(errs) > 0 {
+		// The batch endpoint reports per-line failures in the older error
+		// format, which carries the index of each failed line.
+		writeJSON(w, http.StatusBadRequest, record{"errors": errs})
+		return
+	}
+	writeJSON(w, http.StatusOK, record{})
+}
+
+func (s *Server) lookupConfigStore(w http.ResponseWriter, r *http.Request) *configStore {
+	st, ok := s.configStores[r.PathValue("store")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Store not found")
+		return nil
+	}
+	return st
+}
+
+func (s *Server) listConfigStores(w http.ResponseWriter, r *http.Request) {
+	recs := make([]record, 0, len(s.configStores))
+	for _, st := range s.configStores {
+		recs = append(recs, st.rec)
+	}
+	writeJSON(w, http.StatusOK, storesByName(r, recs))
+}
+
+func (s *Server) createConfigStore(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	name := r.PostForm.Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "Name can't be blank")
+		return
+	}
+	for _, st := range s.configStores {
+		if st.rec.str("name") == name {
+			writeError(w, http.StatusConflict, "Store already exists")
+			return
+		}
+	}
+	st := &configStore{
+		rec: record{
+			"created_at": s.timestamp(),
+			"deleted_at": nil,
+			"id":         newID(),
+			"name":       name,
+			"updated_at": s.timestamp(),
+		},
+		items: map[string]record{},
+	}
+	s.configStores[st.rec.str("id")] = st
+	writeJSON(w, http.StatusOK, st.rec)
+}
+
+func (s *Server) getConfigStore(w http.ResponseWriter, r *http.Request) {
+	if st := s.lookupConfigStore(w, r); st != nil {
+		writeJSON(w, http.StatusOK, st.rec)
+	}
+}
+
+func (s *Server) updateConfigStore(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if name := r.PostForm.Get("name"); name != "" {
+		st.rec["name"] = name
+	}
+	st.rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, st.rec)
+}
+
+func (s *Server) deleteConfigStore(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	delete(s.configStores, st.rec.str("id"))
+	writeStatusOK(w)
+}
+
+func (s *Server) getConfigStoreInfo(w http.ResponseWriter, r *http.Request) {
+	if st := s.lookupConfigStore(w, r); st != nil {
+		writeJSON(w, http.StatusOK, record{"item_count": len(st.items)})
+	}
+}
+
+func (s *Server) listConfigStoreServices(w http.ResponseWriter, r *http.Request) {
+	// Resource links between services and stores are not modelled.
+	if st := s.lookupConfigStore(w, r); st != nil {
+		writeJSON(w, http.StatusOK, []record{})
+	}
+}
+
+func (s *Server) newConfigStoreItem(st *configStore, key, value string) record {
+	return record{
+		"created_at": s.timestamp(),
+		"deleted_at": nil,
+		"item_key":   key,
+		"item_value": value,
+		"store_id":   st.rec.str("id"),
+		"updated_at": s.timestamp(),
+	}
+}
+
+func (s *Server) listConfigStoreItems(w http.ResponseWriter, r *http.Request) {
+	if st := s.lookupConfigStore(w, r); st != nil {
+		writeJSON(w, http.StatusOK, sortedRecords(st.items))
+	}
+}
+
+func (s *Server) createConfigStoreItem(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	key := r.PostForm.Get("item_key")
+	if key == "" {
+		writeError(w, http.StatusBadRequest, "Item key can't be blank")
+		return
+	}
+	if _, ok := st.items[key]; ok {
+		writeError(w, http.StatusConflict, "Duplicate record")
+		return
+	}
+	rec := s.newConfigStoreItem(st, key, r.PostForm.Get("item_value"))
+	st.items[key] = rec
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) getConfigStoreItem(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	rec, ok := st.items[r.PathValue("key")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, rec)
+}
+
+// updateConfigStoreItem handles both PATCH, which requires the item to
+// exist, and PUT, which creates it if needed.
+func (s *Server) updateConfigStoreItem(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	key := r.PathValue("key")
+	rec, ok := st.items[key]
+	if !ok {
+		if r.Method != http.MethodPut {
+			writeError(w, http.StatusNotFound, "Record not found")
+			return
+		}
+		rec = s.newConfigStoreItem(st, key, "")
+		st.items[key] = rec
+	}
+	rec["item_value"] = r.PostForm.Get("item_value")
+	rec["updated_at"] = s.timestamp()
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) deleteConfigStoreItem(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	key := r.PathValue("key")
+	if _, ok := st.items[key]; !ok {
+		writeError(w, http.StatusNotFound, "Record not found")
+		return
+	}
+	delete(st.items, key)
+	writeStatusOK(w)
+}
+
+func (s *Server) batchConfigStoreItems(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupConfigStore(w, r)
+	if st == nil {
+		return
+	}
+	var body struct {
+		Items []struct {
+			Op    string `json:"op"`
+			Key   string `json:"item_key"`
+			Value string `json:"item_value"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for _, item := range body.Items {
+		_, exists := st.items[item.Key]
+		switch item.Op {
+		case "create":
+			if exists {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Item %q already exists", item.Key))
+				return
+			}
+		case "update", "delete":
+			if !exists {
+				writeError(w, http.StatusNotFound, fmt.Sprintf("Item %q not found", item.Key))
+				return
+			}
+		case "upsert":
+		default:
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid operation %q", item.Op))
+			return
+		}
+	}
+	for _, item := range body.Items {
+		if item.Op == "delete" {
+			delete(st.items, item.Key)
+			continue
+		}
+		rec, ok := st.items[item.Key]
+		if !ok {
+			rec = s.newConfigStoreItem(st, item.Key, "")
+			st.items[item.Key] = rec
+		}
+		rec["item_value"] = item.Value
+		rec["updated_at"] = s.timestamp()
+	}
+	writeStatusOK(w)
+}
+
+func (s *Server) lookupSecretStore(w http.ResponseWriter, r *http.Request) *secretStore {
+	st, ok := s.secretStores[r.PathValue("store")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Store not found")
+		return nil
+	}
+	return st
+}
+
+func (s *Server) listSecretStores(w http.ResponseWriter, r *http.Request) {
+	recs := make([]record, 0, len(s.secretStores))
+	for _, st := range s.secretStores {
+		recs = append(recs, st.rec)
+	}
+	page, next := cursorPage(r, storesByName(r, recs))
+	writeJSON(w, http.StatusOK, record{
+		"data": page,
+		"meta": record{"limit": len(page), "next_cursor": next},
+	})
+}
+
+func (s *Server) createSecretStore(w http.ResponseWriter, r *http.Request) {
+	name, ok := decodeName(w, r)
+	if !ok {
+		return
+	}
+	for _, st := range s.secretStores {
+		if st.rec.str("name") == name {
+			writeError(w, http.StatusConflict, "Store already exists")
+			return
+		}
+	}
+	st := &secretStore{
+		rec: record{
+			"created_at": s.timestamp(),
+			"id":         newID(),
+			"name":       name,
+		},
+		secrets: map[string]record{},
+	}
+	s.secretStores[st.rec.str("id")] = st
+	writeJSON(w, http.StatusOK, st.rec)
+}
+
+func (s *Server) getSecretStore(w http.ResponseWriter, r *http.Request) {
+	if st := s.lookupSecretStore(w, r); st != nil {
+		writeJSON(w, http.StatusOK, st.rec)
+	}
+}
+
+func (s *Server) deleteSecretStore(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupSecretStore(w, r)
+	if st == nil {
+		return
+	}
+	delete(s.secretStores, st.rec.str("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listSecrets(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupSecretStore(w, r)
+	if st == nil {
+		return
+	}
+	page, next := cursorPage(r, sortedRecords(st.secrets))
+	writeJSON(w, http.StatusOK, record{
+		"data": page,
+		"meta": record{"limit": len(page), "next_cursor": next},
+	})
+}
+
+// createSecret handles POST, which fails if the secret exists, PATCH, which
+// fails if it does not, and PUT, which creates or recreates it.
+func (s *Server) createSecret(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupSecretStore(w, r)
+	if st == nil {
+		return
+	}
+	var body struct {
+		Name   string `json:"name"`
+		Secret []byte `json:"secret"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if body.Name == "" || len(body.Secret) == 0 {
+		writeError(w, http.StatusBadRequest, "Name and secret are required")
+		return
+	}
+
+	_, exists := st.secrets[body.Name]
+	switch {
+	case r.Method == http.MethodPost && exists:
+		writeError(w, http.StatusConflict, fmt.Sprintf("Secret %q already exists", body.Name))
+		return
+	case r.Method == http.MethodPatch && !exists:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Secret %q not found", body.Name))
+		return
+	}
+
+	digest := sha256.Sum256(body.Secret)
+	rec := record{
+		"created_at": s.timestamp(),
+		"digest":     digest[:],
+		"name":       body.Name,
+	}
+	st.secrets[body.Name] = rec
+	out := rec.clone()
+	if exists {
+		out["recreated"] = true
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) getSecret(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupSecretStore(w, r)
+	if st == nil {
+		return
+	}
+	rec, ok := st.secrets[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Secret not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, rec)
+}
+
+func (s *Server) deleteSecret(w http.ResponseWriter, r *http.Request) {
+	st := s.lookupSecretStore(w, r)
+	if st == nil {
+		return
+	}
+	name := r.PathValue("name")
+	if _, ok := st.secrets[name]; !ok {
+		writeError(w, http.StatusNotFound, "Secret not found")
+		return
+	}
+	delete(st.secrets, name)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,416 @@
+package fastly
+
+import (
+	"cmp"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// HARRecorder writes the requests sent by a client, and the responses
+// received, to an HTTP Archive (HAR 1.2) log. Register one with
+// WithHARRecorder or by setting Client.HAR.
+//
+// Each attempt is recorded as a separate entry, so retried requests appear
+// once per attempt. An entry is recorded once the response body has been
+// read to the end or closed, so its timings include the transfer of the body.
+// Headers, bodies and query parameters are masked by the client's Redactor,
+// as in debug logs. Request bodies are only recorded if they can be
+// replayed, and bodies of either kind larger than 64KiB are omitted, so
+// streamed uploads such as KV Store values are never buffered.
+//
+// Entries are written as they are recorded; the log is only complete once
+// Close is called. This structure is safe to use from concurrent goroutines.
+type HARRecorder struct {
+	mu      sync.Mutex
+	w       io.Writer
+	closer  io.Closer
+	entries int
+	closed  bool
+	err     error
+}
+
+// NewHARRecorder returns a HARRecorder writing to w.
+func NewHARRecorder(w io.Writer) *HARRecorder {
+	return &HARRecorder{w: w}
+}
+
+// CreateHARFile creates or truncates the named file and returns a
+// HARRecorder writing to it. Close closes the file.
+func CreateHARFile(name string) (*HARRecorder, error) {
+	f, err := os.Create(filepath.Clean(name))
+	if err != nil {
+		return nil, err
+	}
+	return &HARRecorder{w: f, closer: f}, nil
+}
+
+// Close completes the log and closes the underlying file, if the recorder
+// was created with CreateHARFile. It returns the first error encountered
+// while writing the log. Requests completed after Close are not recorded.
+func (r *HARRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return r.err
+	}
+	r.closed = true
+
+	if r.entries == 0 {
+		r.writeHeader()
+	}
+	r.write([]byte("]}}\n"))
+	if r.closer != nil {
+		if err := r.closer.Close(); err != nil && r.err == nil {
+			r.err = err
+		}
+	}
+	return r.err
+}
+
+// writeHeader writes the start of the log, up to the entries array.
+func (r *HARRecorder) writeHeader() {
+	header, err := json.Marshal(harCreator{Name: "go-fastly", Version: ProjectVersion})
+	if err != nil {
+		r.err = err
+		return
+	}
+	r.write([]byte(`{"log":{"version":"1.2","creator":`))
+	r.write(header)
+	r.write([]byte(`,"entries":[`))
+}
+
+func (r *HARRecorder) write(b []byte) {
+	if r.err != nil {
+		return
+	}
+	_, r.err = r.w.Write(b)
+}
+
+func (r *HARRecorder) add(e *harEntry) {
+	b, err := json.Marshal(e)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	if err != nil {
+		if r.err == nil {
+			r.err = err
+		}
+		return
+	}
+	if r.entries == 0 {
+		r.writeHeader()
+	} else {
+		r.write([]byte(","))
+	}
+	r.write(b)
+	r.entries++
+}
+
+// harTrace records the timings of a single request attempt.
+type harTrace struct {
+	mu                       sync.Mutex
+	dnsStart, dnsDone        time.Time
+	connectStart, connectEnd time.Time
+	tlsStart, tlsDone        time.Time
+	gotConn                  time.Time
+	wroteRequest             time.Time
+	firstByte                time.Time
+}
+
+// trace returns req with a client trace recording into t.
+func (t *harTrace) trace(req *http.Request) *http.Request {
+	set := func(field *time.Time) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if field.IsZero() {
+			*field = time.Now()
+		}
+	}
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { set(&t.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { set(&t.dnsDone) },
+		ConnectStart:         func(string, string) { set(&t.connectStart) },
+		ConnectDone:          func(string, string, error) { set(&t.connectEnd) },
+		TLSHandshakeStart:    func() { set(&t.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { set(&t.tlsDone) },
+		GotConn:              func(httptrace.GotConnInfo) { set(&t.gotConn) },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { set(&t.wroteRequest) },
+		GotFirstResponseByte: func() { set(&t.firstByte) },
+	}))
+}
+
+// timings returns the HAR timings of an attempt started at start and
+// finished at end. Phases the trace did not observe, for example because
+// the connection was reused or the transport does not support tracing, are
+// reported as -1 or folded into the wait time.
+func (t *harTrace) timings(start, end time.Time) harTimings {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ms := func(from, to time.Time) float64 {
+		if from.IsZero() || to.IsZero() {
+			return -1
+		}
+		return float64(to.Sub(from).Microseconds()) / 1000
+	}
+	timings := harTimings{
+		Blocked: -1,
+		DNS:     ms(t.dnsStart, t.dnsDone),
+		Connect: ms(t.connectStart, t.gotConn),
+		SSL:     ms(t.tlsStart, t.tlsDone),
+	}
+	if t.gotConn.IsZero() || t.wroteRequest.IsZero() || t.firstByte.IsZero() {
+		timings.Wait = ms(start, end)
+		return timings
+	}
+	if connStart := firstTime(t.dnsStart, t.connectStart, t.gotConn); connStart.After(start) {
+		timings.Blocked = ms(start, connStart)
+	}
+	timings.Send = ms(t.gotConn, t.wroteRequest)
+	timings.Wait = ms(t.wroteRequest, t.firstByte)
+	timings.Receive = ms(t.firstByte, end)
+	return timings
+}
+
+// firstTime returns the first non-zero time of ts.
+func firstTime(ts ...time.Time) time.Time {
+	for _, t := range ts {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// recordHAR records an attempt to send req in the client's HAR log. resp
+// and err are the results of sending req, before checkResp consumes the
+// body of an unsuccessful response. The entry is completed once the body of
+// the returned response, which wraps resp, is read to the end or closed.
+func (c *Client) recordHAR(req *http.Request, resp *http.Response, err error, trace *harTrace, start time.Time) *http.Response {
+	r := c.redactor()
+	contentType := req.Header.Get("Content-Type")
+	u := *req.URL
+	query := redactQuery(r, req.URL.Query())
+	if u.RawQuery != "" {
+		u.RawQuery = query.Encode()
+	}
+
+	e := &harEntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         u.String(),
+			HTTPVersion: cmp.Or(req.Proto, "HTTP/1.1"),
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(r, req.Header),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    max(req.ContentLength, 0),
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			Content:     harContent{MimeType: "x-unknown"},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Cache: struct{}{},
+	}
+	for _, k := range slices.Sorted(maps.Keys(query)) {
+		for _, v := range query[k] {
+			e.Request.QueryString = append(e.Request.QueryString, harNameValue{Name: k, Value: v})
+		}
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		post := &harPostData{MimeType: contentType}
+		if body, ok := peekRequestBody(req); ok {
+			post.Text = string(r.RedactBody(contentType, body))
+		} else if req.GetBody == nil {
+			post.Text = "[streamed body omitted]"
+		} else {
+			post.Text = string(omittedBody(int(req.ContentLength)))
+		}
+		e.Request.PostData = post
+	}
+
+	if resp != nil {
+		e.Response.Status = resp.StatusCode
+		e.Response.StatusText = http.StatusText(resp.StatusCode)
+		e.Response.HTTPVersion = resp.Proto
+		e.Response.Headers = harHeaders(r, resp.Header)
+		e.Response.Content.Size = max(resp.ContentLength, 0)
+		if ct := resp.Header.Get("Content-Type"); ct != "" {
+			e.Response.Content.MimeType = ct
+		}
+		if body, ok := peekResponseBody(resp); ok {
+			e.Response.Content.Size = int64(len(body))
+			e.Response.Content.Text = string(r.RedactBody(resp.Header.Get("Content-Type"), body))
+			e.Response.BodySize = int64(len(body))
+		} else {
+			e.Response.Content.Text = "[body omitted]"
+		}
+		e.Response.RedirectURL = resp.Header.Get("Location")
+	}
+	e.Response.HTTPVersion = cmp.Or(e.Response.HTTPVersion, "HTTP/1.1")
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	finish := func() {
+		end := time.Now()
+		e.Timings = trace.timings(start, end)
+		e.Time = float64(end.Sub(start).Microseconds()) / 1000
+		c.HAR.add(e)
+	}
+	if resp == nil || resp.Body == nil || resp.Body == http.NoBody {
+		finish()
+		return resp
+	}
+	resp.Body = &harBody{ReadCloser: resp.Body, finish: finish}
+	return resp
+}
+
+// harBody wraps a response body to complete its HAR entry when the body is
+// read to the end or closed, whichever happens first.
+type harBody struct {
+	io.ReadCloser
+	once   sync.Once
+	finish func()
+}
+
+func (b *harBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.once.Do(b.finish)
+	}
+	return n, err
+}
+
+func (b *harBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.finish)
+	return err
+}
+
+// redactQuery returns query with the values of sensitive parameters masked
+// by r, which treats them as form fields.
+func redactQuery(r Redactor, query url.Values) url.Values {
+	if len(query) == 0 {
+		return query
+	}
+	redacted, err := url.ParseQuery(string(r.RedactBody("application/x-www-form-urlencoded", []byte(query.Encode()))))
+	if err != nil {
+		return url.Values{}
+	}
+	return redacted
+}
+
+// peekRequestBody returns a copy of the request body if it can be replayed
+// and is no larger than maxLoggedBodySize. Unlike requestBody, it never
+// reads more than the limit.
+func peekRequestBody(req *http.Request) ([]byte, bool) {
+	if req.GetBody == nil || req.ContentLength > maxLoggedBodySize {
+		return nil, false
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(io.LimitReader(rc, maxLoggedBodySize+1))
+	if err != nil || len(b) > maxLoggedBodySize {
+		return nil, false
+	}
+	return b, true
+}
+
+func harHeaders(r Redactor, h http.Header) []harNameValue {
+	headers := []harNameValue{}
+	for _, k := range slices.Sorted(maps.Keys(h)) {
+		for _, v := range h[k] {
+			headers = append(headers, harNameValue{Name: k, Value: r.RedactHeader(k, v)})
+		}
+	}
+	return headers
+}
+
+// The types below follow the HAR 1.2 specification. Errors are reported in
+// the custom _error field.
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int64          `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int64          `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
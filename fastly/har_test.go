@@ -0,0 +1,167 @@
+package fastly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type harLogFile struct {
+	Log struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+func TestWithHARRecorder(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", JSONMimeType)
+		if r.URL.Path == "/slow" {
+			// The end of a body too large to be recorded arrives well
+			// after its start.
+			_, _ = w.Write([]byte(`{"status":"` + strings.Repeat("x", maxLoggedBodySize)))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+			_, _ = w.Write([]byte(`"}`))
+			return
+		}
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"msg":"Record not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok","secret":"shh"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	name := filepath.Join(t.TempDir(), "traffic.har")
+	har, err := CreateHARFile(name)
+	require.NoError(t, err)
+
+	c, err := New(WithEnvironment(false), WithAPIKey("secret-api-key"), WithEndpoint(srv.URL), WithHARRecorder(har))
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	resp, err := c.PostForm(ctx, "/service/abc/version/1/logging/ftp", &struct {
+		Name     string `url:"name"`
+		Password string `url:"password"`
+	}{"ftp", "hunter2"}, CreateRequestOptions())
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	err = c.InsertKVStoreKey(ctx, &InsertKVStoreKeyInput{
+		StoreID: "store",
+		Key:     "big",
+		Body:    bytes.NewBufferString(strings.Repeat("x", 1024)),
+	})
+	require.NoError(t, err)
+
+	_, err = c.Get(ctx, "/missing", CreateRequestOptions())
+	var httpErr *HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.True(t, httpErr.IsNotFound())
+
+	ro := CreateRequestOptions()
+	ro.Params = map[string]string{"access_token": "query-secret", "page": "2"}
+	resp, err = c.Get(ctx, "/slow", ro)
+	require.NoError(t, err)
+	_, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	require.NoError(t, har.Close())
+	require.NoError(t, har.Close())
+
+	b, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "secret-api-key")
+	require.NotContains(t, string(b), "hunter2")
+	require.NotContains(t, string(b), "shh")
+	require.NotContains(t, string(b), "query-secret")
+
+	var log harLogFile
+	require.NoError(t, json.Unmarshal(b, &log))
+	require.Equal(t, "1.2", log.Log.Version)
+	require.Equal(t, "go-fastly", log.Log.Creator.Name)
+	require.Len(t, log.Log.Entries, 4)
+
+	form := log.Log.Entries[0]
+	require.Equal(t, http.MethodPost, form.Request.Method)
+	require.Equal(t, srv.URL+"/service/abc/version/1/logging/ftp", form.Request.URL)
+	require.Contains(t, form.Request.Headers, harNameValue{Name: APIKeyHeader, Value: RedactedValue})
+	require.Equal(t, "name=ftp&password=%5BREDACTED%5D", form.Request.PostData.Text)
+	require.Equal(t, http.StatusOK, form.Response.Status)
+	require.JSONEq(t, `{"status":"ok","secret":"[REDACTED]"}`, form.Response.Content.Text)
+	require.Positive(t, form.Time)
+	require.GreaterOrEqual(t, form.Timings.Send, 0.0)
+	require.GreaterOrEqual(t, form.Timings.Wait, 0.0)
+	require.GreaterOrEqual(t, form.Timings.Receive, 0.0)
+
+	kv := log.Log.Entries[1]
+	require.Equal(t, http.MethodPut, kv.Request.Method)
+	require.Equal(t, "[streamed body omitted]", kv.Request.PostData.Text)
+	require.EqualValues(t, 1024, kv.Request.BodySize)
+
+	missing := log.Log.Entries[2]
+	require.Equal(t, http.StatusNotFound, missing.Response.Status)
+	require.Equal(t, "Not Found", missing.Response.StatusText)
+	require.JSONEq(t, `{"msg":"Record not found"}`, missing.Response.Content.Text)
+
+	// The entry is completed once the body has been read, and sensitive
+	// query parameters are masked.
+	slow := log.Log.Entries[3]
+	require.Equal(t, "[body omitted]", slow.Response.Content.Text)
+	require.Equal(t, srv.URL+"/slow?access_token=%5BREDACTED%5D&page=2", slow.Request.URL)
+	require.Equal(t, []harNameValue{{Name: "access_token", Value: RedactedValue}, {Name: "page", Value: "2"}}, slow.Request.QueryString)
+	require.GreaterOrEqual(t, slow.Timings.Receive, 40.0)
+	require.GreaterOrEqual(t, slow.Time, 40.0)
+}
+
+func TestHARRecorder_Empty(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	har := NewHARRecorder(&buf)
+	require.NoError(t, har.Close())
+
+	var log harLogFile
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Equal(t, "1.2", log.Log.Version)
+	require.Empty(t, log.Log.Entries)
+}
+
+func TestHARRecorder_TransportError(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	har := NewHARRecorder(&buf)
+	c := newRetryTestClient(t, roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	}), nil)
+	c.HAR = har
+
+	_, err := c.Get(context.TODO(), "/service", CreateRequestOptions())
+	require.Error(t, err)
+	require.NoError(t, har.Close())
+
+	var log harLogFile
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	require.Len(t, log.Log.Entries, 1)
+	require.Equal(t, 0, log.Log.Entries[0].Response.Status)
+	require.Contains(t, log.Log.Entries[0].Error, io.ErrUnexpectedEOF.Error())
+	require.Equal(t, -1.0, log.Log.Entries[0].Timings.Connect)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/url"
+	"reflect"
 )
 
 // MultiConstraint is a generic constraint for ToPointer/ToValue.
@@ -72,6 +73,22 @@ func (n Nullable[T]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(*n.value)
 }
 
+// nullable is implemented by *Nullable[T] so that values can be set by
+// reflection.
+type nullable interface {
+	valueType() reflect.Type
+	setValue(v reflect.Value)
+}
+
+func (*Nullable[T]) valueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (n *Nullable[T]) setValue(v reflect.Value) {
+	t := v.Convert(reflect.TypeFor[T]()).Interface().(T)
+	n.value = &t
+}
+
 // ToSafeURL produces a safe (no path traversal, no unsafe characters) URL
 // from the path components passed in.
 //
@@ -0,0 +1,331 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Instrumentation receives notifications for every API operation made
+// through [Client.Request]. It can be used to adapt the client to tracing
+// and metrics systems without this module depending on them.
+type Instrumentation interface {
+	// StartOperation is called before the request is built. The returned
+	// context is used for the request, so tracers can attach a span to it.
+	StartOperation(ctx context.Context, op OperationInfo) context.Context
+	// EndOperation is called once the operation has completed, including
+	// any retries. ctx is the context returned by StartOperation.
+	EndOperation(ctx context.Context, op OperationInfo, result OperationResult)
+}
+
+// OperationInfo identifies an API operation.
+type OperationInfo struct {
+	// Name is the name of the function that issued the request, e.g.
+	// "CreateBackend" for a *Client method or "computeacls.Update" for a
+	// subpackage function. Requests issued directly through the request
+	// primitives (Get, PostJSON, ...) by code outside this module are
+	// named after their verb and path template.
+	Name string
+	// Verb is the HTTP method of the request.
+	Verb string
+	// PathTemplate is the request path with its parameters replaced by
+	// placeholders (see [PathTemplate]).
+	PathTemplate string
+}
+
+// OperationResult describes the outcome of an API operation.
+type OperationResult struct {
+	// Status is the HTTP status code of the final response, or zero if no
+	// response was received.
+	Status int
+	// Err is the error returned to the caller, if any.
+	Err error
+	// Latency is the total time spent on the operation, including retries
+	// and any client-side throttling.
+	Latency time.Duration
+	// Retries is the number of retries performed after the first attempt.
+	Retries int
+	// RateLimitRemaining is the client's rate limit budget after the
+	// operation (see [Client.RateLimitRemaining]).
+	RateLimitRemaining int
+	// RateLimitReset is the client's rate limit reset time after the
+	// operation (see [Client.RateLimitReset]).
+	RateLimitReset time.Time
+}
+
+// modulePath is the import path prefix shared by every package in this
+// module, e.g. "github.com/fastly/go-fastly/v17/". It is derived from the
+// path of this package so that it follows major version bumps.
+var modulePath = path.Dir(reflect.TypeFor[Client]().PkgPath()) + "/"
+
+// requestPrimitives are the *Client methods that build and send requests on
+// behalf of API operations. They are skipped when naming an operation.
+var requestPrimitives = []string{
+	"Delete", "DeleteJSONAPI", "DeleteJSONAPIBulk",
+	"Get", "GetJSON", "Head",
+	"Patch", "PatchForm", "PatchJSON", "PatchJSONAPI",
+	"Post", "PostForm", "PostJSON", "PostJSONAPI", "PostJSONAPIBulk",
+	"Put", "PutForm", "PutFormFile", "PutFormFileFromReader", "PutJSON", "PutJSONAPI",
+	"Request", "RequestForm", "RequestFormFile", "RequestFormFileFromReader",
+	"RequestJSON", "RequestJSONAPI", "RequestJSONAPIBulk", "SimpleGet",
+}
+
+// operationName returns the name of the API operation that called into the
+// request primitives, derived from the call stack. It returns an empty
+// string if the request was issued by code outside this module (including
+// this module's own tests).
+func operationName() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		fn, ok := strings.CutPrefix(frame.Function, modulePath)
+		if !ok || strings.HasSuffix(frame.File, "_test.go") {
+			return ""
+		}
+		if name := operationFromFunc(fn); name != "" {
+			return name
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// operationFromFunc converts a function name relative to the module path
+// into an operation name, or returns an empty string if the function is
+// part of the request machinery.
+//
+//	fastly.(*Client).CreateBackend                    -> CreateBackend
+//	fastly/computeacls.Update                         -> computeacls.Update
+//	fastly.(*ListPaginator[...]).GetNext              -> ListPaginator.GetNext
+//	fastly.(*Client).Request                          -> ""
+func operationFromFunc(fn string) string {
+	if strings.HasPrefix(fn, "internal/") {
+		return ""
+	}
+
+	// Split "fastly/computeacls.Update" into the package path and the
+	// function, which may include a receiver.
+	slash := strings.LastIndexByte(fn, '/')
+	dot := strings.IndexByte(fn[slash+1:], '.')
+	if dot < 0 {
+		return ""
+	}
+	pkg := fn[slash+1 : slash+1+dot]
+	fn = fn[slash+1+dot+1:]
+
+	// Closures, e.g. "(*Client).Foo.func1", belong to their parent.
+	for {
+		i := strings.LastIndex(fn, ".func")
+		if i < 0 {
+			break
+		}
+		fn = fn[:i]
+	}
+
+	recv, method, hasRecv := strings.Cut(fn, ").")
+	if hasRecv {
+		recv = strings.TrimPrefix(strings.TrimPrefix(recv, "("), "*")
+		if i := strings.IndexByte(recv, '['); i >= 0 {
+			recv = recv[:i]
+		}
+	} else {
+		recv, method = "", fn
+	}
+	if i := strings.IndexByte(method, '['); i >= 0 {
+		method = method[:i]
+	}
+
+	if pkg == "fastly" {
+		switch recv {
+		case "Client":
+			if slices.Contains(requestPrimitives, method) || !isExported(method) {
+				return ""
+			}
+			return method
+		case "RTSClient":
+			return method
+		case "":
+			if !isExported(method) {
+				return ""
+			}
+			return method
+		default:
+			if !isExported(method) {
+				return ""
+			}
+			return recv + "." + method
+		}
+	}
+	if hasRecv {
+		return pkg + "." + recv + "." + method
+	}
+	return pkg + "." + method
+}
+
+func isExported(name string) bool {
+	return name != "" && name[0] >= 'A' && name[0] <= 'Z'
+}
+
+// operationInfo returns the OperationInfo describing info.
+func (info *RequestInfo) operationInfo() OperationInfo {
+	op := OperationInfo{
+		Name:         info.Operation,
+		Verb:         info.Verb,
+		PathTemplate: info.PathTemplate,
+	}
+	if op.Name == "" {
+		op.Name = info.Verb + " " + info.PathTemplate
+	}
+	return op
+}
+
+// instrument calls h and notifies the client's Instrumentation of the
+// outcome of the operation.
+func (c *Client) instrument(ctx context.Context, op OperationInfo, info *RequestInfo, h RequestHandler) (*http.Response, error) {
+	start := time.Now()
+	resp, err := h(ctx, info)
+
+	result := OperationResult{
+		Err:                err,
+		Latency:            time.Since(start),
+		Retries:            info.Retries,
+		RateLimitRemaining: c.RateLimitRemaining(),
+		RateLimitReset:     c.RateLimitReset(),
+	}
+	if resp != nil {
+		result.Status = resp.StatusCode
+	}
+	c.Instrumentation.EndOperation(ctx, op, result)
+
+	return resp, err
+}
+
+// DefaultLatencyBuckets are the upper bounds of the latency histogram
+// buckets used by [MetricsRecorder] when none are given.
+var DefaultLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Ensure MetricsRecorder implements Instrumentation.
+var _ Instrumentation = (*MetricsRecorder)(nil)
+
+// MetricsRecorder is an [Instrumentation] that keeps request counts and
+// latency histograms per operation in memory. It is useful in tests and as
+// a reference for writing adapters to real metrics systems.
+//
+// This structure is safe to use from concurrent goroutines.
+type MetricsRecorder struct {
+	buckets []time.Duration
+
+	mu  sync.Mutex
+	ops map[string]*OperationStats
+}
+
+// OperationStats holds the metrics recorded for a single operation.
+type OperationStats struct {
+	// Count is the number of times the operation completed.
+	Count int
+	// Errors is the number of times the operation returned an error.
+	Errors int
+	// Retries is the total number of retries across all calls.
+	Retries int
+	// Statuses counts the final HTTP status codes observed.
+	Statuses map[int]int
+	// TotalLatency is the sum of all observed latencies.
+	TotalLatency time.Duration
+	// Buckets are the upper bounds of the latency histogram buckets.
+	Buckets []time.Duration
+	// BucketCounts holds the number of observations for each bucket. It has
+	// one more element than Buckets, for observations above the last bound.
+	BucketCounts []int
+}
+
+// NewMetricsRecorder returns a MetricsRecorder using the given latency
+// histogram bucket upper bounds, or DefaultLatencyBuckets if none are given.
+func NewMetricsRecorder(buckets ...time.Duration) *MetricsRecorder {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &MetricsRecorder{
+		buckets: buckets,
+		ops:     make(map[string]*OperationStats),
+	}
+}
+
+// StartOperation implements the Instrumentation interface.
+func (m *MetricsRecorder) StartOperation(ctx context.Context, _ OperationInfo) context.Context {
+	return ctx
+}
+
+// EndOperation implements the Instrumentation interface.
+func (m *MetricsRecorder) EndOperation(_ context.Context, op OperationInfo, result OperationResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.ops[op.Name]
+	if !ok {
+		s = &OperationStats{
+			Statuses:     make(map[int]int),
+			Buckets:      m.buckets,
+			BucketCounts: make([]int, len(m.buckets)+1),
+		}
+		m.ops[op.Name] = s
+	}
+
+	s.Count++
+	if result.Err != nil {
+		s.Errors++
+	}
+	s.Retries += result.Retries
+	if result.Status != 0 {
+		s.Statuses[result.Status]++
+	}
+	s.TotalLatency += result.Latency
+
+	i, _ := slices.BinarySearch(m.buckets, result.Latency)
+	s.BucketCounts[i]++
+}
+
+// Snapshot returns a copy of the metrics recorded so far, keyed by
+// operation name.
+func (m *MetricsRecorder) Snapshot() map[string]OperationStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]OperationStats, len(m.ops))
+	for name, s := range m.ops {
+		cp := *s
+		cp.Statuses = make(map[int]int, len(s.Statuses))
+		for k, v := range s.Statuses {
+			cp.Statuses[k] = v
+		}
+		cp.BucketCounts = slices.Clone(s.BucketCounts)
+		out[name] = cp
+	}
+	return out
+}
+
+// Reset discards all recorded metrics.
+func (m *MetricsRecorder) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ops = make(map[string]*OperationStats)
+}
@@ -0,0 +1,139 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type spanKey struct{}
+
+type recordingInstrumentation struct {
+	started []OperationInfo
+	ended   []OperationResult
+	spans   []any
+}
+
+func (r *recordingInstrumentation) StartOperation(ctx context.Context, op OperationInfo) context.Context {
+	r.started = append(r.started, op)
+	return context.WithValue(ctx, spanKey{}, op.Name)
+}
+
+func (r *recordingInstrumentation) EndOperation(ctx context.Context, _ OperationInfo, result OperationResult) {
+	r.ended = append(r.ended, result)
+	r.spans = append(r.spans, ctx.Value(spanKey{}))
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	t.Parallel()
+
+	rt := &sequenceRoundTripper{
+		statuses: []int{http.StatusServiceUnavailable, http.StatusOK, http.StatusNotFound},
+		headers: []http.Header{
+			nil,
+			{"Content-Type": []string{JSONMimeType}, "Fastly-Ratelimit-Remaining": []string{"77"}},
+		},
+	}
+	c := newRetryTestClient(t, rt, testRetryPolicy())
+	inst := &recordingInstrumentation{}
+	c.Instrumentation = inst
+
+	var seenSpan any
+	c.Use(func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, info *RequestInfo) (*http.Response, error) {
+			if seenSpan == nil {
+				seenSpan = info.Request.Context().Value(spanKey{})
+			}
+			return next(ctx, info)
+		}
+	})
+
+	err := c.DeleteBackend(context.TODO(), &DeleteBackendInput{
+		ServiceID:      "svc",
+		ServiceVersion: 1,
+		Name:           "origin",
+	})
+	require.Error(t, err) // the stub body is not a valid status response
+
+	_, err = c.Get(context.TODO(), "/service/svc/details", CreateRequestOptions())
+	require.Error(t, err)
+
+	require.Len(t, inst.started, 2)
+	require.Equal(t, OperationInfo{
+		Name:         "DeleteBackend",
+		Verb:         http.MethodDelete,
+		PathTemplate: "/service/{service}/version/{version}/backend/{backend}",
+	}, inst.started[0])
+	require.Equal(t, "DeleteBackend", seenSpan)
+	require.Equal(t, "DeleteBackend", inst.spans[0])
+	require.Equal(t, http.StatusOK, inst.ended[0].Status)
+	require.Equal(t, 1, inst.ended[0].Retries)
+	require.Equal(t, 77, inst.ended[0].RateLimitRemaining)
+	require.Positive(t, inst.ended[0].Latency)
+
+	// Requests issued directly from outside the module (or from tests) are
+	// named after the verb and path template.
+	require.Equal(t, "GET /service/{service}/details", inst.started[1].Name)
+	require.Equal(t, http.StatusNotFound, inst.ended[1].Status)
+	require.Error(t, inst.ended[1].Err)
+}
+
+func TestOperationFromFunc(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"fastly.(*Client).CreateBackend":                       "CreateBackend",
+		"fastly.(*Client).CreateBackend.func1":                 "CreateBackend",
+		"fastly.(*Client).Request":                             "",
+		"fastly.(*Client).PostForm":                            "",
+		"fastly.(*Client).do":                                  "",
+		"fastly.(*RTSClient).GetRealtimeStats":                 "GetRealtimeStats",
+		"fastly.(*ListPaginator[...]).GetNext":                 "ListPaginator.GetNext",
+		"fastly.(*ListPaginator[...]).getNext":                 "",
+		"fastly.decodeMap":                                     "",
+		"fastly/computeacls.Update":                            "computeacls.Update",
+		"fastly/ngwaf/v1/workspaces.Create":                    "workspaces.Create",
+		"fastly/apisecurity/operations.(*Paginator[...]).Next": "operations.Paginator.Next",
+		"internal/productcore.Get[...]":                        "",
+	}
+	for in, want := range cases {
+		require.Equal(t, want, operationFromFunc(in), in)
+	}
+}
+
+func TestModulePath(t *testing.T) {
+	t.Parallel()
+
+	require.Regexp(t, `^github\.com/fastly/go-fastly/v\d+/$`, modulePath)
+}
+
+func TestMetricsRecorder(t *testing.T) {
+	t.Parallel()
+
+	m := NewMetricsRecorder(100*time.Millisecond, 10*time.Millisecond)
+	op := OperationInfo{Name: "CreateBackend"}
+
+	m.EndOperation(context.TODO(), op, OperationResult{Status: 200, Latency: 5 * time.Millisecond})
+	m.EndOperation(context.TODO(), op, OperationResult{Status: 200, Latency: 50 * time.Millisecond, Retries: 2})
+	m.EndOperation(context.TODO(), op, OperationResult{Status: 503, Latency: time.Second, Err: context.Canceled})
+
+	snap := m.Snapshot()
+	s := snap["CreateBackend"]
+	require.Equal(t, 3, s.Count)
+	require.Equal(t, 1, s.Errors)
+	require.Equal(t, 2, s.Retries)
+	require.Equal(t, map[int]int{200: 2, 503: 1}, s.Statuses)
+	require.Equal(t, []time.Duration{10 * time.Millisecond, 100 * time.Millisecond}, s.Buckets)
+	require.Equal(t, []int{1, 1, 1}, s.BucketCounts)
+	require.Equal(t, 1055*time.Millisecond, s.TotalLatency)
+
+	// Snapshots are independent copies.
+	s.Statuses[200] = 0
+	require.Equal(t, 2, m.Snapshot()["CreateBackend"].Statuses[200])
+
+	m.Reset()
+	require.Empty(t, m.Snapshot())
+}
@@ -0,0 +1,93 @@
+package fastly
+
+import (
+	"context"
+	"iter"
+)
+
+// PageFunc fetches the next page of a paginated listing. It returns the
+// items on the page and whether another page may follow. A PageFunc is
+// stateful: each call must advance to the following page.
+type PageFunc[E any] func(ctx context.Context) (page []E, more bool, err error)
+
+// Paginate returns an iterator over every item of every page returned by
+// fetch. It is the building block for the All methods of the paginators in
+// this module, and behaves the same for each of them:
+//
+//   - While the loop body consumes a page, the next page is fetched in the
+//     background, so at most one page is requested ahead of the consumer.
+//   - Fetching stops at the first error, which is yielded with the zero
+//     value of E and ends the iteration.
+//   - If ctx is cancelled, the iteration ends by yielding ctx.Err().
+//   - Breaking out of the loop cancels any in-flight request, and the
+//     iterator does not return until fetch has returned.
+//
+// The returned iterator is single-use, as fetch cannot be rewound.
+func Paginate[E any](ctx context.Context, fetch PageFunc[E]) iter.Seq2[E, error] {
+	return func(yield func(E, error) bool) {
+		type result struct {
+			page []E
+			err  error
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		pages := make(chan result)
+		done := make(chan struct{})
+		defer func() {
+			cancel()
+			<-done
+		}()
+
+		go func() {
+			defer close(done)
+			defer close(pages)
+			for {
+				page, more, err := fetch(ctx)
+				select {
+				case pages <- result{page, err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil || !more {
+					return
+				}
+			}
+		}()
+
+		var zero E
+		for r := range pages {
+			if r.err != nil {
+				if err := ctx.Err(); err != nil {
+					r.err = err
+				}
+				yield(zero, r.err)
+				return
+			}
+			for _, item := range r.page {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// PaginateValues is like Paginate for listings whose pages hold values
+// rather than pointers. It yields a pointer to each item.
+func PaginateValues[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[*T, error] {
+	return Paginate(ctx, func(ctx context.Context) ([]*T, bool, error) {
+		page, more, err := fetch(ctx)
+		ptrs := make([]*T, len(page))
+		for i := range page {
+			ptrs[i] = &page[i]
+		}
+		return ptrs, more, err
+	})
+}
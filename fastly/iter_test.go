@@ -0,0 +1,157 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// servicePagesRoundTripper serves three pages of two services each, with
+// Link headers in the style of the Fastly API.
+type servicePagesRoundTripper struct {
+	mu    sync.Mutex
+	pages []string
+}
+
+func (s *servicePagesRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	page := req.URL.Query().Get("page")
+	s.mu.Lock()
+	s.pages = append(s.pages, page)
+	s.mu.Unlock()
+
+	var n int
+	if _, err := fmt.Sscan(page, &n); err != nil {
+		return nil, err
+	}
+	h := http.Header{"Content-Type": []string{JSONMimeType}}
+	if n < 3 {
+		h.Set("Link", fmt.Sprintf(`<%[1]s/service?page=%[2]d>; rel="next", <%[1]s/service?page=3>; rel="last"`, DefaultEndpoint, n+1))
+	}
+	body := fmt.Sprintf(`[{"id":"svc%d"},{"id":"svc%d"}]`, 2*n-1, 2*n)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     h,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func TestClient_AllServices(t *testing.T) {
+	t.Parallel()
+
+	rt := &servicePagesRoundTripper{}
+	c := newRetryTestClient(t, rt, nil)
+
+	var ids []string
+	for svc, err := range c.AllServices(context.TODO()) {
+		require.NoError(t, err)
+		ids = append(ids, *svc.ServiceID)
+	}
+	require.Equal(t, []string{"svc1", "svc2", "svc3", "svc4", "svc5", "svc6"}, ids)
+	require.Equal(t, []string{"1", "2", "3"}, rt.pages)
+
+	// Breaking out of the loop stops fetching. The second page may already
+	// have been prefetched, but never the third.
+	rt = &servicePagesRoundTripper{}
+	c = newRetryTestClient(t, rt, nil)
+	for svc, err := range c.AllServices(context.TODO()) {
+		require.NoError(t, err)
+		require.Equal(t, "svc1", *svc.ServiceID)
+		break
+	}
+	require.NotContains(t, rt.pages, "3")
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	pages := [][]int{{1, 2}, {}, {3}}
+	var calls int
+	fetch := func(context.Context) ([]int, bool, error) {
+		page := pages[calls]
+		calls++
+		return page, calls < len(pages), nil
+	}
+	var got []int
+	for v, err := range Paginate(context.TODO(), fetch) {
+		require.NoError(t, err)
+		got = append(got, v)
+	}
+	require.Equal(t, []int{1, 2, 3}, got)
+
+	// An error ends the iteration after the items already fetched.
+	errBoom := errors.New("boom")
+	calls = 0
+	fetch = func(context.Context) ([]int, bool, error) {
+		calls++
+		if calls == 2 {
+			return nil, true, errBoom
+		}
+		return []int{calls}, true, nil
+	}
+	got = nil
+	var gotErr error
+	for v, err := range Paginate(context.TODO(), fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+	}
+	require.Equal(t, []int{1}, got)
+	require.ErrorIs(t, gotErr, errBoom)
+	require.Equal(t, 2, calls)
+}
+
+func TestPaginate_Cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetch := func(ctx context.Context) ([]int, bool, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+		return []int{1, 2, 3}, true, nil
+	}
+
+	var got []int
+	var gotErr error
+	for v, err := range Paginate(ctx, fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, v)
+		if v == 2 {
+			cancel()
+		}
+	}
+	require.Equal(t, []int{1, 2}, got)
+	require.ErrorIs(t, gotErr, context.Canceled)
+}
+
+func TestPaginateValues(t *testing.T) {
+	t.Parallel()
+
+	done := false
+	fetch := func(context.Context) ([]string, bool, error) {
+		if done {
+			return nil, false, nil
+		}
+		done = true
+		return []string{"a", "b"}, true, nil
+	}
+	var got []string
+	for v, err := range PaginateValues(context.TODO(), fetch) {
+		require.NoError(t, err)
+		got = append(got, *v)
+	}
+	require.Equal(t, []string{"a", "b"}, got)
+}
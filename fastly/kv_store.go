@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"io"
+	"iter"
 	"net/http"
 	"os"
 	"strconv"
@@ -146,13 +147,28 @@ func (c *Client) NewListKVStoresPaginator(ctx context.Context, i *ListKVStoresIn
 
 // Next advances the paginator and fetches the next set of kv stores.
 func (l *ListKVStoresPaginator) Next() bool {
+	return l.next(l.ctx)
+}
+
+// All returns an iterator over the kv stores of all remaining pages, using
+// ctx for each request (see Paginate).
+func (l *ListKVStoresPaginator) All(ctx context.Context) iter.Seq2[*KVStore, error] {
+	return PaginateValues(ctx, func(ctx context.Context) ([]KVStore, bool, error) {
+		if !l.next(ctx) {
+			return nil, false, l.err
+		}
+		return l.stores, !l.finished, nil
+	})
+}
+
+func (l *ListKVStoresPaginator) next(ctx context.Context) bool {
 	if l.finished {
 		l.stores = nil
 		return false
 	}
 
 	l.input.Cursor = l.cursor
-	o, err := l.client.ListKVStores(l.ctx, l.input)
+	o, err := l.client.ListKVStores(ctx, l.input)
 	if err != nil {
 		l.err = err
 		l.finished = true
@@ -169,6 +185,16 @@ func (l *ListKVStoresPaginator) Next() bool {
 	return true
 }
 
+// AllKVStores returns an iterator over all kv stores matching i, fetching
+// them a page at a time (see Paginate).
+func (c *Client) AllKVStores(ctx context.Context, i *ListKVStoresInput) iter.Seq2[*KVStore, error] {
+	input := ListKVStoresInput{}
+	if i != nil {
+		input = *i
+	}
+	return c.NewListKVStoresPaginator(ctx, &input).All(ctx)
+}
+
 // Stores returns the current partial list of kv stores.
 func (l *ListKVStoresPaginator) Stores() []KVStore {
 	return l.stores
@@ -348,13 +374,28 @@ func (c *Client) NewListKVStoreKeysPaginator(ctx context.Context, i *ListKVStore
 
 // Next advances the paginator.
 func (l *ListKVStoreKeysPaginator) Next() bool {
+	return l.next(l.ctx)
+}
+
+// All returns an iterator over the keys of all remaining pages, using ctx
+// for each request (see Paginate). Keys are yielded as plain strings.
+func (l *ListKVStoreKeysPaginator) All(ctx context.Context) iter.Seq2[string, error] {
+	return Paginate(ctx, func(ctx context.Context) ([]string, bool, error) {
+		if !l.next(ctx) {
+			return nil, false, l.err
+		}
+		return l.keys, !l.finished, nil
+	})
+}
+
+func (l *ListKVStoreKeysPaginator) next(ctx context.Context) bool {
 	if l.finished {
 		l.keys = nil
 		return false
 	}
 
 	l.input.Cursor = l.cursor
-	o, err := l.client.ListKVStoreKeys(l.ctx, l.input)
+	o, err := l.client.ListKVStoreKeys(ctx, l.input)
 	if err != nil {
 		l.err = err
 		l.finished = true
@@ -371,6 +412,14 @@ func (l *ListKVStoreKeysPaginator) Next() bool {
 	return true
 }
 
+// AllKVStoreKeys returns an iterator over all keys in a kv store matching i,
+// fetching them a page at a time (see Paginate).
+func (c *Client) AllKVStoreKeys(ctx context.Context, i *ListKVStoreKeysInput) iter.Seq2[string, error] {
+	input := *i
+	p := &ListKVStoreKeysPaginator{client: c, input: &input}
+	return p.All(ctx)
+}
+
 // Err returns any error from the paginator.
 func (l *ListKVStoreKeysPaginator) Err() error {
 	return l.err
@@ -0,0 +1,310 @@
+package fastly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/jsonapi"
+)
+
+// RedactedValue replaces sensitive values in logged requests and responses.
+const RedactedValue = "[REDACTED]"
+
+// maxLoggedBodySize is the maximum number of response body bytes buffered
+// for logging. Larger bodies are logged as omitted.
+const maxLoggedBodySize = 64 << 10
+
+// Redactor masks sensitive data in requests and responses before they are
+// logged.
+type Redactor interface {
+	// RedactHeader returns the value to log for the named header.
+	RedactHeader(name, value string) string
+	// RedactBody returns the body to log, given the body's Content-Type.
+	RedactBody(contentType string, body []byte) []byte
+}
+
+// Ensure FieldRedactor implements Redactor.
+var _ Redactor = (*FieldRedactor)(nil)
+
+// FieldRedactor is a [Redactor] that masks the values of named headers and
+// named form or JSON fields. Bodies of any other content type (for example
+// KV Store values or Compute packages) are omitted entirely.
+type FieldRedactor struct {
+	// Headers is the list of header names whose values are masked. Matching
+	// is case-insensitive.
+	Headers []string
+	// Fields is the list of form keys and JSON object keys whose values are
+	// masked. JSON keys are matched at any depth, which includes JSON:API
+	// attributes. Matching is case-insensitive.
+	Fields []string
+}
+
+// DefaultRedactor returns a FieldRedactor covering the API key header and
+// the credential fields used by logging endpoints, TLS private keys and
+// secret stores.
+func DefaultRedactor() *FieldRedactor {
+	return &FieldRedactor{
+		Headers: []string{APIKeyHeader, "Authorization", "Cookie", "Set-Cookie"},
+		Fields: []string{
+			// Logging endpoint credentials.
+			"access_key",
+			"api_key",
+			"auth_token",
+			"password",
+			"sas_token",
+			"secret_key",
+			"ssl_client_key",
+			"tls_client_key",
+			"token",
+			// TLS private keys.
+			"key",
+			"private_key",
+			// Secret store.
+			"client_key",
+			"secret",
+			"signing_key",
+			// Tokens and automation tokens.
+			"access_token",
+		},
+	}
+}
+
+// RedactHeader implements the Redactor interface.
+func (r *FieldRedactor) RedactHeader(name, value string) string {
+	for _, h := range r.Headers {
+		if strings.EqualFold(h, name) {
+			return RedactedValue
+		}
+	}
+	return value
+}
+
+// RedactBody implements the Redactor interface.
+func (r *FieldRedactor) RedactBody(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return omittedBody(len(body))
+		}
+		for k := range values {
+			if r.sensitive(k) {
+				for i := range values[k] {
+					values[k][i] = RedactedValue
+				}
+			}
+		}
+		return []byte(values.Encode())
+	case mediaType == "application/x-ndjson":
+		lines := bytes.Split(body, []byte("\n"))
+		for i, line := range lines {
+			if len(bytes.TrimSpace(line)) > 0 {
+				lines[i] = r.redactJSON(line)
+			}
+		}
+		return bytes.Join(lines, []byte("\n"))
+	case mediaType == JSONMimeType, mediaType == jsonapi.MediaType, strings.HasSuffix(mediaType, "+json"):
+		return r.redactJSON(body)
+	default:
+		return omittedBody(len(body))
+	}
+}
+
+func (r *FieldRedactor) redactJSON(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return omittedBody(len(body))
+	}
+	out, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return omittedBody(len(body))
+	}
+	return out
+}
+
+func (r *FieldRedactor) redactValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if r.sensitive(k) && val != nil {
+				t[k] = RedactedValue
+			} else {
+				t[k] = r.redactValue(val)
+			}
+		}
+	case []any:
+		for i := range t {
+			t[i] = r.redactValue(t[i])
+		}
+	}
+	return v
+}
+
+func (r *FieldRedactor) sensitive(field string) bool {
+	return slices.ContainsFunc(r.Fields, func(f string) bool {
+		return strings.EqualFold(f, field)
+	})
+}
+
+func omittedBody(n int) []byte {
+	return fmt.Appendf(nil, "[%d bytes omitted]", n)
+}
+
+// requestIDFromHeader returns the API request ID from the response headers.
+func requestIDFromHeader(h http.Header) string {
+	if id := h.Get("X-Request-Id"); id != "" {
+		return id
+	}
+	return h.Get("Fastly-Request-Id")
+}
+
+// logger returns the logger to use for request logging, or nil if logging
+// is disabled. DebugMode without a Logger logs to stderr at debug level.
+func (c *Client) logger() *slog.Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	if c.DebugMode {
+		return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+	return nil
+}
+
+// redactor returns the client's Redactor, or the default one.
+func (c *Client) redactor() Redactor {
+	if c.Redactor != nil {
+		return c.Redactor
+	}
+	return DefaultRedactor()
+}
+
+// redactedHeaders returns h as a slog group value with sensitive values
+// masked.
+func redactedHeaders(r Redactor, h http.Header) slog.Value {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.String(k, r.RedactHeader(k, strings.Join(h[k], ", "))))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// logRequest logs req at debug level. Only replayable bodies (those
+// built in memory by the Request* helpers) are logged; streamed bodies are
+// never read.
+func (c *Client) logRequest(ctx context.Context, l *slog.Logger, req *http.Request) {
+	if !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	r := c.redactor()
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Any("headers", redactedHeaders(r, req.Header)),
+	}
+	if req.URL.RawQuery != "" {
+		attrs = append(attrs, slog.String("query", redactQuery(r, req.URL.Query()).Encode()))
+	}
+	if body := requestBody(req); body != nil {
+		attrs = append(attrs, slog.String("body", string(r.RedactBody(req.Header.Get("Content-Type"), body))))
+	} else if req.Body != nil && req.Body != http.NoBody {
+		attrs = append(attrs, slog.String("body", "[streamed body omitted]"))
+	}
+	l.DebugContext(ctx, "fastly: request", attrs...)
+}
+
+// logResponse logs the outcome of a request. Successful responses are
+// logged at debug level, API errors at info level and transport errors at
+// warn level.
+func (c *Client) logResponse(ctx context.Context, l *slog.Logger, req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", elapsed),
+	}
+	if resp == nil {
+		l.WarnContext(ctx, "fastly: request failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if id := requestIDFromHeader(resp.Header); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		l.InfoContext(ctx, "fastly: response error", append(attrs, slog.String("error", httpErr.Error()))...)
+		return
+	}
+	if err != nil {
+		l.WarnContext(ctx, "fastly: request failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+	if !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	r := c.redactor()
+	attrs = append(attrs, slog.Any("headers", redactedHeaders(r, resp.Header)))
+	if body, ok := peekResponseBody(resp); ok {
+		attrs = append(attrs, slog.String("body", string(r.RedactBody(resp.Header.Get("Content-Type"), body))))
+	}
+	l.DebugContext(ctx, "fastly: response", attrs...)
+}
+
+// requestBody returns a copy of the request body if it can be replayed
+// without consuming req.Body, or nil otherwise.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil || req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	rc, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// peekResponseBody reads up to maxLoggedBodySize bytes of the response body
+// and restores resp.Body so the caller still sees the full body. It returns
+// false if the body is larger than the limit.
+func peekResponseBody(resp *http.Response) ([]byte, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, true
+	}
+	buf, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), resp.Body), resp.Body}
+	if err != nil || len(buf) > maxLoggedBodySize {
+		return nil, false
+	}
+	return buf, true
+}
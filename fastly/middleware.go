@@ -0,0 +1,113 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+//go:generate go run ../internal/cmd/pathsegments -dir . -out path_segments.go
+
+// RequestInfo describes a single logical API request made through
+// [Client.Request]. It is passed to each [Middleware] in the chain.
+type RequestInfo struct {
+	// Operation is the name of the API operation that issued the request,
+	// e.g. "CreateBackend" or "computeacls.Update". It is empty if the
+	// request was issued directly by code outside this module.
+	Operation string
+	// Verb is the HTTP method of the request.
+	Verb string
+	// Path is the request path, relative to the client's Address.
+	Path string
+	// PathTemplate is Path with its parameters replaced by placeholders
+	// named after the preceding path segment, e.g.
+	// "/service/{service}/version/{version}/backend".
+	PathTemplate string
+	// ResourceID is the resource ID set with [NewContextForResourceID], or
+	// an empty string if none was set.
+	ResourceID string
+	// Parallel reports whether the request may run concurrently with other
+	// requests for the same ResourceID (see [RequestOptions]).
+	Parallel bool
+	// CustomerID is the impersonated customer ID set with
+	// impersonation.NewContextForCustomerID, or an empty string if none was
+	// set.
+	CustomerID string
+	// Request is the HTTP request that will be sent. Middleware may modify
+	// it, or replace it, before calling the next handler.
+	Request *http.Request
+	// Retries is the number of retries performed after the first attempt.
+	// It is set once the innermost handler returns.
+	Retries int
+}
+
+// RequestHandler sends the request described by info and returns the
+// response. On failure the returned error is an *HTTPError if the API
+// responded with an unsuccessful status code.
+type RequestHandler func(ctx context.Context, info *RequestInfo) (*http.Response, error)
+
+// Middleware wraps a [RequestHandler] with additional behavior, such as
+// logging, metrics or header rewriting. A middleware must call next to
+// continue the chain, or return its own response to short-circuit it.
+type Middleware func(next RequestHandler) RequestHandler
+
+// Use appends middleware to the client's request chain. The first
+// middleware registered is the outermost one, i.e. it sees the request
+// first and the response last.
+//
+// Middleware wraps the whole logical request, including write throttling,
+// resource locking and retries.
+//
+// This method is safe to use from concurrent goroutines.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middlewareMu.Lock()
+	defer c.middlewareMu.Unlock()
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// handler returns the client's request handler, wrapped in the dry-run
+// handler if DryRun is set and in any registered middleware.
+func (c *Client) handler() RequestHandler {
+	c.middlewareMu.RLock()
+	defer c.middlewareMu.RUnlock()
+
+	h := RequestHandler(c.do)
+	if c.DryRun != nil {
+		h = c.DryRun.dryRun(h, c.redactor())
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+	return h
+}
+
+// PathTemplate returns p with every path parameter replaced by a
+// placeholder named after the preceding literal segment. Segments are
+// recognised as literals if they are used as such by any API operation in
+// this module, so the result is a best-effort approximation.
+//
+// For example "/service/SU1Z0isxPaozGVKXdv0eY/version/1/backend/www" becomes
+// "/service/{service}/version/{version}/backend/{backend}".
+func PathTemplate(p string) string {
+	if i := strings.IndexByte(p, '?'); i >= 0 {
+		p = p[:i]
+	}
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+	prev := ""
+	for i, seg := range segs {
+		if seg == "" {
+			continue
+		}
+		if _, ok := knownPathSegments[seg]; ok {
+			prev = seg
+			continue
+		}
+		if prev != "" {
+			segs[i] = "{" + prev + "}"
+		} else {
+			segs[i] = "{param}"
+		}
+		prev = ""
+	}
+	return "/" + strings.Join(segs, "/")
+}
@@ -0,0 +1,105 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly/impersonation"
+)
+
+func TestClient_Use(t *testing.T) {
+	t.Parallel()
+
+	rt := &sequenceRoundTripper{statuses: []int{http.StatusOK, http.StatusConflict}}
+	c := newRetryTestClient(t, rt, nil)
+
+	var (
+		order []string
+		seen  []RequestInfo
+		errs  []error
+	)
+	c.Use(
+		func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, info *RequestInfo) (*http.Response, error) {
+				order = append(order, "outer")
+				info.Request.Header.Set("X-Injected", "yes")
+				resp, err := next(ctx, info)
+				seen = append(seen, *info)
+				errs = append(errs, err)
+				return resp, err
+			}
+		},
+		func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, info *RequestInfo) (*http.Response, error) {
+				order = append(order, "inner")
+				require.Equal(t, "yes", info.Request.Header.Get("X-Injected"))
+				return next(ctx, info)
+			}
+		},
+	)
+
+	ctx := NewContextForResourceID(context.TODO(), "svc123")
+	ctx = impersonation.NewContextForCustomerID(ctx, "cust456")
+	_, err := c.Get(ctx, ToSafeURL("service", "svc123", "version", "4", "backend"), CreateRequestOptions())
+	require.NoError(t, err)
+	_, err = c.Put(context.TODO(), ToSafeURL("service", "svc123", "version", "4", "activate"), CreateRequestOptions())
+	require.Error(t, err)
+
+	require.Equal(t, []string{"outer", "inner", "outer", "inner"}, order)
+
+	require.Equal(t, http.MethodGet, seen[0].Verb)
+	require.Equal(t, "/service/svc123/version/4/backend", seen[0].Path)
+	require.Equal(t, "/service/{service}/version/{version}/backend", seen[0].PathTemplate)
+	require.Equal(t, "svc123", seen[0].ResourceID)
+	require.Equal(t, "cust456", seen[0].CustomerID)
+	require.True(t, seen[0].Parallel)
+	require.NoError(t, errs[0])
+
+	require.Equal(t, http.MethodPut, seen[1].Verb)
+	require.Empty(t, seen[1].ResourceID)
+	require.False(t, seen[1].Parallel)
+	var httpErr *HTTPError
+	require.True(t, errors.As(errs[1], &httpErr))
+	require.Equal(t, http.StatusConflict, httpErr.StatusCode)
+}
+
+func TestClient_UseShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	rt := &sequenceRoundTripper{statuses: []int{http.StatusOK}}
+	c := newRetryTestClient(t, rt, nil)
+	c.Use(func(_ RequestHandler) RequestHandler {
+		return func(_ context.Context, _ *RequestInfo) (*http.Response, error) {
+			return checkResp(&http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"msg":"injected"}`)),
+			}, nil)
+		}
+	})
+
+	_, err := c.Get(context.TODO(), "/service", CreateRequestOptions())
+	require.ErrorContains(t, err, "injected")
+	require.Empty(t, rt.bodies)
+}
+
+func TestPathTemplate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"/service/SU1Z0isxPaozGVKXdv0eY/version/1/backend/www": "/service/{service}/version/{version}/backend/{backend}",
+		"/tokens/self":                           "/tokens/self",
+		"service/abc/details":                    "/service/{service}/details",
+		"/resources/stores/kv/x/keys/y?cursor=z": "/resources/stores/kv/{kv}/keys/{keys}",
+		"/":                                      "/",
+	}
+	for in, want := range cases {
+		require.Equal(t, want, PathTemplate(in), in)
+	}
+}
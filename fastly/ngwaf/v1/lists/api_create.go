@@ -27,7 +27,7 @@ type CreateInput struct {
 }
 
 // Create creates a new list.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*List, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*List, error) {
 	if i.Entries == nil {
 		return nil, fastly.ErrMissingEntries
 	}
@@ -20,7 +20,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes the specified list.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.ListID == nil {
 		return fastly.ErrMissingListID
 	}
@@ -20,7 +20,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified list.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*List, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*List, error) {
 	if i.ListID == nil {
 		return nil, fastly.ErrMissingListID
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/fastly/go-fastly/v17/fastly"
 	"github.com/fastly/go-fastly/v17/fastly/ngwaf/v1/scope"
@@ -18,7 +19,7 @@ type ListInput struct {
 }
 
 // ListLists retrieves a list of lists for the given workspace.
-func ListLists(ctx context.Context, c *fastly.Client, i *ListInput) (*Lists, error) {
+func ListLists(ctx context.Context, c fastly.Requester, i *ListInput) (*Lists, error) {
 	if i.Scope == nil {
 		return nil, fastly.ErrMissingScope
 	}
@@ -41,3 +42,16 @@ func ListLists(ctx context.Context, c *fastly.Client, i *ListInput) (*Lists, err
 
 	return lists, nil
 }
+
+// All returns an iterator over the lists for the given scope (see
+// fastly.Paginate). The lists API is not paginated, so all lists are
+// fetched with a single request.
+func All(ctx context.Context, c fastly.Requester, i *ListInput) iter.Seq2[*List, error] {
+	return fastly.PaginateValues(ctx, func(ctx context.Context) ([]List, bool, error) {
+		lists, err := ListLists(ctx, c, i)
+		if err != nil {
+			return nil, false, err
+		}
+		return lists.Data, false, nil
+	})
+}
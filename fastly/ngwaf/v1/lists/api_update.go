@@ -24,7 +24,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified list.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*List, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*List, error) {
 	if i.ListID == nil {
 		return nil, fastly.ErrMissingListID
 	}
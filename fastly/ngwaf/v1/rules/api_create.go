@@ -189,7 +189,7 @@ type privateCreateMultivalCondition struct {
 }
 
 // Create creates a new rule.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Rule, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Rule, error) {
 	if i.Type == nil {
 		return nil, fastly.ErrMissingType
 	}
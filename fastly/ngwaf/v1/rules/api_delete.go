@@ -21,7 +21,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes the specified rule.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.RuleID == nil {
 		return fastly.ErrMissingRuleID
 	}
@@ -21,7 +21,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified rule.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Rule, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Rule, error) {
 	if i.RuleID == nil {
 		return nil, fastly.ErrMissingRuleID
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"strconv"
 
 	"github.com/fastly/go-fastly/v17/fastly"
@@ -32,7 +33,7 @@ type ListInput struct {
 
 // List retrieves a list of rules, with optional filtering and
 // pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Rules, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Rules, error) {
 	if i.Scope == nil {
 		return nil, fastly.ErrMissingScope
 	}
@@ -72,3 +73,30 @@ func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Rules, error) {
 
 	return r, nil
 }
+
+// All returns an iterator over all rules matching i, fetching them a page at
+// a time (see fastly.Paginate). Iteration starts at i.Page, or the first
+// page, and ends once meta.total rules have been returned or a page is
+// empty. i itself is not modified.
+func All(ctx context.Context, c fastly.Requester, i *ListInput) iter.Seq2[*Rule, error] {
+	input := *i
+	page := 1
+	if input.Page != nil {
+		page = *input.Page
+	}
+	if input.Limit == nil {
+		input.Limit = fastly.ToPointer(100)
+	}
+
+	var fetched int
+	return fastly.PaginateValues(ctx, func(ctx context.Context) ([]Rule, bool, error) {
+		input.Page = fastly.ToPointer(page)
+		r, err := List(ctx, c, &input)
+		if err != nil {
+			return nil, false, err
+		}
+		page++
+		fetched += len(r.Data)
+		return r.Data, len(r.Data) > 0 && fetched < r.Meta.Total, nil
+	})
+}
@@ -191,7 +191,7 @@ type privateUpdateMultivalCondition struct {
 }
 
 // Update updates a rule.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Rule, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Rule, error) {
 	if i.RuleID == nil {
 		return nil, fastly.ErrMissingRuleID
 	}
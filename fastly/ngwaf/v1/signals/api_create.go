@@ -24,7 +24,7 @@ type CreateInput struct {
 }
 
 // Create creates a new signal in the given workspace.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Signal, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Signal, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
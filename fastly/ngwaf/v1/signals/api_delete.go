@@ -20,7 +20,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes the specified signal.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.SignalID == nil {
 		return fastly.ErrMissingSignalID
 	}
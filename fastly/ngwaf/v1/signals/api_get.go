@@ -20,7 +20,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified signal for the given workspace.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Signal, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Signal, error) {
 	if i.Scope == nil {
 		return nil, fastly.ErrMissingScope
 	}
@@ -22,7 +22,7 @@ type ListInput struct {
 
 // List retrieves a list of signals for the given workspace, with
 // optional pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Signals, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Signals, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Limit != nil {
 		requestOptions.Params["limit"] = strconv.Itoa(*i.Limit)
@@ -24,7 +24,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified signal.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Signal, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Signal, error) {
 	if i.SignalID == nil {
 		return nil, fastly.ErrMissingSignalID
 	}
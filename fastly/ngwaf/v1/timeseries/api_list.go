@@ -29,7 +29,7 @@ type ListInput struct {
 }
 
 // List retrieves timeseries metrics for Next-Gen WAF.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Timeseries, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Timeseries, error) {
 	if i.Metrics == nil || *i.Metrics == "" {
 		return nil, fastly.ErrMissingMetrics
 	}
@@ -42,7 +42,7 @@ type privateDatadogInput struct {
 }
 
 // Create creates a new datadog alert.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Alert, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Alert, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -48,7 +48,7 @@ type privateJiraInput struct {
 }
 
 // Create creates a new jira alert.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Alert, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Alert, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
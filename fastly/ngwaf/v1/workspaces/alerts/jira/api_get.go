@@ -20,7 +20,7 @@ type GetInput struct {
 }
 
 // Get retrieves the jira workspace alert.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Alert, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Alert, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -40,7 +40,7 @@ type privateTeamsInput struct {
 }
 
 // Create creates a new microsoftteams alert.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Alert, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Alert, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
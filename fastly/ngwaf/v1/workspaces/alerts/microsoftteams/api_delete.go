@@ -19,7 +19,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes the microsoftteams workspace alert.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.WorkspaceID == nil {
 		return fastly.ErrMissingWorkspaceID
 	}
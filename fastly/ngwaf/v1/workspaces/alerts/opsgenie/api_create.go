@@ -40,7 +40,7 @@ type privateOpsGenieInput struct {
 }
 
 // Create creates a new opsgenie alert.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Alert, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Alert, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -21,7 +21,7 @@ type ListInput struct {
 }
 
 // List retrieves a list of opsgenie workspace alerts.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Alerts, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Alerts, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -30,7 +30,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified opsgenie alert.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Alert, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Alert, error) {
 	if i.AlertID == nil {
 		return nil, fastly.ErrMissingAlertID
 	}
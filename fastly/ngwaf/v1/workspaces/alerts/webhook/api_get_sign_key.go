@@ -20,7 +20,7 @@ type GetKeyInput struct {
 }
 
 // GetKey gets the webhook alert signing key.
-func GetKey(ctx context.Context, c *fastly.Client, i *GetKeyInput) (*AlertsKey, error) {
+func GetKey(ctx context.Context, c fastly.Requester, i *GetKeyInput) (*AlertsKey, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
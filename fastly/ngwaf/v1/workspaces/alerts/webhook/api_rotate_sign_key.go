@@ -20,7 +20,7 @@ type RotateKeyInput struct {
 }
 
 // RotateKey rotates the webhook alert signing key.
-func RotateKey(ctx context.Context, c *fastly.Client, i *RotateKeyInput) (*AlertsKey, error) {
+func RotateKey(ctx context.Context, c fastly.Requester, i *RotateKeyInput) (*AlertsKey, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
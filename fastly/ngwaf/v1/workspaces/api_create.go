@@ -43,7 +43,7 @@ type CreateInput struct {
 }
 
 // Create creates a new workspace.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Workspace, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Workspace, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
@@ -16,7 +16,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified workspace.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Workspace, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Workspace, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -22,7 +22,7 @@ type ListInput struct {
 
 // List retrieves a list of workspaces, with optional filtering and
 // pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Workspaces, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Workspaces, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Limit != nil {
 		requestOptions.Params["limit"] = strconv.Itoa(*i.Limit)
@@ -45,7 +45,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified workspace.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Workspace, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Workspace, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
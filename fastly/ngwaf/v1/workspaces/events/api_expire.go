@@ -20,7 +20,7 @@ type ExpireInput struct {
 }
 
 // Expire expires the specified event.
-func Expire(ctx context.Context, c *fastly.Client, i *ExpireInput) (*Event, error) {
+func Expire(ctx context.Context, c fastly.Requester, i *ExpireInput) (*Event, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -18,7 +18,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified event.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Event, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Event, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -36,7 +36,7 @@ type ListInput struct {
 }
 
 // List retrieves a list of events, with optional filtering and pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Events, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Events, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
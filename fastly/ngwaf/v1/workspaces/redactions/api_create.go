@@ -24,7 +24,7 @@ type CreateInput struct {
 }
 
 // Create creates a new redaction.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Redaction, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Redaction, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -18,7 +18,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified redaction.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Redaction, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Redaction, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
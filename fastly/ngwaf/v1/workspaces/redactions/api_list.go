@@ -19,7 +19,7 @@ type ListInput struct {
 }
 
 // List retrieves a list of redactions, with optional pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Redactions, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Redactions, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Limit != nil {
 		requestOptions.Params["limit"] = strconv.Itoa(*i.Limit)
@@ -27,7 +27,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified redaction.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Redaction, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Redaction, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -18,7 +18,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified request.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Request, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Request, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
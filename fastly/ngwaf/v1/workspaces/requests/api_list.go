@@ -28,7 +28,7 @@ type ListInput struct {
 
 // List retrieves a list of requests in the specified workspace, with
 // optional filtering and pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Requests, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Requests, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -41,7 +41,7 @@ type CreateInput struct {
 }
 
 // Create creates a new threshold.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*Threshold, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*Threshold, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -18,7 +18,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified threshold.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*Threshold, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*Threshold, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -19,7 +19,7 @@ type ListInput struct {
 }
 
 // List retrieves a list of thresholds, with optional limiting.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Thresholds, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Thresholds, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Limit != nil {
 		requestOptions.Params["limit"] = strconv.Itoa(*i.Limit)
@@ -41,7 +41,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified workspace.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*Threshold, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*Threshold, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -27,7 +27,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified timeseries.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*TimeSeries, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*TimeSeries, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -18,7 +18,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified virtual patch.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*VirtualPatch, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*VirtualPatch, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
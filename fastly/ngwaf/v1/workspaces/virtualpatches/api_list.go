@@ -21,7 +21,7 @@ type ListInput struct {
 }
 
 // List retrieves a list of virtual patches, with optional pagination.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*VirtualPatches, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*VirtualPatches, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
@@ -27,7 +27,7 @@ type UpdateInput struct {
 }
 
 // Update updates the specified virtual patch.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*VirtualPatch, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*VirtualPatch, error) {
 	if i.WorkspaceID == nil {
 		return nil, fastly.ErrMissingWorkspaceID
 	}
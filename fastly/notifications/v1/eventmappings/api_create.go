@@ -35,7 +35,7 @@ type CreateInput struct {
 
 // Create creates a new audit log event mapping. The mapping becomes active
 // immediately.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*EventMapping, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*EventMapping, error) {
 	if i.Name == nil {
 		return nil, fastly.ErrMissingName
 	}
@@ -15,7 +15,7 @@ type DeleteInput struct {
 }
 
 // Delete deletes the specified event mapping.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.MappingID == nil {
 		return fastly.ErrMissingMappingID
 	}
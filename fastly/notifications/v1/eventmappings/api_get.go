@@ -16,7 +16,7 @@ type GetInput struct {
 }
 
 // Get retrieves the specified event mapping.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*EventMapping, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*EventMapping, error) {
 	if i.MappingID == nil {
 		return nil, fastly.ErrMissingMappingID
 	}
@@ -35,7 +35,7 @@ type ListInput struct {
 
 // List retrieves all event mappings matching the given filters,
 // automatically paginating through all pages.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) ([]EventMapping, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) ([]EventMapping, error) {
 	var (
 		out    []EventMapping
 		cursor *string
@@ -55,7 +55,7 @@ func List(ctx context.Context, c *fastly.Client, i *ListInput) ([]EventMapping,
 }
 
 // listPage retrieves a single page of event mappings.
-func listPage(ctx context.Context, c *fastly.Client, i *ListInput, cursor *string) (*Collection, error) {
+func listPage(ctx context.Context, c fastly.Requester, i *ListInput, cursor *string) (*Collection, error) {
 	path := fastly.ToSafeURL("notifications", "v1", "event-mappings")
 
 	requestOptions := fastly.CreateRequestOptions()
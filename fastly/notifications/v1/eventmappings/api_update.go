@@ -36,7 +36,7 @@ type UpdateInput struct {
 
 // Update replaces an existing event mapping. The mapping is always set to
 // MappingStatusActive on update.
-func Update(ctx context.Context, c *fastly.Client, i *UpdateInput) (*EventMapping, error) {
+func Update(ctx context.Context, c fastly.Requester, i *UpdateInput) (*EventMapping, error) {
 	if i.MappingID == nil {
 		return nil, fastly.ErrMissingMappingID
 	}
@@ -21,7 +21,7 @@ type ListInput struct {
 
 // List retrieves all audit event types that can be used when creating an
 // event mapping.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Collection, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Collection, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.ScopeType != nil {
 		requestOptions.Params["scope_type"] = *i.ScopeType
@@ -17,7 +17,7 @@ type ListInput struct {
 }
 
 // List retrieves all scope types supported by the Event Mappings API.
-func List(ctx context.Context, c *fastly.Client, i *ListInput) (*Collection, error) {
+func List(ctx context.Context, c fastly.Requester, i *ListInput) (*Collection, error) {
 	requestOptions := fastly.CreateRequestOptions()
 	if i.Sort != nil {
 		requestOptions.Params["sort"] = *i.Sort
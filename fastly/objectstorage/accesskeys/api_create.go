@@ -31,7 +31,7 @@ type CreateInput struct {
 }
 
 // Create creates a new Object Storage Access Key.
-func Create(ctx context.Context, c *fastly.Client, i *CreateInput) (*AccessKey, error) {
+func Create(ctx context.Context, c fastly.Requester, i *CreateInput) (*AccessKey, error) {
 	if i.Description == nil {
 		return nil, fastly.ErrMissingDescription
 	}
@@ -15,7 +15,7 @@ type DeleteInput struct {
 }
 
 // DeleteAccessKey deletes an access key.
-func Delete(ctx context.Context, c *fastly.Client, i *DeleteInput) error {
+func Delete(ctx context.Context, c fastly.Requester, i *DeleteInput) error {
 	if i.AccessKeyID == nil {
 		return fastly.ErrMissingAccessKeyID
 	}
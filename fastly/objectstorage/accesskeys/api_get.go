@@ -16,7 +16,7 @@ type GetInput struct {
 }
 
 // GetAccessKey finds an access key with the given ID if the user has the correct permisssions.
-func Get(ctx context.Context, c *fastly.Client, i *GetInput) (*AccessKey, error) {
+func Get(ctx context.Context, c fastly.Requester, i *GetInput) (*AccessKey, error) {
 	if i.AccessKeyID == nil {
 		return nil, fastly.ErrMissingAccessKeyID
 	}
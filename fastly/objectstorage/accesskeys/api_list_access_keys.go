@@ -9,7 +9,7 @@ import (
 )
 
 // ListAccessKeys retrieves all access keys within object storage.
-func ListAccessKeys(ctx context.Context, c *fastly.Client) (*AccessKeys, error) {
+func ListAccessKeys(ctx context.Context, c fastly.Requester) (*AccessKeys, error) {
 	resp, err := c.Get(ctx, "/resources/object-storage/access-keys", fastly.CreateRequestOptions())
 	if err != nil {
 		return nil, err
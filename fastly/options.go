@@ -0,0 +1,279 @@
+package fastly
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// Option configures a [Client] created with [New].
+type Option func(*clientConfig) error
+
+// clientConfig collects the settings applied by each Option.
+type clientConfig struct {
+	apiKey          *string
+	endpoint        *string
+	defaultEndpoint string
+	endpointEnvVar  string
+	userAgent       string
+	httpClient      *http.Client
+	timeout         time.Duration
+	logger          *slog.Logger
+	debugMode       *bool
+	dryRun          *Plan
+	har             *HARRecorder
+	retryPolicy     *RetryPolicy
+	locks           *ResourceLockManager
+	tokenSource     TokenSource
+	readEnv         bool
+}
+
+// WithAPIKey sets the Fastly API key used to authenticate requests. Without
+// this option the key is read from FASTLY_API_KEY, unless environment
+// variables are disabled with WithEnvironment(false).
+func WithAPIKey(key string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.apiKey = &key
+		return nil
+	}
+}
+
+// WithTokenSource sets the TokenSource consulted for the API token on every
+// request, and enables resending a request once with a refreshed token after
+// a 401 (see Client.RefreshOnUnauthorized). It takes precedence over
+// WithAPIKey and FASTLY_API_KEY.
+func WithTokenSource(ts TokenSource) Option {
+	return func(cfg *clientConfig) error {
+		if ts == nil {
+			return errors.New("token source cannot be nil")
+		}
+		cfg.tokenSource = ts
+		return nil
+	}
+}
+
+// WithEndpoint sets the API endpoint. Without this option the endpoint is
+// read from FASTLY_API_URL, falling back to DefaultEndpoint.
+func WithEndpoint(endpoint string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.endpoint = &endpoint
+		return nil
+	}
+}
+
+// WithUserAgent prepends ua to the client's User-Agent header, in the same
+// way as the FASTLY_USER_AGENT environment variable. It only affects the
+// client being created.
+func WithUserAgent(ua string) Option {
+	return func(cfg *clientConfig) error {
+		cfg.userAgent = ua
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(cfg *clientConfig) error {
+		if hc == nil {
+			return errors.New("HTTP client cannot be nil")
+		}
+		cfg.httpClient = hc
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout for each HTTP request attempt. If combined
+// with WithHTTPClient, the provided HTTP client is copied rather than
+// modified.
+func WithTimeout(d time.Duration) Option {
+	return func(cfg *clientConfig) error {
+		if d < 0 {
+			return fmt.Errorf("timeout cannot be negative: %s", d)
+		}
+		cfg.timeout = d
+		return nil
+	}
+}
+
+// WithLogger sets the logger used to log requests and responses (see
+// Client.Logger).
+func WithLogger(l *slog.Logger) Option {
+	return func(cfg *clientConfig) error {
+		cfg.logger = l
+		return nil
+	}
+}
+
+// WithDebugMode enables or disables debug logging (see Client.DebugMode).
+// Without this option debug mode is read from FASTLY_DEBUG_MODE.
+func WithDebugMode(enabled bool) Option {
+	return func(cfg *clientConfig) error {
+		cfg.debugMode = &enabled
+		return nil
+	}
+}
+
+// WithDryRun enables dry-run mode, recording the client's mutating requests
+// in plan instead of sending them (see Client.DryRun).
+func WithDryRun(plan *Plan) Option {
+	return func(cfg *clientConfig) error {
+		if plan == nil {
+			return errors.New("plan cannot be nil")
+		}
+		cfg.dryRun = plan
+		return nil
+	}
+}
+
+// WithHARRecorder records every request and response in the HTTP Archive
+// log written by r (see Client.HAR). The caller must close r when the
+// client is no longer used.
+func WithHARRecorder(r *HARRecorder) Option {
+	return func(cfg *clientConfig) error {
+		if r == nil {
+			return errors.New("HAR recorder cannot be nil")
+		}
+		cfg.har = r
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(cfg *clientConfig) error {
+		cfg.retryPolicy = p
+		return nil
+	}
+}
+
+// WithResourceLockManager sets the ResourceLockManager used to serialize
+// mutating requests against the same resource (see NewContextForResourceID).
+// By default all clients in a process share a single manager.
+func WithResourceLockManager(m *ResourceLockManager) Option {
+	return func(cfg *clientConfig) error {
+		if m == nil {
+			return errors.New("resource lock manager cannot be nil")
+		}
+		cfg.locks = m
+		return nil
+	}
+}
+
+// WithEnvironment controls whether the FASTLY_API_KEY, FASTLY_API_URL,
+// FASTLY_RTS_URL, FASTLY_DEBUG_MODE and FASTLY_USER_AGENT environment
+// variables are consulted for settings not given explicitly. It defaults to
+// true.
+func WithEnvironment(enabled bool) Option {
+	return func(cfg *clientConfig) error {
+		cfg.readEnv = enabled
+		return nil
+	}
+}
+
+// New creates a new API client configured by the given options.
+//
+// Unlike NewClient and NewClientForEndpoint, New does not modify any package
+// level state, so clients with different settings can coexist in one
+// process. Because Fastly allows some requests without an API key, New will
+// not error if no API key is configured.
+func New(opts ...Option) (*Client, error) {
+	return newClient(DefaultEndpoint, EndpointEnvVar, opts)
+}
+
+// NewRealtimeStats creates a new realtime stats API client configured by the
+// given options. The endpoint defaults to FASTLY_RTS_URL or
+// DefaultRealtimeStatsEndpoint.
+func NewRealtimeStats(opts ...Option) (*RTSClient, error) {
+	c, err := newClient(DefaultRealtimeStatsEndpoint, RealtimeStatsEndpointEnvVar, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &RTSClient{client: c}, nil
+}
+
+func newClient(defaultEndpoint, endpointEnvVar string, opts []Option) (*Client, error) {
+	cfg := &clientConfig{
+		defaultEndpoint: defaultEndpoint,
+		endpointEnvVar:  endpointEnvVar,
+		readEnv:         true,
+	}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.readEnv {
+		if cfg.apiKey == nil {
+			if key, ok := os.LookupEnv(APIKeyEnvVar); ok {
+				cfg.apiKey = &key
+			}
+		}
+		if cfg.endpoint == nil {
+			if endpoint, ok := os.LookupEnv(cfg.endpointEnvVar); ok {
+				cfg.endpoint = &endpoint
+			}
+		}
+		if cfg.debugMode == nil {
+			if v, ok := os.LookupEnv(DebugEnvVar); ok {
+				debug := v == "true"
+				cfg.debugMode = &debug
+			}
+		}
+		if customUserAgent, ok := os.LookupEnv(UserAgentEnvVar); ok {
+			if cfg.userAgent != "" {
+				cfg.userAgent = fmt.Sprintf("%s, %s", cfg.userAgent, customUserAgent)
+			} else {
+				cfg.userAgent = customUserAgent
+			}
+		}
+	}
+
+	client := &Client{
+		Address:     cfg.defaultEndpoint,
+		DryRun:      cfg.dryRun,
+		HAR:         cfg.har,
+		HTTPClient:  cfg.httpClient,
+		Logger:      cfg.logger,
+		RetryPolicy: cfg.retryPolicy,
+		userAgent:   UserAgent,
+	}
+	if cfg.apiKey != nil {
+		client.apiKey = *cfg.apiKey
+	}
+	if cfg.endpoint != nil {
+		client.Address = *cfg.endpoint
+	}
+	if cfg.debugMode != nil {
+		client.DebugMode = *cfg.debugMode
+	}
+	if cfg.locks != nil {
+		client.resourceLocks = cfg.locks
+	}
+	if cfg.tokenSource != nil {
+		client.TokenSource = cfg.tokenSource
+		client.RefreshOnUnauthorized = true
+	}
+	if cfg.userAgent != "" {
+		client.userAgent = fmt.Sprintf("%s, %s", cfg.userAgent, UserAgent)
+	}
+
+	if cfg.timeout > 0 {
+		var hc http.Client
+		if cfg.httpClient != nil {
+			hc = *cfg.httpClient
+		} else {
+			// IMPORTANT: Avoid cleanhttp.DefaultTransport() which disables keepalive.
+			hc.Transport = cleanhttp.DefaultPooledTransport()
+		}
+		hc.Timeout = cfg.timeout
+		client.HTTPClient = &hc
+	}
+
+	return client.init()
+}
@@ -0,0 +1,105 @@
+package fastly
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type headerRoundTripper struct {
+	header http.Header
+	host   string
+}
+
+func (h *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	h.header = req.Header.Clone()
+	h.host = req.URL.Host
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+}
+
+func TestNew(t *testing.T) {
+	t.Setenv(APIKeyEnvVar, "env-key")
+	t.Setenv(EndpointEnvVar, "https://env.example.com")
+	t.Setenv(UserAgentEnvVar, "env-agent")
+	t.Setenv(DebugEnvVar, "true")
+
+	globalUserAgent := UserAgent
+
+	// Environment variables are read by default.
+	rt := &headerRoundTripper{}
+	c, err := New(WithHTTPClient(&http.Client{Transport: rt}))
+	require.NoError(t, err)
+	require.True(t, c.DebugMode)
+	_, err = c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, "env-key", rt.header.Get(APIKeyHeader))
+	require.Equal(t, "env.example.com", rt.host)
+	require.Equal(t, "env-agent, "+globalUserAgent, rt.header.Get("User-Agent"))
+
+	// Explicit options take precedence, and the environment can be ignored.
+	rt = &headerRoundTripper{}
+	logger := slog.New(slog.DiscardHandler)
+	c, err = New(
+		WithEnvironment(false),
+		WithAPIKey("opt-key"),
+		WithEndpoint("https://opt.example.com"),
+		WithUserAgent("my-tool/1.0"),
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithLogger(logger),
+		WithRetryPolicy(DefaultRetryPolicy()),
+	)
+	require.NoError(t, err)
+	require.False(t, c.DebugMode)
+	require.Same(t, logger, c.Logger)
+	require.NotNil(t, c.RetryPolicy)
+	_, err = c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, "opt-key", rt.header.Get(APIKeyHeader))
+	require.Equal(t, "opt.example.com", rt.host)
+	require.Equal(t, "my-tool/1.0, "+globalUserAgent, rt.header.Get("User-Agent"))
+
+	// Creating clients never modifies the package level user agent.
+	_, err = NewClientForEndpoint("key", DefaultEndpoint)
+	require.NoError(t, err)
+	_, err = NewClientForEndpoint("key", DefaultEndpoint)
+	require.NoError(t, err)
+	require.Equal(t, globalUserAgent, UserAgent)
+
+	// The realtime stats client uses its own endpoint variable.
+	t.Setenv(RealtimeStatsEndpointEnvVar, "https://rts.example.com")
+	rts, err := NewRealtimeStats()
+	require.NoError(t, err)
+	require.Equal(t, "https://rts.example.com", rts.client.Address)
+}
+
+func TestNew_Options(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(WithEnvironment(false))
+	require.NoError(t, err)
+	require.Equal(t, DefaultEndpoint, c.Address)
+	require.Empty(t, c.apiKey)
+	require.Equal(t, UserAgent, c.UserAgent())
+
+	hc := &http.Client{}
+	c, err = New(WithEnvironment(false), WithHTTPClient(hc), WithTimeout(5*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, c.HTTPClient.Timeout)
+	require.Zero(t, hc.Timeout, "the provided HTTP client must not be modified")
+
+	m := NewResourceLockManager()
+	c, err = New(WithEnvironment(false), WithResourceLockManager(m))
+	require.NoError(t, err)
+	require.Same(t, m, c.lockManager())
+
+	_, err = New(WithTimeout(-time.Second))
+	require.Error(t, err)
+	_, err = New(WithHTTPClient(nil))
+	require.Error(t, err)
+	_, err = New(WithEndpoint("://bad"))
+	require.Error(t, err)
+}
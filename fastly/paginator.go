@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -80,6 +81,22 @@ func (p *ListPaginator[T]) Remaining() int {
 
 // GetNext retrieves data in the next page.
 func (p *ListPaginator[T]) GetNext() ([]*T, error) {
+	return p.getNext(p.ctx)
+}
+
+// All returns an iterator over the items of all remaining pages, using ctx
+// for each request (see Paginate).
+func (p *ListPaginator[T]) All(ctx context.Context) iter.Seq2[*T, error] {
+	return Paginate(ctx, func(ctx context.Context) ([]*T, bool, error) {
+		if !p.HasNext() {
+			return nil, false, nil
+		}
+		page, err := p.getNext(ctx)
+		return page, p.HasNext(), err
+	})
+}
+
+func (p *ListPaginator[T]) getNext(ctx context.Context) ([]*T, error) {
 	var perPage int
 	const maxPerPage = 100
 	if p.opts.PerPage <= 0 {
@@ -111,7 +128,7 @@ func (p *ListPaginator[T]) GetNext() ([]*T, error) {
 		requestOptions.Params["sort"] = p.opts.Sort
 	}
 
-	resp, err := p.client.Get(p.ctx, p.path, requestOptions)
+	resp, err := p.client.Get(ctx, p.path, requestOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,188 @@
+// Code generated by internal/cmd/pathsegments; DO NOT EDIT.
+
+package fastly
+
+// knownPathSegments is the set of literal path segments used by API
+// operations. Any other segment is treated as a path parameter.
+var knownPathSegments = map[string]struct{}{
+	"access-keys":                      {},
+	"acl":                              {},
+	"acls":                             {},
+	"activate":                         {},
+	"activations":                      {},
+	"aggregate":                        {},
+	"ai-runtime-control":               {},
+	"alerts":                           {},
+	"api-security":                     {},
+	"automation-tokens":                {},
+	"azureblob":                        {},
+	"backend":                          {},
+	"batch":                            {},
+	"bigquery":                         {},
+	"billing":                          {},
+	"bulk":                             {},
+	"cache_settings":                   {},
+	"certificates":                     {},
+	"channel":                          {},
+	"check":                            {},
+	"check_all":                        {},
+	"client-key":                       {},
+	"clone":                            {},
+	"cloudfiles":                       {},
+	"condition":                        {},
+	"config":                           {},
+	"configurations":                   {},
+	"content":                          {},
+	"current_user":                     {},
+	"customer":                         {},
+	"dashboards":                       {},
+	"datacenters":                      {},
+	"datadog":                          {},
+	"deactivate":                       {},
+	"definitions":                      {},
+	"details":                          {},
+	"dev":                              {},
+	"dictionary":                       {},
+	"diff":                             {},
+	"digitalocean":                     {},
+	"director":                         {},
+	"discovered-operations":            {},
+	"discovered-operations-bulk":       {},
+	"dns":                              {},
+	"domain":                           {},
+	"domain-management":                {},
+	"domains":                          {},
+	"edge_check":                       {},
+	"elasticsearch":                    {},
+	"entries":                          {},
+	"entry":                            {},
+	"errors":                           {},
+	"event-mappings":                   {},
+	"event-types":                      {},
+	"events":                           {},
+	"export":                           {},
+	"field":                            {},
+	"from":                             {},
+	"ftp":                              {},
+	"gcs":                              {},
+	"generated_vcl":                    {},
+	"grafanacloudlogs":                 {},
+	"gzip":                             {},
+	"header":                           {},
+	"healthcheck":                      {},
+	"heroku":                           {},
+	"history":                          {},
+	"honeycomb":                        {},
+	"http3":                            {},
+	"https":                            {},
+	"image_optimizer_default_settings": {},
+	"info":                             {},
+	"instance_output":                  {},
+	"integration-types":                {},
+	"integrations":                     {},
+	"item":                             {},
+	"items":                            {},
+	"kafka":                            {},
+	"keys":                             {},
+	"kinesis":                          {},
+	"kv":                               {},
+	"limit":                            {},
+	"lock":                             {},
+	"log-explorer":                     {},
+	"log-insights":                     {},
+	"log_stream":                       {},
+	"logentries":                       {},
+	"logging":                          {},
+	"loggly":                           {},
+	"logshuttle":                       {},
+	"mailinglist-confirmations":        {},
+	"main":                             {},
+	"managed":                          {},
+	"metrics":                          {},
+	"models":                           {},
+	"month":                            {},
+	"mutual_authentications":           {},
+	"newrelic":                         {},
+	"newrelicotlp":                     {},
+	"ngwaf":                            {},
+	"notifications":                    {},
+	"null":                             {},
+	"object-storage":                   {},
+	"observability":                    {},
+	"openstack":                        {},
+	"operations":                       {},
+	"operations-bulk":                  {},
+	"operations-bulk-tags":             {},
+	"origins":                          {},
+	"package":                          {},
+	"papertrail":                       {},
+	"password":                         {},
+	"pool":                             {},
+	"private_keys":                     {},
+	"provider-connections":             {},
+	"providers":                        {},
+	"public-ip-list":                   {},
+	"pubsub":                           {},
+	"purge":                            {},
+	"purge_all":                        {},
+	"rate-limiters":                    {},
+	"redactions":                       {},
+	"request_reset":                    {},
+	"request_settings":                 {},
+	"requests":                         {},
+	"resource":                         {},
+	"resources":                        {},
+	"response_object":                  {},
+	"rotate":                           {},
+	"rotateSigningKey":                 {},
+	"s3":                               {},
+	"scalyr":                           {},
+	"scope-types":                      {},
+	"search":                           {},
+	"secret":                           {},
+	"secrets":                          {},
+	"self":                             {},
+	"server":                           {},
+	"servers":                          {},
+	"service":                          {},
+	"service-authorizations":           {},
+	"services":                         {},
+	"sessions":                         {},
+	"settings":                         {},
+	"sftp":                             {},
+	"signing-key":                      {},
+	"signingKey":                       {},
+	"snippet":                          {},
+	"splunk":                           {},
+	"stats":                            {},
+	"status":                           {},
+	"stores":                           {},
+	"subscriptions":                    {},
+	"sudo":                             {},
+	"suggest":                          {},
+	"sumologic":                        {},
+	"syslog":                           {},
+	"tags":                             {},
+	"test":                             {},
+	"thresholds":                       {},
+	"timeseries":                       {},
+	"tls":                              {},
+	"to":                               {},
+	"tokens":                           {},
+	"tools":                            {},
+	"ts":                               {},
+	"tsig-keys":                        {},
+	"usage":                            {},
+	"usage-metrics":                    {},
+	"usage_by_service":                 {},
+	"user":                             {},
+	"users":                            {},
+	"v1":                               {},
+	"validate":                         {},
+	"vcl":                              {},
+	"version":                          {},
+	"virtual-patches":                  {},
+	"workspaces":                       {},
+	"year":                             {},
+	"zones":                            {},
+}
@@ -0,0 +1,242 @@
+package fastly
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/google/jsonapi"
+)
+
+// PlannedRequest is a mutating request captured by a [Plan] instead of being
+// sent to the API.
+type PlannedRequest struct {
+	// Operation is the name of the API operation that issued the request
+	// (see [RequestInfo]).
+	Operation string `json:"operation,omitempty"`
+	// Verb is the HTTP method of the request.
+	Verb string `json:"verb"`
+	// Path is the request path, relative to the client's Address.
+	Path string `json:"path"`
+	// Query holds the query parameters of the request, if any.
+	Query url.Values `json:"query,omitempty"`
+	// ResourceID is the resource ID set with [NewContextForResourceID], or
+	// an empty string if none was set.
+	ResourceID string `json:"resource_id,omitempty"`
+	// Body is the decoded request body, masked by the client's Redactor.
+	// JSON bodies are decoded into the corresponding Go values, form bodies
+	// into a map of field names to values, and other text bodies are kept
+	// as the string returned by the Redactor. Binary bodies, such as package
+	// uploads, are replaced by a description of their size, and bodies
+	// larger than 64KiB by a placeholder. It is nil if the request has no
+	// body.
+	Body any `json:"body,omitempty"`
+}
+
+// Plan is an ordered record of the mutating requests a client in dry-run
+// mode would have sent (see [WithDryRun]).
+//
+// The zero value is an empty plan ready to use. This structure is safe to use
+// from concurrent goroutines.
+type Plan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns a copy of the requests recorded so far, in the order they
+// were made.
+func (p *Plan) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+// Len returns the number of requests recorded so far.
+func (p *Plan) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.requests)
+}
+
+// Reset discards the recorded requests.
+func (p *Plan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = nil
+}
+
+// String returns a human-readable summary of the plan, listing each request
+// with its body indented below it.
+func (p *Plan) String() string {
+	requests := p.Requests()
+	if len(requests) == 0 {
+		return "No changes.\n"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d planned request(s):\n", len(requests))
+	for i, r := range requests {
+		target := r.Path
+		if len(r.Query) > 0 {
+			target += "?" + r.Query.Encode()
+		}
+		fmt.Fprintf(&b, "\n%d. %s %s\n", i+1, r.Verb, target)
+		if r.Operation != "" {
+			fmt.Fprintf(&b, "   operation: %s\n", r.Operation)
+		}
+		if r.ResourceID != "" {
+			fmt.Fprintf(&b, "   resource: %s\n", r.ResourceID)
+		}
+		if r.Body != nil {
+			body, err := json.MarshalIndent(r.Body, "   ", "  ")
+			if err != nil {
+				body = fmt.Appendf(nil, "%v", r.Body)
+			}
+			fmt.Fprintf(&b, "   body: %s\n", body)
+		}
+	}
+	return b.String()
+}
+
+// MarshalJSON encodes the plan as a JSON array of its requests.
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	requests := p.Requests()
+	if requests == nil {
+		requests = []PlannedRequest{}
+	}
+	return json.Marshal(requests)
+}
+
+func (p *Plan) add(r PlannedRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, r)
+}
+
+// dryRun wraps h so that GET and HEAD requests are passed through, and every
+// other request is recorded in the plan, with its body masked by r, and
+// answered with a synthesized success response.
+func (p *Plan) dryRun(h RequestHandler, r Redactor) RequestHandler {
+	return func(ctx context.Context, info *RequestInfo) (*http.Response, error) {
+		if info.Verb == http.MethodGet || info.Verb == http.MethodHead {
+			return h(ctx, info)
+		}
+
+		var (
+			body []byte
+			size int64
+		)
+		if info.Request.Body != nil {
+			var err error
+			body, size, err = readPlannedBody(info.Request.Body)
+			_ = info.Request.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		contentType := info.Request.Header.Get("Content-Type")
+		planned := PlannedRequest{
+			Operation:  info.Operation,
+			Verb:       info.Verb,
+			Path:       info.Path,
+			ResourceID: info.ResourceID,
+		}
+		if size > maxLoggedBodySize {
+			planned.Body = string(omittedBody(int(size)))
+		} else {
+			planned.Body = decodePlannedBody(r, body, contentType)
+		}
+		if q := info.Request.URL.Query(); len(q) > 0 {
+			planned.Query = q
+		}
+		p.add(planned)
+
+		return plannedResponse(info.Request, body, contentType), nil
+	}
+}
+
+// readPlannedBody reads at most maxLoggedBodySize bytes of body. Larger
+// bodies are drained without being kept, and only their size is returned.
+func readPlannedBody(body io.Reader) ([]byte, int64, error) {
+	b, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(b) <= maxLoggedBodySize {
+		return b, int64(len(b)), nil
+	}
+	n, err := io.Copy(io.Discard, body)
+	return nil, int64(len(b)) + n, err
+}
+
+// decodePlannedBody masks a request body with r and decodes it for a
+// PlannedRequest.
+func decodePlannedBody(r Redactor, body []byte, contentType string) any {
+	if len(body) == 0 {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if strings.HasPrefix(mediaType, "multipart/") || !utf8.Valid(body) {
+		return fmt.Sprintf("(%d bytes of %s)", len(body), cmp.Or(mediaType, "binary data"))
+	}
+	body = r.RedactBody(contentType, body)
+
+	switch mediaType {
+	case JSONMimeType, jsonapi.MediaType:
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err == nil {
+			return v
+		}
+	case "application/x-www-form-urlencoded":
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			fields := make(map[string]any, len(form))
+			for k, vs := range form {
+				if len(vs) == 1 {
+					fields[k] = vs[0]
+				} else {
+					fields[k] = vs
+				}
+			}
+			return fields
+		}
+	}
+
+	return string(body)
+}
+
+// plannedResponse returns the synthesized response to a planned request.
+// JSON:API requests are answered with their own payload, so the caller can
+// decode it as the resource it expected; other requests are answered with
+// the {"status":"ok"} body returned by most mutating endpoints. Either way,
+// the response carries no values assigned by the server, such as IDs.
+func plannedResponse(req *http.Request, body []byte, contentType string) *http.Response {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != jsonapi.MediaType || len(body) == 0 {
+		contentType = JSONMimeType
+		body = []byte(`{"status":"ok"}`)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,162 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDryRun(t *testing.T) {
+	t.Parallel()
+
+	var sent []string
+	rt := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent = append(sent, req.Method+" "+req.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{JSONMimeType}},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"svc123","name":"example"}`)),
+		}, nil
+	})
+
+	plan := &Plan{}
+	c, err := New(WithEnvironment(false), WithHTTPClient(&http.Client{Transport: rt}), WithDryRun(plan))
+	require.NoError(t, err)
+	require.Same(t, plan, c.DryRun)
+	require.Equal(t, "No changes.\n", plan.String())
+
+	ctx := context.TODO()
+	svc, err := c.GetService(ctx, &GetServiceInput{ServiceID: "svc123"})
+	require.NoError(t, err)
+	require.Equal(t, "example", *svc.Name)
+
+	// Form
+	b, err := c.CreateBackend(NewContextForResourceID(ctx, "svc123"), &CreateBackendInput{
+		ServiceID:      "svc123",
+		ServiceVersion: 2,
+		Name:           ToPointer("origin"),
+		Address:        ToPointer("example.com"),
+		Port:           ToPointer(443),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, b)
+
+	// JSON
+	err = c.BatchModifyDictionaryItems(ctx, &BatchModifyDictionaryItemsInput{
+		ServiceID:    "svc123",
+		DictionaryID: "dict456",
+		Items: []*BatchDictionaryItem{
+			{Operation: ToPointer(CreateBatchOperation), ItemKey: ToPointer("k"), ItemValue: ToPointer("v")},
+		},
+	})
+	require.NoError(t, err)
+
+	// JSON:API is answered with the request payload.
+	cert, err := c.CreateCustomTLSCertificate(ctx, &CreateCustomTLSCertificateInput{CertBlob: "PEM", Name: "cert"})
+	require.NoError(t, err)
+	require.Equal(t, "cert", cert.Name)
+
+	// Raw
+	err = c.InsertKVStoreKey(ctx, &InsertKVStoreKeyInput{StoreID: "store789", Key: "greeting", Value: "hello", Add: true})
+	require.NoError(t, err)
+
+	err = c.DeleteBackend(ctx, &DeleteBackendInput{ServiceID: "svc123", ServiceVersion: 2, Name: "origin"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"GET /service/svc123"}, sent)
+
+	requests := plan.Requests()
+	require.Len(t, requests, 5)
+	require.Equal(t, PlannedRequest{
+		Operation:  "CreateBackend",
+		Verb:       http.MethodPost,
+		Path:       "/service/svc123/version/2/backend",
+		ResourceID: "svc123",
+		Body:       map[string]any{"name": "origin", "address": "example.com", "port": "443"},
+	}, requests[0])
+
+	require.Equal(t, "BatchModifyDictionaryItems", requests[1].Operation)
+	require.Equal(t, http.MethodPatch, requests[1].Verb)
+	require.Equal(t, map[string]any{
+		"items": []any{map[string]any{"op": "create", "item_key": "k", "item_value": "v"}},
+	}, requests[1].Body)
+
+	require.Equal(t, "/tls/certificates", requests[2].Path)
+	require.Equal(t, "tls_certificate", requests[2].Body.(map[string]any)["data"].(map[string]any)["type"])
+
+	require.Equal(t, http.MethodPut, requests[3].Verb)
+	require.Equal(t, "/resources/stores/kv/store789/keys/greeting", requests[3].Path)
+	require.Equal(t, "true", requests[3].Query.Get("add"))
+	// Raw bodies are omitted by the default Redactor, as they are in logs.
+	require.Equal(t, "[5 bytes omitted]", requests[3].Body)
+
+	require.Equal(t, http.MethodDelete, requests[4].Verb)
+	require.Nil(t, requests[4].Body)
+
+	summary := plan.String()
+	require.True(t, strings.HasPrefix(summary, "5 planned request(s):\n"))
+	require.Contains(t, summary, "\n1. POST /service/svc123/version/2/backend\n   operation: CreateBackend\n   resource: svc123\n   body: {\n")
+	require.Contains(t, summary, "\n4. PUT /resources/stores/kv/store789/keys/greeting?add=true\n")
+	require.Contains(t, summary, "\n5. DELETE /service/svc123/version/2/backend/origin\n")
+
+	out, err := json.Marshal(plan)
+	require.NoError(t, err)
+	var decoded []PlannedRequest
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	require.Len(t, decoded, 5)
+	require.Equal(t, "/service/svc123/version/2/backend", decoded[0].Path)
+
+	plan.Reset()
+	require.Equal(t, 0, plan.Len())
+	out, err = json.Marshal(plan)
+	require.NoError(t, err)
+	require.JSONEq(t, `[]`, string(out))
+}
+
+func TestWithDryRun_redaction(t *testing.T) {
+	t.Parallel()
+
+	plan := &Plan{}
+	c, err := New(WithEnvironment(false), WithDryRun(plan))
+	require.NoError(t, err)
+
+	ctx := context.TODO()
+	_, err = c.CreateS3(ctx, &CreateS3Input{
+		ServiceID:      "svc123",
+		ServiceVersion: 2,
+		Name:           ToPointer("logs"),
+		AccessKey:      ToPointer("AKIAEXAMPLE"),
+		SecretKey:      ToPointer("s3-secret-value"),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateSecret(ctx, &CreateSecretInput{StoreID: "store789", Name: "db", Secret: []byte("json-secret-value")})
+	require.NoError(t, err)
+
+	requests := plan.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, RedactedValue, requests[0].Body.(map[string]any)["secret_key"])
+	require.Equal(t, "logs", requests[0].Body.(map[string]any)["name"])
+	require.Equal(t, RedactedValue, requests[1].Body.(map[string]any)["secret"])
+
+	out, err := json.Marshal(plan)
+	require.NoError(t, err)
+	for _, s := range []string{plan.String(), string(out)} {
+		require.Contains(t, s, RedactedValue)
+		require.NotContains(t, s, "s3-secret-value")
+		require.NotContains(t, s, "AKIAEXAMPLE")
+		require.NotContains(t, s, "json-secret-value")
+		require.NotContains(t, s, "anNvbi1zZWNyZXQtdmFsdWU") // base64 of the secret
+	}
+
+	// Large bodies are not buffered.
+	plan.Reset()
+	err = c.InsertKVStoreKey(ctx, &InsertKVStoreKeyInput{StoreID: "store789", Key: "big", Value: strings.Repeat("x", maxLoggedBodySize+1)})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("[%d bytes omitted]", maxLoggedBodySize+1), plan.Requests()[0].Body)
+}
@@ -19,7 +19,7 @@ const (
 type EnableOutput = products.EnableOutput
 
 // Get gets the status of the API Discovery product on the service.
-func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput, error) {
+func Get(ctx context.Context, c fastly.Requester, serviceID string) (EnableOutput, error) {
 	return productcore.Get[EnableOutput](ctx, &productcore.GetInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -28,7 +28,7 @@ func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput,
 }
 
 // Enable enables the API Discovery product on the service.
-func Enable(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput, error) {
+func Enable(ctx context.Context, c fastly.Requester, serviceID string) (EnableOutput, error) {
 	return productcore.Put[EnableOutput](ctx, &productcore.PutInput[products.NullInput]{
 		Client:    c,
 		ProductID: ProductID,
@@ -37,7 +37,7 @@ func Enable(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutp
 }
 
 // Disable disables the API Discovery product on the service.
-func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
+func Disable(ctx context.Context, c fastly.Requester, serviceID string) error {
 	return productcore.Delete(ctx, &productcore.DeleteInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -41,7 +41,7 @@ type configureOutputNested struct {
 }
 
 // Get gets the status of the Bot Management product on the service.
-func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput, error) {
+func Get(ctx context.Context, c fastly.Requester, serviceID string) (EnableOutput, error) {
 	return productcore.Get[EnableOutput](ctx, &productcore.GetInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -50,7 +50,7 @@ func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput,
 }
 
 // Enable enables the Bot Management product on the service.
-func Enable(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput, error) {
+func Enable(ctx context.Context, c fastly.Requester, serviceID string) (EnableOutput, error) {
 	return productcore.Put[EnableOutput](ctx, &productcore.PutInput[products.NullInput]{
 		Client:    c,
 		ProductID: ProductID,
@@ -59,7 +59,7 @@ func Enable(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutp
 }
 
 // Disable disables the Bot Management product on the service.
-func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
+func Disable(ctx context.Context, c fastly.Requester, serviceID string) error {
 	return productcore.Delete(ctx, &productcore.DeleteInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -68,7 +68,7 @@ func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
 }
 
 // GetConfiguration gets the configuration of the Bot Management product on the service.
-func GetConfiguration(ctx context.Context, c *fastly.Client, serviceID string) (ConfigureOutput, error) {
+func GetConfiguration(ctx context.Context, c fastly.Requester, serviceID string) (ConfigureOutput, error) {
 	return productcore.Get[ConfigureOutput](ctx, &productcore.GetInput{
 		Client:        c,
 		ProductID:     ProductID,
@@ -78,7 +78,7 @@ func GetConfiguration(ctx context.Context, c *fastly.Client, serviceID string) (
 }
 
 // UpdateConfiguration updates the configuration of the Bot Management product on the service.
-func UpdateConfiguration(ctx context.Context, c *fastly.Client, serviceID string, i ConfigureInput) (ConfigureOutput, error) {
+func UpdateConfiguration(ctx context.Context, c fastly.Requester, serviceID string, i ConfigureInput) (ConfigureOutput, error) {
 	if i.ContentGuard == "" {
 		return ConfigureOutput{}, ErrMissingContentGuard
 	}
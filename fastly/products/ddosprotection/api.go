@@ -47,7 +47,7 @@ type configureOutputNested struct {
 }
 
 // Get gets the status of the DDoS Protection product on the service.
-func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput, error) {
+func Get(ctx context.Context, c fastly.Requester, serviceID string) (EnableOutput, error) {
 	return productcore.Get[EnableOutput](ctx, &productcore.GetInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -56,7 +56,7 @@ func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput,
 }
 
 // Enable enables the DDoS Protection product on the service.
-func Enable(ctx context.Context, c *fastly.Client, serviceID string, i EnableInput) (EnableOutput, error) {
+func Enable(ctx context.Context, c fastly.Requester, serviceID string, i EnableInput) (EnableOutput, error) {
 	return productcore.Put[EnableOutput](ctx, &productcore.PutInput[EnableInput]{
 		Client:    c,
 		ProductID: ProductID,
@@ -66,7 +66,7 @@ func Enable(ctx context.Context, c *fastly.Client, serviceID string, i EnableInp
 }
 
 // Disable disables the DDoS Protection product on the service.
-func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
+func Disable(ctx context.Context, c fastly.Requester, serviceID string) error {
 	return productcore.Delete(ctx, &productcore.DeleteInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -75,7 +75,7 @@ func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
 }
 
 // GetConfiguration gets the configuration of the DDoS Protection product on the service.
-func GetConfiguration(ctx context.Context, c *fastly.Client, serviceID string) (ConfigureOutput, error) {
+func GetConfiguration(ctx context.Context, c fastly.Requester, serviceID string) (ConfigureOutput, error) {
 	return productcore.Get[ConfigureOutput](ctx, &productcore.GetInput{
 		Client:        c,
 		ProductID:     ProductID,
@@ -85,7 +85,7 @@ func GetConfiguration(ctx context.Context, c *fastly.Client, serviceID string) (
 }
 
 // UpdateConfiguration updates the configuration of the DDoS Protection product on the service.
-func UpdateConfiguration(ctx context.Context, c *fastly.Client, serviceID string, i ConfigureInput) (ConfigureOutput, error) {
+func UpdateConfiguration(ctx context.Context, c fastly.Requester, serviceID string, i ConfigureInput) (ConfigureOutput, error) {
 	if i.Mode == "" {
 		return ConfigureOutput{}, ErrMissingMode
 	}
@@ -49,7 +49,7 @@ type configureOutputNested struct {
 }
 
 // Get gets the status of the Next-Gen WAF product on the service.
-func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput, error) {
+func Get(ctx context.Context, c fastly.Requester, serviceID string) (EnableOutput, error) {
 	return productcore.Get[EnableOutput](ctx, &productcore.GetInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -58,7 +58,7 @@ func Get(ctx context.Context, c *fastly.Client, serviceID string) (EnableOutput,
 }
 
 // Enable enables the Next-Gen WAF product on the service.
-func Enable(ctx context.Context, c *fastly.Client, serviceID string, i EnableInput) (EnableOutput, error) {
+func Enable(ctx context.Context, c fastly.Requester, serviceID string, i EnableInput) (EnableOutput, error) {
 	if i.WorkspaceID == "" {
 		return EnableOutput{}, ErrMissingWorkspaceID
 	}
@@ -72,7 +72,7 @@ func Enable(ctx context.Context, c *fastly.Client, serviceID string, i EnableInp
 }
 
 // Disable disables the Next-Gen WAF product on the service.
-func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
+func Disable(ctx context.Context, c fastly.Requester, serviceID string) error {
 	return productcore.Delete(ctx, &productcore.DeleteInput{
 		Client:    c,
 		ProductID: ProductID,
@@ -81,7 +81,7 @@ func Disable(ctx context.Context, c *fastly.Client, serviceID string) error {
 }
 
 // GetConfiguration gets the configuration of the Next-Gen WAF product on the service.
-func GetConfiguration(ctx context.Context, c *fastly.Client, serviceID string) (ConfigureOutput, error) {
+func GetConfiguration(ctx context.Context, c fastly.Requester, serviceID string) (ConfigureOutput, error) {
 	return productcore.Get[ConfigureOutput](ctx, &productcore.GetInput{
 		Client:        c,
 		ProductID:     ProductID,
@@ -91,7 +91,7 @@ func GetConfiguration(ctx context.Context, c *fastly.Client, serviceID string) (
 }
 
 // UpdateConfiguration updates the configuration of the Next-Gen WAF product on the service.
-func UpdateConfiguration(ctx context.Context, c *fastly.Client, serviceID string, i ConfigureInput) (ConfigureOutput, error) {
+func UpdateConfiguration(ctx context.Context, c fastly.Requester, serviceID string, i ConfigureInput) (ConfigureOutput, error) {
 	return productcore.Patch[ConfigureOutput](ctx, &productcore.PatchInput[ConfigureInput]{
 		Client:        c,
 		ProductID:     ProductID,
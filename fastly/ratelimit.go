@@ -0,0 +1,38 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// updateRateLimit records the Fastly-RateLimit-Remaining and
+// Fastly-RateLimit-Reset headers from resp, if present.
+//
+// This method is safe to use from concurrent goroutines.
+func (c *Client) updateRateLimit(resp *http.Response) {
+	if remaining := resp.Header.Get("Fastly-RateLimit-Remaining"); remaining != "" {
+		if val, err := strconv.ParseInt(remaining, 10, 64); err == nil {
+			c.remaining.Store(val)
+		}
+	}
+	if reset := resp.Header.Get("Fastly-RateLimit-Reset"); reset != "" {
+		if val, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			c.reset.Store(val)
+		}
+	}
+}
+
+// waitForRateLimit blocks while the remaining rate limit budget is below
+// WriteThrottleThreshold and the rate limit window has not yet reset. It
+// returns early with the context's error if ctx is done first.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.WriteThrottleThreshold <= 0 {
+		return nil
+	}
+	if c.remaining.Load() >= int64(c.WriteThrottleThreshold) {
+		return nil
+	}
+	return sleepContext(ctx, time.Until(time.Unix(c.reset.Load(), 0)))
+}
@@ -0,0 +1,86 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RateLimitTracking(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Unix()
+	rt := &sequenceRoundTripper{
+		statuses: []int{http.StatusOK, http.StatusOK},
+		headers: []http.Header{
+			{
+				"Fastly-Ratelimit-Remaining": []string{"42"},
+				"Fastly-Ratelimit-Reset":     []string{strconv.FormatInt(reset, 10)},
+			},
+			{
+				"Fastly-Ratelimit-Remaining": []string{"1"},
+			},
+		},
+	}
+	c := newRetryTestClient(t, rt, nil)
+	require.Equal(t, 1000, c.RateLimitRemaining())
+
+	_, err := c.Put(context.TODO(), "/test", CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, 42, c.RateLimitRemaining())
+	require.Equal(t, reset, c.RateLimitReset().Unix())
+
+	// Read requests do not count against the write budget.
+	_, err = c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, 42, c.RateLimitRemaining())
+}
+
+func TestClient_WriteThrottle(t *testing.T) {
+	t.Parallel()
+
+	rt := &sequenceRoundTripper{statuses: []int{http.StatusOK, http.StatusOK}}
+	c := newRetryTestClient(t, rt, nil)
+	c.WriteThrottleThreshold = 10
+	c.remaining.Store(5)
+	c.reset.Store(time.Now().Add(time.Hour).Unix())
+
+	// Reads are never throttled.
+	_, err := c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.NoError(t, err)
+
+	// Writes wait for the reset, bounded by the context.
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Put(ctx, "/test", CreateRequestOptions())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, rt.bodies, 1)
+
+	// Once the window has reset, writes proceed.
+	c.reset.Store(time.Now().Add(-time.Second).Unix())
+	_, err = c.Put(context.TODO(), "/test", CreateRequestOptions())
+	require.NoError(t, err)
+	require.Len(t, rt.bodies, 2)
+}
+
+func TestClient_RateLimitConcurrentUpdates(t *testing.T) {
+	t.Parallel()
+
+	c := newRetryTestClient(t, http.DefaultTransport, nil)
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Go(func() {
+			c.updateRateLimit(&http.Response{Header: http.Header{
+				"Fastly-Ratelimit-Remaining": []string{strconv.Itoa(i)},
+			}})
+			_ = c.RateLimitRemaining()
+		})
+	}
+	wg.Wait()
+	require.Less(t, c.RateLimitRemaining(), 50)
+}
@@ -0,0 +1,25 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+)
+
+//go:generate go run ../internal/cmd/apigen -dir . -out api.go -mock fastlytest/mock_api.go
+
+// Requester is the set of request primitives used by the API subpackages,
+// such as computeacls and ngwaf/v1/rules, to call the Fastly API. It is
+// satisfied by *Client and by any API implementation, including
+// fastlytest.MockAPI, so the subpackages can be tested without an HTTP
+// server.
+type Requester interface {
+	Get(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	GetJSON(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	Post(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+	PostJSON(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PatchJSON(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	PutJSON(ctx context.Context, p string, i any, ro RequestOptions) (*http.Response, error)
+	Delete(ctx context.Context, p string, ro RequestOptions) (*http.Response, error)
+}
+
+var _ Requester = (*Client)(nil)
@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMeta describes the HTTP response to an API call. Register one with
+// WithResponseMeta to have it filled in by Client.Request.
+type ResponseMeta struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// RequestID is the value of the X-Request-Id or Fastly-Request-Id
+	// response header, useful when contacting Fastly support.
+	RequestID string
+	// RateLimitRemaining is the value of the Fastly-RateLimit-Remaining
+	// header. A `nil` value indicates the API returned no value.
+	RateLimitRemaining *int
+	// RateLimitReset is the value of the Fastly-RateLimit-Reset header, as a
+	// Unix timestamp. A `nil` value indicates the API returned no value.
+	RateLimitReset *int
+	// Date is the value of the Date header, or the zero time if it is absent
+	// or malformed.
+	Date time.Time
+	// Elapsed is the time taken by the call, including any retries.
+	Elapsed time.Duration
+	// Retries is the number of times the request was retried.
+	Retries int
+	// Header holds the response headers, e.g. for cache information such as
+	// Age or X-Cache.
+	Header http.Header
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a [context.Context] which causes every request
+// made with it to record metadata about its response in meta. Calls that
+// return an error still fill in meta if a response was received.
+//
+// If several requests are made with the returned context, e.g. by a
+// paginator, meta describes the last one. meta must not be shared by
+// concurrent calls.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+func responseMetaFromContext(ctx context.Context) (*ResponseMeta, bool) {
+	meta, ok := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	return meta, ok && meta != nil
+}
+
+// recordResponseMeta wraps h so that the response is recorded in meta.
+func recordResponseMeta(meta *ResponseMeta, h RequestHandler) RequestHandler {
+	return func(ctx context.Context, info *RequestInfo) (*http.Response, error) {
+		start := time.Now()
+		resp, err := h(ctx, info)
+
+		*meta = ResponseMeta{
+			Elapsed: time.Since(start),
+			Retries: info.Retries,
+		}
+		if resp != nil {
+			meta.StatusCode = resp.StatusCode
+			meta.RequestID = requestIDFromHeader(resp.Header)
+			meta.Header = resp.Header.Clone()
+			if v, err := strconv.Atoi(resp.Header.Get("Fastly-RateLimit-Remaining")); err == nil {
+				meta.RateLimitRemaining = &v
+			}
+			if v, err := strconv.Atoi(resp.Header.Get("Fastly-RateLimit-Reset")); err == nil {
+				meta.RateLimitReset = &v
+			}
+			if t, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+				meta.Date = t
+			}
+		}
+
+		return resp, err
+	}
+}
@@ -0,0 +1,74 @@
+package fastly
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithResponseMeta(t *testing.T) {
+	t.Parallel()
+
+	rt := &sequenceRoundTripper{
+		statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+		headers: []http.Header{
+			nil,
+			{
+				"Date":                       []string{"Tue, 15 Nov 1994 08:12:31 GMT"},
+				"Fastly-Ratelimit-Remaining": []string{"42"},
+				"Fastly-Ratelimit-Reset":     []string{"1700000000"},
+				"X-Cache":                    []string{"MISS"},
+				"X-Request-Id":               []string{"req-1"},
+			},
+		},
+	}
+	c := newRetryTestClient(t, rt, testRetryPolicy())
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.TODO(), &meta)
+	_, err := c.Get(ctx, "/service/svc/details", CreateRequestOptions())
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, meta.StatusCode)
+	require.Equal(t, "req-1", meta.RequestID)
+	require.Equal(t, 42, *meta.RateLimitRemaining)
+	require.Equal(t, 1700000000, *meta.RateLimitReset)
+	require.Equal(t, time.Date(1994, 11, 15, 8, 12, 31, 0, time.UTC), meta.Date)
+	require.Equal(t, 1, meta.Retries)
+	require.Equal(t, "MISS", meta.Header.Get("X-Cache"))
+	require.Positive(t, meta.Elapsed)
+}
+
+func TestWithResponseMeta_Error(t *testing.T) {
+	t.Parallel()
+
+	c := newRetryTestClient(t, roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusConflict,
+			Header:     http.Header{"Fastly-Request-Id": []string{"req-2"}},
+			Body:       io.NopCloser(strings.NewReader(`{"msg":"conflict"}`)),
+		}, nil
+	}), nil)
+
+	var meta ResponseMeta
+	err := c.DeleteBackend(WithResponseMeta(context.TODO(), &meta), &DeleteBackendInput{
+		ServiceID:      "svc",
+		ServiceVersion: 1,
+		Name:           "origin",
+	})
+	require.ErrorIs(t, err, ErrConflict)
+	require.Equal(t, http.StatusConflict, meta.StatusCode)
+	require.Equal(t, "req-2", meta.RequestID)
+	require.Nil(t, meta.RateLimitRemaining)
+}
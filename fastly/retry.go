@@ -0,0 +1,203 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how [Client.Request] retries requests that fail
+// with a transport error or a retryable HTTP status code.
+//
+// A nil *RetryPolicy (the default) disables retries entirely.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one. Values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. Each subsequent retry
+	// doubles the delay, up to MaxDelay. A random jitter of up to half the
+	// computed delay is subtracted so concurrent clients spread out.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff delay.
+	MaxDelay time.Duration
+	// MaxRetryAfter caps the delay the API may request via the Retry-After
+	// or Fastly-RateLimit-Reset response headers. If the API asks for a
+	// longer wait, the response is returned to the caller instead. A zero
+	// value means no cap (the request context still bounds the wait).
+	MaxRetryAfter time.Duration
+	// RetryableStatusCodes is the list of HTTP status codes that trigger a
+	// retry.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent allows POST and PATCH requests to be retried.
+	// These may not be safe to resend if the API partially processed the
+	// original request.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with reasonable defaults: three
+// attempts, exponential backoff starting at 500ms, and retries on 429 and
+// the transient 5xx status codes for idempotent requests only. The API may
+// ask for waits of up to 30s; longer ones, such as the reset of an hourly
+// rate limit, are returned to the caller.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:   3,
+		BaseDelay:     500 * time.Millisecond,
+		MaxDelay:      30 * time.Second,
+		MaxRetryAfter: 30 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// retryableVerb reports whether requests with the given verb may be retried.
+func (p *RetryPolicy) retryableVerb(verb string) bool {
+	switch verb {
+	case http.MethodPost, http.MethodPatch:
+		return p.RetryNonIdempotent
+	default:
+		return true
+	}
+}
+
+// retryableStatus reports whether a response with the given status code
+// should be retried.
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	return slices.Contains(p.RetryableStatusCodes, code)
+}
+
+// backoff returns the delay before the given retry (1 for the first retry).
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < retry && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	// #nosec G404 -- jitter does not need a cryptographically secure source
+	return d - rand.N(d/2+1)
+}
+
+// retryAfter returns the delay requested by the API through the Retry-After
+// header or, for rate limited responses, the Fastly-RateLimit-Reset header.
+// The second return value is false if neither header is usable.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get("Fastly-RateLimit-Reset"); v != "" {
+			if reset, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return max(time.Unix(reset, 0).Sub(now), 0), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// rewindBody resets req.Body so the request can be sent again. It returns
+// false if the body cannot be replayed.
+//
+// The bodies built by RequestForm, RequestJSON, RequestJSONAPI and
+// RequestFormFileFromReader are *strings.Reader, *bytes.Reader or
+// *bytes.Buffer values, for which http.NewRequestWithContext populates
+// GetBody. Arbitrary readers passed via RequestOptions.Body are not
+// replayable and are therefore never retried.
+func rewindBody(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
+
+// sleepContext waits for the given duration or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// doWithRetry sends req, retrying according to the client's RetryPolicy.
+// It returns the number of retries performed along with the final result.
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, int, error) {
+	p := c.RetryPolicy
+	if p == nil || p.MaxAttempts < 2 || !p.retryableVerb(req.Method) {
+		resp, err := c.send(req)
+		return resp, 0, err
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(req)
+
+		if attempt >= p.MaxAttempts || ctx.Err() != nil {
+			return resp, attempt - 1, err
+		}
+
+		var delay time.Duration
+		var httpErr *HTTPError
+		switch {
+		case errors.As(err, &httpErr):
+			if !p.retryableStatus(httpErr.StatusCode) {
+				return resp, attempt - 1, err
+			}
+			if d, ok := retryAfter(resp, time.Now()); ok {
+				if p.MaxRetryAfter > 0 && d > p.MaxRetryAfter {
+					return resp, attempt - 1, err
+				}
+				delay = d
+			} else {
+				delay = p.backoff(attempt)
+			}
+		case err != nil:
+			// Transport-level failure (connection reset, timeout, etc.).
+			delay = p.backoff(attempt)
+		default:
+			return resp, attempt - 1, nil
+		}
+
+		if !rewindBody(req) {
+			return resp, attempt - 1, err
+		}
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+		if serr := sleepContext(ctx, delay); serr != nil {
+			return nil, attempt - 1, serr
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package fastly
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// sequenceRoundTripper returns the queued status codes in order, recording
+// the request bodies it receives.
+type sequenceRoundTripper struct {
+	statuses []int
+	headers  []http.Header
+	bodies   []string
+}
+
+func (s *sequenceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body string
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(b)
+	}
+	i := len(s.bodies)
+	s.bodies = append(s.bodies, body)
+
+	h := http.Header{}
+	if i < len(s.headers) && s.headers[i] != nil {
+		h = s.headers[i]
+	}
+	return &http.Response{
+		StatusCode: s.statuses[i],
+		Header:     h,
+		Body:       io.NopCloser(strings.NewReader(`{"msg":"x"}`)),
+	}, nil
+}
+
+func newRetryTestClient(t *testing.T, rt http.RoundTripper, p *RetryPolicy) *Client {
+	t.Helper()
+	c, err := NewClientForEndpoint("nokey", DefaultEndpoint)
+	require.NoError(t, err)
+	c.HTTPClient = &http.Client{Transport: rt}
+	c.RetryPolicy = p
+	return c
+}
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseDelay = time.Millisecond
+	p.MaxDelay = 5 * time.Millisecond
+	return p
+}
+
+func TestClient_RetryRewindsBody(t *testing.T) {
+	t.Parallel()
+
+	rt := &sequenceRoundTripper{statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}}
+	c := newRetryTestClient(t, rt, testRetryPolicy())
+
+	input := struct {
+		Name string `url:"name"`
+	}{Name: "foo"}
+	_, err := c.PutForm(context.TODO(), "/test", input, CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, []string{"name=foo", "name=foo", "name=foo"}, rt.bodies)
+
+	rt = &sequenceRoundTripper{statuses: []int{http.StatusInternalServerError, http.StatusOK}}
+	c = newRetryTestClient(t, rt, testRetryPolicy())
+	_, err = c.RequestJSONAPI(context.TODO(), http.MethodPut, "/test", &struct {
+		ID   string `jsonapi:"primary,thing"`
+		Name string `jsonapi:"attr,name"`
+	}{ID: "1", Name: "foo"}, CreateRequestOptions())
+	require.NoError(t, err)
+	require.Len(t, rt.bodies, 2)
+	require.Equal(t, rt.bodies[0], rt.bodies[1])
+	require.NotEmpty(t, rt.bodies[0])
+}
+
+func TestClient_RetryLimits(t *testing.T) {
+	t.Parallel()
+
+	// MaxAttempts bounds the number of attempts.
+	rt := &sequenceRoundTripper{statuses: []int{500, 500, 500, 500}}
+	c := newRetryTestClient(t, rt, testRetryPolicy())
+	_, err := c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.Error(t, err)
+	require.Len(t, rt.bodies, 3)
+
+	// Non-retryable status codes are returned immediately.
+	rt = &sequenceRoundTripper{statuses: []int{http.StatusNotFound, http.StatusOK}}
+	c = newRetryTestClient(t, rt, testRetryPolicy())
+	_, err = c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.Error(t, err)
+	require.Len(t, rt.bodies, 1)
+
+	// Non-idempotent verbs are not retried unless enabled.
+	rt = &sequenceRoundTripper{statuses: []int{500, http.StatusOK}}
+	c = newRetryTestClient(t, rt, testRetryPolicy())
+	_, err = c.PostJSON(context.TODO(), "/test", map[string]string{"a": "b"}, CreateRequestOptions())
+	require.Error(t, err)
+	require.Len(t, rt.bodies, 1)
+
+	rt = &sequenceRoundTripper{statuses: []int{500, http.StatusOK}}
+	p := testRetryPolicy()
+	p.RetryNonIdempotent = true
+	c = newRetryTestClient(t, rt, p)
+	_, err = c.PostJSON(context.TODO(), "/test", map[string]string{"a": "b"}, CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"a":"b"}`, `{"a":"b"}`}, rt.bodies)
+
+	// A nil policy disables retries.
+	rt = &sequenceRoundTripper{statuses: []int{500, http.StatusOK}}
+	c = newRetryTestClient(t, rt, nil)
+	_, err = c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.Error(t, err)
+	require.Len(t, rt.bodies, 1)
+}
+
+func TestClient_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	// A Retry-After above MaxRetryAfter is handed back to the caller.
+	rt := &sequenceRoundTripper{
+		statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+		headers:  []http.Header{{"Retry-After": []string{"3600"}}},
+	}
+	p := testRetryPolicy()
+	p.MaxRetryAfter = time.Second
+	c := newRetryTestClient(t, rt, p)
+	_, err := c.Get(context.TODO(), "/test", CreateRequestOptions())
+	require.Error(t, err)
+	require.Len(t, rt.bodies, 1)
+
+	// The default policy does not wait for the reset of a rate limit far in
+	// the future.
+	rt = &sequenceRoundTripper{
+		statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+		headers:  []http.Header{{"Fastly-Ratelimit-Reset": []string{strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)}}},
+	}
+	c = newRetryTestClient(t, rt, DefaultRetryPolicy())
+	start := time.Now()
+	_, err = c.Put(context.TODO(), "/test", CreateRequestOptions())
+	require.Error(t, err)
+	require.Len(t, rt.bodies, 1)
+	require.Less(t, time.Since(start), time.Second)
+
+	// Without a cap, the wait is bounded by the request context.
+	rt = &sequenceRoundTripper{
+		statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+		headers:  []http.Header{{"Retry-After": []string{"3600"}}},
+	}
+	p = testRetryPolicy()
+	p.MaxRetryAfter = 0
+	c = newRetryTestClient(t, rt, p)
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Get(ctx, "/test", CreateRequestOptions())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, rt.bodies, 1)
+}
+
+func TestRetryAfterHeaders(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+
+	d, ok := retryAfter(&http.Response{Header: http.Header{"Retry-After": []string{"7"}}}, now)
+	require.True(t, ok)
+	require.Equal(t, 7*time.Second, d)
+
+	d, ok = retryAfter(&http.Response{Header: http.Header{"Retry-After": []string{now.Add(time.Minute).UTC().Format(http.TimeFormat)}}}, now)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, d)
+
+	d, ok = retryAfter(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Fastly-Ratelimit-Reset": []string{strconv.FormatInt(now.Unix()+30, 10)}},
+	}, now)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, d)
+
+	_, ok = retryAfter(&http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}, now)
+	require.False(t, ok)
+}
@@ -0,0 +1,238 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultParallelism is the number of services ForEachService processes
+// concurrently if its Parallelism is zero.
+const DefaultParallelism = 4
+
+// ServiceFilter selects the services processed by ForEachService. Services
+// match if they match all of the set fields.
+type ServiceFilter struct {
+	// CommentTags are tags which must all appear in the service comment. The
+	// comment is split into tags on whitespace and commas, e.g. "team:edge,
+	// env:prod" has the tags "team:edge" and "env:prod".
+	CommentTags []string
+	// Match is an additional predicate the service must satisfy.
+	Match func(s *Service) bool
+	// NamePattern is a pattern the service name must match, using the syntax
+	// of path.Match, e.g. "prod-*".
+	NamePattern string
+	// Type is the type the service must have ("vcl" or "wasm").
+	Type string
+}
+
+// Matches reports whether s matches the filter. It returns an error only if
+// NamePattern is malformed.
+func (f *ServiceFilter) Matches(s *Service) (bool, error) {
+	if f.NamePattern != "" {
+		ok, err := path.Match(f.NamePattern, ToValue(s.Name))
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	if f.Type != "" && ToValue(s.Type) != f.Type {
+		return false, nil
+	}
+	if len(f.CommentTags) > 0 {
+		tags := strings.FieldsFunc(ToValue(s.Comment), func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+		})
+		for _, tag := range f.CommentTags {
+			if !slices.Contains(tags, tag) {
+				return false, nil
+			}
+		}
+	}
+	if f.Match != nil && !f.Match(s) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// ServiceFunc is applied to each service by ForEachService. The value it
+// returns is recorded in the [ServiceResult] of the service.
+type ServiceFunc func(ctx context.Context, c *Client, s *Service) (any, error)
+
+// ServiceResult is the outcome of applying a [ServiceFunc] to a service.
+type ServiceResult struct {
+	// Duration is how long the function took.
+	Duration time.Duration
+	// Err is the error returned by the function.
+	Err error
+	// Service is the service.
+	Service *Service
+	// Value is the value returned by the function.
+	Value any
+}
+
+// ForEachServiceReport holds the results of ForEachService.
+type ForEachServiceReport struct {
+	// Results are the results of the services the function was applied to,
+	// in the order the services were listed.
+	Results []*ServiceResult
+	// Skipped is the number of matching services the function was not
+	// applied to because ctx was done or, with StopOnError, a function
+	// failed.
+	Skipped int
+}
+
+// Failed returns the results with an error.
+func (r *ForEachServiceReport) Failed() []*ServiceResult {
+	var out []*ServiceResult
+	for _, res := range r.Results {
+		if res.Err != nil {
+			out = append(out, res)
+		}
+	}
+	return out
+}
+
+// Err returns the errors of the failed services joined together, each
+// prefixed by the service name and ID, or nil if none failed.
+func (r *ForEachServiceReport) Err() error {
+	var errs []error
+	for _, res := range r.Failed() {
+		errs = append(errs, fmt.Errorf("service %s (%s): %w", ToValue(res.Service.Name), ToValue(res.Service.ServiceID), res.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// String returns a human-readable summary of the report, with one line per
+// service showing its error, or else the first line of its value.
+func (r *ForEachServiceReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d service(s), %d failed", len(r.Results), len(r.Failed()))
+	if r.Skipped > 0 {
+		fmt.Fprintf(&b, ", %d skipped", r.Skipped)
+	}
+	b.WriteString("\n")
+	for _, res := range r.Results {
+		status := "ok"
+		if res.Err != nil {
+			status = res.Err.Error()
+		} else if res.Value != nil {
+			status, _, _ = strings.Cut(fmt.Sprint(res.Value), "\n")
+		}
+		fmt.Fprintf(&b, "  %s (%s): %s\n", ToValue(res.Service.Name), ToValue(res.Service.ServiceID), status)
+	}
+	return b.String()
+}
+
+// ForEachServiceInput is used as input to the ForEachService function.
+type ForEachServiceInput struct {
+	// Filter selects the services. The zero value selects all services.
+	Filter ServiceFilter
+	// Func is applied to each selected service (required).
+	Func ServiceFunc
+	// Parallelism is the maximum number of services processed concurrently.
+	// It defaults to DefaultParallelism.
+	Parallelism int
+	// StopOnError stops applying the function to further services once it
+	// has failed for one.
+	StopOnError bool
+}
+
+// ForEachService lists all services and applies a function to those
+// matching the filter, processing up to Parallelism services concurrently.
+//
+// The function is passed a context carrying the service ID as its resource ID
+// (see NewContextForResourceID), so mutating requests for the same service
+// are serialized by the client's ResourceLockManager, while requests for
+// different services run concurrently. A function making requests for other
+// resources may set its own resource ID.
+//
+// The report is returned even if the function fails for some services, in
+// which case the returned error is the report's Err, joined with ctx.Err()
+// if ctx is done. If the services cannot be listed, the report is nil.
+func (c *Client) ForEachService(ctx context.Context, i *ForEachServiceInput) (*ForEachServiceReport, error) {
+	if i.Func == nil {
+		return nil, ErrMissingFunc
+	}
+
+	var services []*Service
+	for s, err := range c.AllServices(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		ok, err := i.Filter.Matches(s)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			services = append(services, s)
+		}
+	}
+
+	parallelism := i.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultParallelism
+	}
+	var (
+		results = make([]*ServiceResult, len(services))
+		sem     = make(chan struct{}, parallelism)
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		failed  bool
+	)
+	for n, s := range services {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		mu.Lock()
+		stop := i.StopOnError && failed
+		mu.Unlock()
+		if stop || ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			start := time.Now()
+			value, err := i.Func(NewContextForResourceID(ctx, ToValue(s.ServiceID)), c, s)
+			results[n] = &ServiceResult{Duration: time.Since(start), Err: err, Service: s, Value: value}
+			if err != nil {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	r := &ForEachServiceReport{}
+	for _, res := range results {
+		if res == nil {
+			r.Skipped++
+			continue
+		}
+		r.Results = append(r.Results, res)
+	}
+	return r, errors.Join(r.Err(), ctx.Err())
+}
+
+// ServiceFunc returns a [ServiceFunc] which deploys changes to each service
+// with d, for use with ForEachService. The [DeployReport] of each service is
+// returned as its value. The Client of d is used instead of the one passed
+// by ForEachService.
+func (d *Deployer) ServiceFunc(changes func(ctx context.Context, c *Client, s *Service, serviceVersion int) error) ServiceFunc {
+	return func(ctx context.Context, _ *Client, s *Service) (any, error) {
+		return d.Deploy(ctx, &DeployInput{
+			ServiceID: ToValue(s.ServiceID),
+			Changes: func(ctx context.Context, c *Client, serviceVersion int) error {
+				return changes(ctx, c, s, serviceVersion)
+			},
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package fastly_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_ForEachService(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	ids := map[string]string{}
+	for _, s := range []struct{ name, typ, comment string }{
+		{"prod-a", "vcl", "team:edge, env:prod"},
+		{"prod-b", "vcl", "team:edge"},
+		{"prod-c", "wasm", "team:edge env:prod"},
+		{"prod-d", "vcl", "team:origin"},
+		{"dev-a", "vcl", "team:edge env:dev"},
+	} {
+		svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer(s.name), Type: fastly.ToPointer(s.typ), Comment: fastly.ToPointer(s.comment)})
+		require.NoError(t, err)
+		ids[s.name] = *svc.ServiceID
+		_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: *svc.ServiceID, ServiceVersion: 1, Name: fastly.ToPointer(s.name + ".example.com")})
+		require.NoError(t, err)
+	}
+
+	// Mutating requests carry the ID of the service being processed.
+	var (
+		mu          sync.Mutex
+		resourceIDs = map[string]string{}
+	)
+	c.Use(func(next fastly.RequestHandler) fastly.RequestHandler {
+		return func(ctx context.Context, info *fastly.RequestInfo) (*http.Response, error) {
+			if info.Verb != http.MethodGet {
+				mu.Lock()
+				resourceIDs[info.Path] = info.ResourceID
+				mu.Unlock()
+			}
+			return next(ctx, info)
+		}
+	})
+
+	_, err = c.ForEachService(ctx, &fastly.ForEachServiceInput{})
+	require.ErrorIs(t, err, fastly.ErrMissingFunc)
+	_, err = c.ForEachService(ctx, &fastly.ForEachServiceInput{
+		Filter: fastly.ServiceFilter{NamePattern: "["},
+		Func:   func(context.Context, *fastly.Client, *fastly.Service) (any, error) { return nil, nil },
+	})
+	require.ErrorIs(t, err, path.ErrBadPattern)
+
+	var running, maxRunning int
+	report, err := c.ForEachService(ctx, &fastly.ForEachServiceInput{
+		Filter: fastly.ServiceFilter{
+			CommentTags: []string{"team:edge"},
+			NamePattern: "prod-*",
+			Type:        "vcl",
+		},
+		Func: func(ctx context.Context, c *fastly.Client, s *fastly.Service) (any, error) {
+			mu.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				running--
+				mu.Unlock()
+			}()
+			time.Sleep(10 * time.Millisecond)
+			v, err := c.CloneVersion(ctx, &fastly.CloneVersionInput{ServiceID: *s.ServiceID, ServiceVersion: 1})
+			if err != nil {
+				return nil, err
+			}
+			return *v.Number, nil
+		},
+		Parallelism: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, maxRunning)
+	require.Len(t, report.Results, 2)
+	for _, res := range report.Results {
+		require.Contains(t, []string{"prod-a", "prod-b"}, *res.Service.Name)
+		require.Equal(t, 2, res.Value)
+		id := *res.Service.ServiceID
+		require.Equal(t, id, resourceIDs["/service/"+id+"/version/1/clone"])
+	}
+
+	// Errors are collected per service.
+	report, err = c.ForEachService(ctx, &fastly.ForEachServiceInput{
+		Filter: fastly.ServiceFilter{
+			Match: func(s *fastly.Service) bool { return *s.Name == "prod-b" || *s.Name == "dev-a" },
+		},
+		Func: func(_ context.Context, _ *fastly.Client, s *fastly.Service) (any, error) {
+			if *s.Name == "prod-b" {
+				return nil, errors.New("boom")
+			}
+			return "fine", nil
+		},
+	})
+	require.EqualError(t, err, "service prod-b ("+ids["prod-b"]+"): boom")
+	require.Len(t, report.Failed(), 1)
+	require.Len(t, report.Results, 2)
+
+	// A Deployer deploys the same change to every service.
+	d := fastly.NewDeployer(c)
+	report, err = c.ForEachService(ctx, &fastly.ForEachServiceInput{
+		Filter: fastly.ServiceFilter{CommentTags: []string{"env:prod"}, Type: "vcl"},
+		Func: d.ServiceFunc(func(ctx context.Context, c *fastly.Client, s *fastly.Service, v int) error {
+			_, err := c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: *s.ServiceID, ServiceVersion: v, Name: fastly.ToPointer("everywhere")})
+			return err
+		}),
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Results, 1)
+	require.True(t, report.Results[0].Value.(*fastly.DeployReport).Activated)
+	require.Equal(t, `1 service(s), 0 failed
+  prod-a (`+ids["prod-a"]+`): Service `+ids["prod-a"]+` version 3 (cloned from 2): activated
+`, report.String())
+
+	// StopOnError skips the remaining services.
+	report, err = c.ForEachService(ctx, &fastly.ForEachServiceInput{
+		Func: func(context.Context, *fastly.Client, *fastly.Service) (any, error) {
+			return nil, errors.New("boom")
+		},
+		Parallelism: 1,
+		StopOnError: true,
+	})
+	require.Error(t, err)
+	require.Len(t, report.Results, 1)
+	require.Equal(t, 4, report.Skipped)
+}
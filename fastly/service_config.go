@@ -0,0 +1,718 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ServiceConfig describes the configuration objects of a VCL service
+// version. Objects are identified by name, and use the same types returned
+// by the List* functions.
+//
+// When a ServiceConfig is used as the desired state of a version (see
+// PlanServiceConfig), a nil slice leaves the objects of that kind
+// unmanaged, while an empty slice means that all of them should be deleted.
+// Likewise, nil fields of an object are left unchanged. Fields which cannot
+// be set when creating an object, such as CreatedAt or ServiceVersion, are
+// ignored. The Content of a dynamic snippet is only used to create it: it is
+// shared by all versions, is not returned by GetServiceConfig and is updated
+// with UpdateDynamicSnippet instead.
+type ServiceConfig struct {
+	Backends        []*Backend
+	CacheSettings   []*CacheSetting
+	Conditions      []*Condition
+	Directors       []*Director
+	Domains         []*Domain
+	Gzips           []*Gzip
+	Headers         []*Header
+	HealthChecks    []*HealthCheck
+	RequestSettings []*RequestSetting
+	ResponseObjects []*ResponseObject
+	Snippets        []*Snippet
+
+	LoggingBigQuery         []*BigQuery
+	LoggingBlobStorage      []*BlobStorage
+	LoggingCloudfiles       []*Cloudfiles
+	LoggingDatadog          []*Datadog
+	LoggingDigitalOcean     []*DigitalOcean
+	LoggingElasticsearch    []*Elasticsearch
+	LoggingFTP              []*FTP
+	LoggingGCS              []*GCS
+	LoggingGrafanaCloudLogs []*GrafanaCloudLogs
+	LoggingHTTPS            []*HTTPS
+	LoggingHeroku           []*Heroku
+	LoggingHoneycomb        []*Honeycomb
+	LoggingKafka            []*Kafka
+	LoggingKinesis          []*Kinesis
+	LoggingLogentries       []*Logentries
+	LoggingLoggly           []*Loggly
+	LoggingLogshuttle       []*Logshuttle
+	LoggingNewRelic         []*NewRelic
+	LoggingNewRelicOTLP     []*NewRelicOTLP
+	LoggingOpenstack        []*Openstack
+	LoggingPapertrail       []*Papertrail
+	LoggingPubsub           []*Pubsub
+	LoggingS3               []*S3
+	LoggingScalyr           []*Scalyr
+	LoggingSFTP             []*SFTP
+	LoggingSplunk           []*Splunk
+	LoggingSumologic        []*Sumologic
+	LoggingSyslog           []*Syslog
+}
+
+// configKind describes how the objects of one kind are read and written.
+// Inputs are built by reflection: the mapstructure tags of object fields
+// are matched with the url tags of input fields, or their json tags for
+// inputs sent as JSON.
+type configKind struct {
+	// name is the kind of object, named after its API path segment, e.g.
+	// "backend" or "logging/s3".
+	name string
+	// field is the name of the ServiceConfig field holding the objects.
+	field string
+
+	list   func(context.Context, *Client, any) ([]any, error)
+	create func(context.Context, *Client, any) error
+	update func(context.Context, *Client, any) error
+	delete func(context.Context, *Client, any) error
+
+	listInput, createInput, updateInput, deleteInput reflect.Type
+}
+
+func newConfigKind[T, LI, CI, UI, DI any](
+	name, field string,
+	list func(*Client, context.Context, *LI) ([]*T, error),
+	create func(*Client, context.Context, *CI) (*T, error),
+	update func(*Client, context.Context, *UI) (*T, error),
+	del func(*Client, context.Context, *DI) error,
+) *configKind {
+	if f, ok := reflect.TypeFor[ServiceConfig]().FieldByName(field); !ok || f.Type != reflect.TypeFor[[]*T]() {
+		panic(fmt.Sprintf("fastly: ServiceConfig.%s is not a []*%s", field, reflect.TypeFor[T]().Name()))
+	}
+	return &configKind{
+		name:  name,
+		field: field,
+		list: func(ctx context.Context, c *Client, i any) ([]any, error) {
+			objs, err := list(c, ctx, i.(*LI))
+			if err != nil {
+				return nil, err
+			}
+			out := make([]any, len(objs))
+			for j, o := range objs {
+				out[j] = o
+			}
+			return out, nil
+		},
+		create: func(ctx context.Context, c *Client, i any) error {
+			_, err := create(c, ctx, i.(*CI))
+			return err
+		},
+		update: func(ctx context.Context, c *Client, i any) error {
+			_, err := update(c, ctx, i.(*UI))
+			return err
+		},
+		delete: func(ctx context.Context, c *Client, i any) error {
+			return del(c, ctx, i.(*DI))
+		},
+		listInput:   reflect.TypeFor[LI](),
+		createInput: reflect.TypeFor[CI](),
+		updateInput: reflect.TypeFor[UI](),
+		deleteInput: reflect.TypeFor[DI](),
+	}
+}
+
+// configKinds lists the kinds of configuration objects in dependency
+// order: an object may only refer to objects of the kinds before it.
+var configKinds = []*configKind{
+	newConfigKind("condition", "Conditions", (*Client).ListConditions, (*Client).CreateCondition, (*Client).UpdateCondition, (*Client).DeleteCondition),
+	newConfigKind("healthcheck", "HealthChecks", (*Client).ListHealthChecks, (*Client).CreateHealthCheck, (*Client).UpdateHealthCheck, (*Client).DeleteHealthCheck),
+	newConfigKind("backend", "Backends", (*Client).ListBackends, (*Client).CreateBackend, (*Client).UpdateBackend, (*Client).DeleteBackend),
+	// The backends of a director are managed with director backends.
+	newConfigKind("director", "Directors", (*Client).ListDirectors, (*Client).CreateDirector, (*Client).UpdateDirector, (*Client).DeleteDirector),
+	newConfigKind("domain", "Domains", (*Client).ListDomains, (*Client).CreateDomain, (*Client).UpdateDomain, (*Client).DeleteDomain),
+	newConfigKind("cache_settings", "CacheSettings", (*Client).ListCacheSettings, (*Client).CreateCacheSetting, (*Client).UpdateCacheSetting, (*Client).DeleteCacheSetting),
+	newConfigKind("request_settings", "RequestSettings", (*Client).ListRequestSettings, (*Client).CreateRequestSetting, (*Client).UpdateRequestSetting, (*Client).DeleteRequestSetting),
+	newConfigKind("response_object", "ResponseObjects", (*Client).ListResponseObjects, (*Client).CreateResponseObject, (*Client).UpdateResponseObject, (*Client).DeleteResponseObject),
+	newConfigKind("header", "Headers", (*Client).ListHeaders, (*Client).CreateHeader, (*Client).UpdateHeader, (*Client).DeleteHeader),
+	newConfigKind("gzip", "Gzips", (*Client).ListGzips, (*Client).CreateGzip, (*Client).UpdateGzip, (*Client).DeleteGzip),
+	newConfigKind("snippet", "Snippets", (*Client).ListSnippets, (*Client).CreateSnippet, (*Client).UpdateSnippet, (*Client).DeleteSnippet),
+
+	newConfigKind("logging/azureblob", "LoggingBlobStorage", (*Client).ListBlobStorages, (*Client).CreateBlobStorage, (*Client).UpdateBlobStorage, (*Client).DeleteBlobStorage),
+	newConfigKind("logging/bigquery", "LoggingBigQuery", (*Client).ListBigQueries, (*Client).CreateBigQuery, (*Client).UpdateBigQuery, (*Client).DeleteBigQuery),
+	newConfigKind("logging/cloudfiles", "LoggingCloudfiles", (*Client).ListCloudfiles, (*Client).CreateCloudfiles, (*Client).UpdateCloudfiles, (*Client).DeleteCloudfiles),
+	newConfigKind("logging/datadog", "LoggingDatadog", (*Client).ListDatadog, (*Client).CreateDatadog, (*Client).UpdateDatadog, (*Client).DeleteDatadog),
+	newConfigKind("logging/digitalocean", "LoggingDigitalOcean", (*Client).ListDigitalOceans, (*Client).CreateDigitalOcean, (*Client).UpdateDigitalOcean, (*Client).DeleteDigitalOcean),
+	newConfigKind("logging/elasticsearch", "LoggingElasticsearch", (*Client).ListElasticsearch, (*Client).CreateElasticsearch, (*Client).UpdateElasticsearch, (*Client).DeleteElasticsearch),
+	newConfigKind("logging/ftp", "LoggingFTP", (*Client).ListFTPs, (*Client).CreateFTP, (*Client).UpdateFTP, (*Client).DeleteFTP),
+	newConfigKind("logging/gcs", "LoggingGCS", (*Client).ListGCSs, (*Client).CreateGCS, (*Client).UpdateGCS, (*Client).DeleteGCS),
+	newConfigKind("logging/grafanacloudlogs", "LoggingGrafanaCloudLogs", (*Client).ListGrafanaCloudLogs, (*Client).CreateGrafanaCloudLogs, (*Client).UpdateGrafanaCloudLogs, (*Client).DeleteGrafanaCloudLogs),
+	newConfigKind("logging/heroku", "LoggingHeroku", (*Client).ListHerokus, (*Client).CreateHeroku, (*Client).UpdateHeroku, (*Client).DeleteHeroku),
+	newConfigKind("logging/honeycomb", "LoggingHoneycomb", (*Client).ListHoneycombs, (*Client).CreateHoneycomb, (*Client).UpdateHoneycomb, (*Client).DeleteHoneycomb),
+	newConfigKind("logging/https", "LoggingHTTPS", (*Client).ListHTTPS, (*Client).CreateHTTPS, (*Client).UpdateHTTPS, (*Client).DeleteHTTPS),
+	newConfigKind("logging/kafka", "LoggingKafka", (*Client).ListKafkas, (*Client).CreateKafka, (*Client).UpdateKafka, (*Client).DeleteKafka),
+	newConfigKind("logging/kinesis", "LoggingKinesis", (*Client).ListKinesis, (*Client).CreateKinesis, (*Client).UpdateKinesis, (*Client).DeleteKinesis),
+	newConfigKind("logging/logentries", "LoggingLogentries", (*Client).ListLogentries, (*Client).CreateLogentries, (*Client).UpdateLogentries, (*Client).DeleteLogentries),
+	newConfigKind("logging/loggly", "LoggingLoggly", (*Client).ListLoggly, (*Client).CreateLoggly, (*Client).UpdateLoggly, (*Client).DeleteLoggly),
+	newConfigKind("logging/logshuttle", "LoggingLogshuttle", (*Client).ListLogshuttles, (*Client).CreateLogshuttle, (*Client).UpdateLogshuttle, (*Client).DeleteLogshuttle),
+	newConfigKind("logging/newrelic", "LoggingNewRelic", (*Client).ListNewRelic, (*Client).CreateNewRelic, (*Client).UpdateNewRelic, (*Client).DeleteNewRelic),
+	newConfigKind("logging/newrelicotlp", "LoggingNewRelicOTLP", (*Client).ListNewRelicOTLP, (*Client).CreateNewRelicOTLP, (*Client).UpdateNewRelicOTLP, (*Client).DeleteNewRelicOTLP),
+	newConfigKind("logging/openstack", "LoggingOpenstack", (*Client).ListOpenstack, (*Client).CreateOpenstack, (*Client).UpdateOpenstack, (*Client).DeleteOpenstack),
+	newConfigKind("logging/papertrail", "LoggingPapertrail", (*Client).ListPapertrails, (*Client).CreatePapertrail, (*Client).UpdatePapertrail, (*Client).DeletePapertrail),
+	newConfigKind("logging/pubsub", "LoggingPubsub", (*Client).ListPubsubs, (*Client).CreatePubsub, (*Client).UpdatePubsub, (*Client).DeletePubsub),
+	newConfigKind("logging/s3", "LoggingS3", (*Client).ListS3s, (*Client).CreateS3, (*Client).UpdateS3, (*Client).DeleteS3),
+	newConfigKind("logging/scalyr", "LoggingScalyr", (*Client).ListScalyrs, (*Client).CreateScalyr, (*Client).UpdateScalyr, (*Client).DeleteScalyr),
+	newConfigKind("logging/sftp", "LoggingSFTP", (*Client).ListSFTPs, (*Client).CreateSFTP, (*Client).UpdateSFTP, (*Client).DeleteSFTP),
+	newConfigKind("logging/splunk", "LoggingSplunk", (*Client).ListSplunks, (*Client).CreateSplunk, (*Client).UpdateSplunk, (*Client).DeleteSplunk),
+	newConfigKind("logging/sumologic", "LoggingSumologic", (*Client).ListSumologics, (*Client).CreateSumologic, (*Client).UpdateSumologic, (*Client).DeleteSumologic),
+	newConfigKind("logging/syslog", "LoggingSyslog", (*Client).ListSyslogs, (*Client).CreateSyslog, (*Client).UpdateSyslog, (*Client).DeleteSyslog),
+}
+
+// directorBackendKind is the kind of the changes adding backends to, and
+// removing them from, directors. It follows "director" in dependency order.
+const directorBackendKind = "director_backend"
+
+// readOnlyConfigFields are the fields of configuration objects which are
+// set by the API.
+var readOnlyConfigFields = []string{"created_at", "deleted_at", "id", "service_id", "updated_at", "version"}
+
+// objects returns the objects of kind k in cfg, and whether they are
+// managed, i.e. the slice is not nil.
+func (k *configKind) objects(cfg *ServiceConfig) ([]any, bool) {
+	v := reflect.ValueOf(cfg).Elem().FieldByName(k.field)
+	if v.IsNil() {
+		return nil, false
+	}
+	out := make([]any, v.Len())
+	for i := range out {
+		out[i] = v.Index(i).Interface()
+	}
+	return out, true
+}
+
+// setObjects sets the objects of kind k in cfg.
+func (k *configKind) setObjects(cfg *ServiceConfig, objs []any) {
+	v := reflect.ValueOf(cfg).Elem().FieldByName(k.field)
+	s := reflect.MakeSlice(v.Type(), 0, len(objs))
+	for _, o := range objs {
+		s = reflect.Append(s, reflect.ValueOf(o))
+	}
+	v.Set(s)
+}
+
+// compared reports whether the object field f, named tag, is compared when
+// planning changes. Only fields which can be set on creation are compared;
+// the others are either read-only or, like the backends of a director,
+// managed separately.
+func (k *configKind) compared(f reflect.StructField, tag string) bool {
+	if tag == "name" || slices.Contains(readOnlyConfigFields, tag) {
+		return false
+	}
+	dst, ok := inputField(reflect.New(k.createInput).Elem(), tag)
+	return ok && canCopyField(dst.Type(), f.Type)
+}
+
+// changedFields returns the tags of the fields set in desired which differ
+// from current, in the order they are declared. The content of a dynamic
+// snippet is not versioned, and is not returned with the snippet, so it is
+// never compared.
+func (k *configKind) changedFields(desired, current any) []string {
+	dv := reflect.ValueOf(desired).Elem()
+	cv := reflect.ValueOf(current).Elem()
+	var changed []string
+	for i := range dv.NumField() {
+		tag := fieldTag(dv.Type().Field(i), "mapstructure")
+		if tag == "" || !k.compared(dv.Type().Field(i), tag) {
+			continue
+		}
+		if tag == "content" && isDynamicSnippet(current) {
+			continue
+		}
+		d := dv.Field(i)
+		if isNilField(d) {
+			continue
+		}
+		if !reflect.DeepEqual(d.Interface(), cv.Field(i).Interface()) {
+			changed = append(changed, tag)
+		}
+	}
+	return changed
+}
+
+// isDynamicSnippet reports whether obj is a dynamic snippet.
+func isDynamicSnippet(obj any) bool {
+	s, ok := obj.(*Snippet)
+	return ok && s.Dynamic != nil && *s.Dynamic == 1
+}
+
+// updatable reports whether all of the given fields can be set with an
+// update, rather than requiring the object to be replaced.
+func (k *configKind) updatable(obj any, fields []string) bool {
+	ov := reflect.ValueOf(obj).Elem()
+	for _, tag := range fields {
+		src, ok := fieldByTag(ov, "mapstructure", tag)
+		if !ok {
+			return false
+		}
+		dst, ok := inputField(reflect.New(k.updateInput).Elem(), tag)
+		if !ok || !canCopyField(dst.Type(), src.Type()) {
+			return false
+		}
+	}
+	return true
+}
+
+// input returns a new input of type t for the given version, identifying
+// the object named key if key is not empty. The fields of obj with the
+// given tags, or all fields if tags is nil, are copied into it.
+func configInput(t reflect.Type, serviceID string, serviceVersion int, key string, obj any, tags []string) any {
+	in := reflect.New(t)
+	v := in.Elem()
+	v.FieldByName("ServiceID").SetString(serviceID)
+	v.FieldByName("ServiceVersion").SetInt(int64(serviceVersion))
+	if key != "" {
+		v.FieldByName("Name").SetString(key)
+	}
+	if obj == nil {
+		return in.Interface()
+	}
+
+	ov := reflect.ValueOf(obj).Elem()
+	for i := range ov.NumField() {
+		tag := fieldTag(ov.Type().Field(i), "mapstructure")
+		if tag == "" || slices.Contains(readOnlyConfigFields, tag) {
+			continue
+		}
+		if tags != nil && !slices.Contains(tags, tag) {
+			continue
+		}
+		if dst, ok := inputField(v, tag); ok {
+			copyField(dst, ov.Field(i))
+		}
+	}
+	return in.Interface()
+}
+
+// fieldTag returns the name given to f by the struct tag with the given
+// key, or "" if it has none.
+func fieldTag(f reflect.StructField, key string) string {
+	name, _, _ := strings.Cut(f.Tag.Get(key), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// fieldByTag returns the field of the struct v named tag by the struct tag
+// with the given key.
+func fieldByTag(v reflect.Value, key, tag string) (reflect.Value, bool) {
+	for i := range v.NumField() {
+		if fieldTag(v.Type().Field(i), key) == tag {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// inputField returns the field of the input struct v named tag by its url
+// tag or, failing that, its json tag.
+func inputField(v reflect.Value, tag string) (reflect.Value, bool) {
+	if f, ok := fieldByTag(v, "url", tag); ok {
+		return f, true
+	}
+	return fieldByTag(v, "json", tag)
+}
+
+// canCopyField reports whether copyField can copy a value of type src into
+// a field of type dst. Pointers and slices are copied between types with
+// the same underlying kind, e.g. from *bool to *Compatibool, and pointers
+// to values into Nullable fields.
+func canCopyField(dst, src reflect.Type) bool {
+	if n, ok := reflect.Zero(dst).Interface().(nullable); ok {
+		return src.Kind() == reflect.Pointer && src.Elem().Kind() == n.valueType().Kind() && src.Elem().ConvertibleTo(n.valueType())
+	}
+	switch {
+	case src.Kind() == reflect.Pointer && dst.Kind() == reflect.Pointer:
+		return src.Elem().Kind() == dst.Elem().Kind() && src.Elem().ConvertibleTo(dst.Elem())
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		return src.AssignableTo(dst)
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Pointer:
+		return src.AssignableTo(dst.Elem())
+	}
+	return false
+}
+
+// copyField copies src into dst, leaving dst unchanged if src is nil or
+// cannot be copied.
+func copyField(dst, src reflect.Value) {
+	if isNilField(src) || !canCopyField(dst.Type(), src.Type()) {
+		return
+	}
+	if _, ok := dst.Interface().(nullable); ok {
+		p := reflect.New(dst.Type().Elem())
+		p.Interface().(nullable).setValue(src.Elem())
+		dst.Set(p)
+		return
+	}
+	switch {
+	case dst.Kind() == reflect.Pointer && src.Kind() == reflect.Pointer:
+		p := reflect.New(dst.Type().Elem())
+		p.Elem().Set(src.Elem().Convert(dst.Type().Elem()))
+		dst.Set(p)
+	case dst.Kind() == reflect.Pointer:
+		p := reflect.New(dst.Type().Elem())
+		p.Elem().Set(src)
+		dst.Set(p)
+	default:
+		dst.Set(src)
+	}
+}
+
+// configObjectName returns the name of a configuration object.
+func configObjectName(obj any) string {
+	if name := reflect.ValueOf(obj).Elem().FieldByName("Name"); name.Kind() == reflect.Pointer && !name.IsNil() {
+		return name.Elem().String()
+	}
+	return ""
+}
+
+// GetServiceConfigInput is used as input to the GetServiceConfig function.
+type GetServiceConfigInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// GetServiceConfig retrieves all configuration objects of a version.
+func (c *Client) GetServiceConfig(ctx context.Context, i *GetServiceConfigInput) (*ServiceConfig, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	cfg := &ServiceConfig{}
+	for _, k := range configKinds {
+		objs, err := k.list(ctx, c, configInput(k.listInput, i.ServiceID, i.ServiceVersion, "", nil, nil))
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", k.name, err)
+		}
+		k.setObjects(cfg, objs)
+	}
+	return cfg, nil
+}
+
+// ConfigChangeAction is the kind of change made to a configuration object.
+type ConfigChangeAction string
+
+const (
+	// ConfigChangeCreate creates an object.
+	ConfigChangeCreate ConfigChangeAction = "create"
+	// ConfigChangeUpdate updates the changed fields of an object.
+	ConfigChangeUpdate ConfigChangeAction = "update"
+	// ConfigChangeReplace deletes an object and creates it again, because
+	// some of its changed fields cannot be updated.
+	ConfigChangeReplace ConfigChangeAction = "replace"
+	// ConfigChangeDelete deletes an object.
+	ConfigChangeDelete ConfigChangeAction = "delete"
+)
+
+// ConfigChange is a change to a single configuration object.
+type ConfigChange struct {
+	// Action is the change to make.
+	Action ConfigChangeAction
+	// Kind is the kind of object, named after its API path segment, e.g.
+	// "backend", "logging/s3" or "director_backend".
+	Kind string
+	// Name is the name of the object. For director backends it is the name
+	// of the backend.
+	Name string
+	// Director is the name of the director, for director backends.
+	Director string
+	// Fields lists the names of the changed fields, as used by the API,
+	// for updates and replacements.
+	Fields []string
+	// Current is the object before the change, or nil if it is created.
+	Current any
+	// Desired is the desired object, or nil if it is deleted.
+	Desired any
+}
+
+// String returns a one line description of the change.
+func (cc *ConfigChange) String() string {
+	symbol := map[ConfigChangeAction]string{
+		ConfigChangeCreate:  "+",
+		ConfigChangeUpdate:  "~",
+		ConfigChangeReplace: "-/+",
+		ConfigChangeDelete:  "-",
+	}[cc.Action]
+	s := fmt.Sprintf("%s %s %q", symbol, cc.Kind, cc.Name)
+	if cc.Director != "" {
+		s += fmt.Sprintf(" in director %q", cc.Director)
+	}
+	if len(cc.Fields) > 0 {
+		s += " (" + strings.Join(cc.Fields, ", ") + ")"
+	}
+	return s
+}
+
+// ServiceConfigPlan is an ordered set of changes turning the configuration
+// of a version into a desired configuration. It is returned by
+// PlanServiceConfig and executed by ApplyServiceConfig.
+type ServiceConfigPlan struct {
+	// ServiceID is the ID of the service.
+	ServiceID string
+	// ServiceVersion is the version the changes were planned against.
+	ServiceVersion int
+	// Changes are the changes in the order they are applied: creations,
+	// updates and replacements in dependency order, then deletions in
+	// reverse dependency order.
+	Changes []*ConfigChange
+}
+
+// Empty reports whether the plan has no changes.
+func (p *ServiceConfigPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns a human-readable summary of the plan, with one line per
+// change.
+func (p *ServiceConfigPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service %s version %d: ", p.ServiceID, p.ServiceVersion)
+	if p.Empty() {
+		b.WriteString("no changes\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "%d change(s)\n", len(p.Changes))
+	for _, cc := range p.Changes {
+		fmt.Fprintf(&b, "  %s\n", cc)
+	}
+	return b.String()
+}
+
+// PlanServiceConfigInput is used as input to the PlanServiceConfig function.
+type PlanServiceConfigInput struct {
+	// Desired is the desired configuration of the version (required).
+	Desired *ServiceConfig
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// PlanServiceConfig reads the configuration of a version and computes the
+// changes needed to turn it into the desired configuration. It makes no
+// changes to the service.
+func (c *Client) PlanServiceConfig(ctx context.Context, i *PlanServiceConfigInput) (*ServiceConfigPlan, error) {
+	if i.Desired == nil {
+		return nil, ErrMissingDesired
+	}
+
+	current, err := c.GetServiceConfig(ctx, &GetServiceConfigInput{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return planServiceConfig(i.ServiceID, i.ServiceVersion, current, i.Desired), nil
+}
+
+// planServiceConfig computes the changes turning current into desired.
+func planServiceConfig(serviceID string, serviceVersion int, current, desired *ServiceConfig) *ServiceConfigPlan {
+	p := &ServiceConfigPlan{ServiceID: serviceID, ServiceVersion: serviceVersion}
+	var deletes []*ConfigChange
+
+	for _, k := range configKinds {
+		want, managed := k.objects(desired)
+		if !managed {
+			continue
+		}
+		have, _ := k.objects(current)
+		existing := make(map[string]any, len(have))
+		for _, obj := range have {
+			existing[configObjectName(obj)] = obj
+		}
+
+		kept := map[string]bool{}
+		for _, obj := range want {
+			name := configObjectName(obj)
+			kept[name] = true
+			cur, ok := existing[name]
+			switch {
+			case !ok:
+				p.Changes = append(p.Changes, &ConfigChange{Action: ConfigChangeCreate, Kind: k.name, Name: name, Desired: obj})
+			default:
+				fields := k.changedFields(obj, cur)
+				if len(fields) == 0 {
+					break
+				}
+				action := ConfigChangeUpdate
+				if !k.updatable(obj, fields) {
+					action = ConfigChangeReplace
+				}
+				p.Changes = append(p.Changes, &ConfigChange{Action: action, Kind: k.name, Name: name, Fields: fields, Current: cur, Desired: obj})
+			}
+		}
+
+		var removed []*ConfigChange
+		for _, obj := range have {
+			if name := configObjectName(obj); !kept[name] {
+				removed = append(removed, &ConfigChange{Action: ConfigChangeDelete, Kind: k.name, Name: name, Current: obj})
+			}
+		}
+
+		if k.name == "director" {
+			added, dropped := planDirectorBackends(want, existing, p.Changes)
+			p.Changes = append(p.Changes, added...)
+			removed = append(removed, dropped...)
+		}
+		deletes = append(removed, deletes...)
+	}
+
+	p.Changes = append(p.Changes, deletes...)
+	return p
+}
+
+// planDirectorBackends returns the changes adding backends to, and removing
+// them from, the desired directors. Directors which are created or
+// replaced start without backends.
+func planDirectorBackends(want []any, existing map[string]any, changes []*ConfigChange) (added, removed []*ConfigChange) {
+	for _, obj := range want {
+		d := obj.(*Director)
+		if d.Backends == nil {
+			continue
+		}
+		name := configObjectName(d)
+
+		var have []string
+		if cur, ok := existing[name]; ok && !slices.ContainsFunc(changes, func(cc *ConfigChange) bool {
+			return cc.Kind == "director" && cc.Name == name && cc.Action == ConfigChangeReplace
+		}) {
+			have = cur.(*Director).Backends
+		}
+
+		for _, b := range d.Backends {
+			if !slices.Contains(have, b) {
+				added = append(added, &ConfigChange{Action: ConfigChangeCreate, Kind: directorBackendKind, Name: b, Director: name, Desired: d})
+			}
+		}
+		for _, b := range have {
+			if !slices.Contains(d.Backends, b) {
+				removed = append(removed, &ConfigChange{Action: ConfigChangeDelete, Kind: directorBackendKind, Name: b, Director: name, Current: existing[name]})
+			}
+		}
+	}
+	return added, removed
+}
+
+// ApplyServiceConfigInput is used as input to the ApplyServiceConfig
+// function.
+type ApplyServiceConfigInput struct {
+	// Activate activates the new version once the changes are made and it
+	// passes validation.
+	Activate bool
+	// Plan is the plan to apply (required).
+	Plan *ServiceConfigPlan
+}
+
+// ApplyServiceConfig clones the version a plan was computed against and
+// makes the planned changes to the clone, returning the new version. If a
+// change fails, the new version is returned along with the error, so that
+// it can be inspected or discarded.
+func (c *Client) ApplyServiceConfig(ctx context.Context, i *ApplyServiceConfigInput) (*Version, error) {
+	if i.Plan == nil {
+		return nil, ErrMissingPlan
+	}
+	p := i.Plan
+
+	v, err := c.CloneVersion(ctx, &CloneVersionInput{ServiceID: p.ServiceID, ServiceVersion: p.ServiceVersion})
+	if err != nil {
+		return nil, err
+	}
+	version := *v.Number
+
+	for _, cc := range p.Changes {
+		if err := c.applyConfigChange(ctx, p.ServiceID, version, cc); err != nil {
+			return v, fmt.Errorf("applying %s: %w", cc, err)
+		}
+	}
+
+	if !i.Activate {
+		return v, nil
+	}
+	valid, msg, err := c.ValidateVersion(ctx, &ValidateVersionInput{ServiceID: p.ServiceID, ServiceVersion: version})
+	if err != nil {
+		return v, err
+	}
+	if !valid {
+		return v, fmt.Errorf("version %d: %w: %s", version, ErrValidationFailed, msg)
+	}
+	return c.ActivateVersion(ctx, &ActivateVersionInput{ServiceID: p.ServiceID, ServiceVersion: version})
+}
+
+// applyConfigChange makes a single change to the given version.
+func (c *Client) applyConfigChange(ctx context.Context, serviceID string, serviceVersion int, cc *ConfigChange) error {
+	if cc.Kind == directorBackendKind {
+		if cc.Action == ConfigChangeDelete {
+			return c.DeleteDirectorBackend(ctx, &DeleteDirectorBackendInput{
+				Backend: cc.Name, Director: cc.Director, ServiceID: serviceID, ServiceVersion: serviceVersion,
+			})
+		}
+		_, err := c.CreateDirectorBackend(ctx, &CreateDirectorBackendInput{
+			Backend: cc.Name, Director: cc.Director, ServiceID: serviceID, ServiceVersion: serviceVersion,
+		})
+		return err
+	}
+
+	i := slices.IndexFunc(configKinds, func(k *configKind) bool { return k.name == cc.Kind })
+	if i < 0 {
+		return fmt.Errorf("unknown kind %q", cc.Kind)
+	}
+	k := configKinds[i]
+
+	switch cc.Action {
+	case ConfigChangeCreate:
+		return k.create(ctx, c, configInput(k.createInput, serviceID, serviceVersion, "", cc.Desired, nil))
+	case ConfigChangeUpdate:
+		return k.update(ctx, c, configInput(k.updateInput, serviceID, serviceVersion, cc.Name, cc.Desired, cc.Fields))
+	case ConfigChangeReplace:
+		if err := k.delete(ctx, c, configInput(k.deleteInput, serviceID, serviceVersion, cc.Name, nil, nil)); err != nil {
+			return err
+		}
+		// Fields of the current object which are not set in the desired
+		// one are kept.
+		return k.create(ctx, c, configInput(k.createInput, serviceID, serviceVersion, "", mergeConfigObjects(cc.Current, cc.Desired), nil))
+	case ConfigChangeDelete:
+		return k.delete(ctx, c, configInput(k.deleteInput, serviceID, serviceVersion, cc.Name, nil, nil))
+	}
+	return fmt.Errorf("unknown action %q", cc.Action)
+}
+
+// mergeConfigObjects returns a copy of current with the non-nil fields of
+// desired set.
+func mergeConfigObjects(current, desired any) any {
+	out := reflect.New(reflect.TypeOf(current).Elem())
+	out.Elem().Set(reflect.ValueOf(current).Elem())
+	dv := reflect.ValueOf(desired).Elem()
+	for i := range dv.NumField() {
+		if f := dv.Field(i); !isNilField(f) {
+			out.Elem().Field(i).Set(f)
+		}
+	}
+	return out.Interface()
+}
+
+// isNilField reports whether the struct field v is a nil pointer or slice.
+func isNilField(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
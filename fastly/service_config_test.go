@@ -0,0 +1,148 @@
+package fastly_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_ServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("config")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b"} {
+		_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{
+			ServiceID:      id,
+			ServiceVersion: 1,
+			Name:           fastly.ToPointer(name),
+			Address:        fastly.ToPointer(name + ".example.com"),
+			Port:           fastly.ToPointer(443),
+		})
+		require.NoError(t, err)
+	}
+	_, err = c.CreateDirector(ctx, &fastly.CreateDirectorInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("d"),
+		Type:           fastly.ToPointer(fastly.DirectorTypeRandom),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateDirectorBackend(ctx, &fastly.CreateDirectorBackendInput{ServiceID: id, ServiceVersion: 1, Director: "d", Backend: "a"})
+	require.NoError(t, err)
+	_, err = c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("h")})
+	require.NoError(t, err)
+	_, err = c.CreateSnippet(ctx, &fastly.CreateSnippetInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("s"),
+		Content:        fastly.ToPointer("set req.http.X = 1;"),
+		Type:           fastly.ToPointer(fastly.SnippetTypeRecv),
+		Dynamic:        fastly.ToPointer(0),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateDatadog(ctx, &fastly.CreateDatadogInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("dd"), Token: fastly.ToPointer("old")})
+	require.NoError(t, err)
+
+	cfg, err := c.GetServiceConfig(ctx, &fastly.GetServiceConfigInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.Len(t, cfg.Backends, 2)
+	require.Len(t, cfg.Domains, 1)
+	require.Equal(t, []string{"a"}, cfg.Directors[0].Backends)
+	require.NotNil(t, cfg.Conditions)
+	require.Empty(t, cfg.Conditions)
+
+	// Domains and conditions are left unmanaged.
+	desired := &fastly.ServiceConfig{
+		Backends: []*fastly.Backend{
+			{Name: fastly.ToPointer("a"), Port: fastly.ToPointer(8080)},
+			{Name: fastly.ToPointer("b")},
+			{Name: fastly.ToPointer("c"), Address: fastly.ToPointer("c.example.com")},
+		},
+		Directors: []*fastly.Director{
+			{Name: fastly.ToPointer("d"), Backends: []string{"b", "c"}},
+		},
+		Headers: []*fastly.Header{},
+		Snippets: []*fastly.Snippet{
+			{Name: fastly.ToPointer("s"), Dynamic: fastly.ToPointer(1)},
+		},
+		LoggingDatadog: []*fastly.Datadog{
+			{Name: fastly.ToPointer("dd"), Token: fastly.ToPointer("new")},
+		},
+	}
+
+	plan, err := c.PlanServiceConfig(ctx, &fastly.PlanServiceConfigInput{ServiceID: id, ServiceVersion: 1, Desired: desired})
+	require.NoError(t, err)
+	require.Equal(t, `Service `+id+` version 1: 8 change(s)
+  ~ backend "a" (port)
+  + backend "c"
+  + director_backend "b" in director "d"
+  + director_backend "c" in director "d"
+  -/+ snippet "s" (dynamic)
+  ~ logging/datadog "dd" (token)
+  - header "h"
+  - director_backend "a" in director "d"
+`, plan.String())
+
+	_, err = c.ApplyServiceConfig(ctx, &fastly.ApplyServiceConfigInput{})
+	require.ErrorIs(t, err, fastly.ErrMissingPlan)
+
+	v, err := c.ApplyServiceConfig(ctx, &fastly.ApplyServiceConfigInput{Plan: plan, Activate: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, *v.Number)
+	require.True(t, *v.Active)
+
+	cfg, err = c.GetServiceConfig(ctx, &fastly.GetServiceConfigInput{ServiceID: id, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.Len(t, cfg.Backends, 3)
+	require.Equal(t, 8080, *cfg.Backends[0].Port)
+	require.Equal(t, "a.example.com", *cfg.Backends[0].Address)
+	require.ElementsMatch(t, []string{"b", "c"}, cfg.Directors[0].Backends)
+	require.Empty(t, cfg.Headers)
+	require.Equal(t, 1, *cfg.Snippets[0].Dynamic)
+	require.Nil(t, cfg.Snippets[0].Content)
+	dyn, err := c.GetDynamicSnippet(ctx, &fastly.GetDynamicSnippetInput{ServiceID: id, SnippetID: *cfg.Snippets[0].SnippetID})
+	require.NoError(t, err)
+	require.Equal(t, "set req.http.X = 1;", *dyn.Content)
+	require.Equal(t, "new", *cfg.LoggingDatadog[0].Token)
+	require.Len(t, cfg.Domains, 1)
+
+	// The source version is unchanged, and the new one needs no changes.
+	cfg, err = c.GetServiceConfig(ctx, &fastly.GetServiceConfigInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.Len(t, cfg.Backends, 2)
+
+	plan, err = c.PlanServiceConfig(ctx, &fastly.PlanServiceConfigInput{ServiceID: id, ServiceVersion: 2, Desired: desired})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+	require.Equal(t, "Service "+id+" version 2: no changes\n", plan.String())
+
+	// The content of a dynamic snippet is not compared, as it is not
+	// returned with the snippet.
+	desired.Snippets[0].Content = fastly.ToPointer("set req.http.X = 2;")
+	plan, err = c.PlanServiceConfig(ctx, &fastly.PlanServiceConfigInput{ServiceID: id, ServiceVersion: 2, Desired: desired})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+
+	// A version failing validation is not activated.
+	plan, err = c.PlanServiceConfig(ctx, &fastly.PlanServiceConfigInput{ServiceID: id, ServiceVersion: 2, Desired: &fastly.ServiceConfig{Domains: []*fastly.Domain{}}})
+	require.NoError(t, err)
+	v, err = c.ApplyServiceConfig(ctx, &fastly.ApplyServiceConfigInput{Plan: plan, Activate: true})
+	require.ErrorIs(t, err, fastly.ErrValidationFailed)
+	require.Equal(t, 3, *v.Number)
+	require.False(t, fastly.ToValue(v.Active))
+}
@@ -0,0 +1,450 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fastly/go-fastly/v17/fastly/impersonation"
+)
+
+// ServiceVersionRef identifies a version of a service, which may belong to
+// another customer.
+type ServiceVersionRef struct {
+	// CustomerID is the ID of the customer owning the service, which is
+	// accessed through impersonation (see
+	// impersonation.NewContextForCustomerID). It is empty for services of the
+	// customer owning the API token.
+	CustomerID string
+	// ServiceID is the ID of the service.
+	ServiceID string
+	// ServiceVersion is the specific configuration version.
+	ServiceVersion int
+}
+
+// CopyServiceConfigInput is used as input to the CopyServiceConfig function.
+type CopyServiceConfigInput struct {
+	// AllowSharedEntries allows CopyEntries to write to dictionaries and ACLs
+	// of the destination version which a locked, active or staged version
+	// also has. Otherwise ErrSharedEntries is returned before anything is
+	// copied.
+	AllowSharedEntries bool
+	// Comment is the comment of the new destination version, if one is
+	// created. It defaults to a note naming the source service and version.
+	Comment *string
+	// CopyEntries also copies dictionary items and ACL entries. Items of
+	// write-only dictionaries cannot be read and are never copied. Items and
+	// entries are not versioned: those copied into a dictionary or ACL the
+	// destination version already has are seen by every version sharing it,
+	// such as the active version the destination was cloned from (see
+	// AllowSharedEntries).
+	CopyEntries bool
+	// Destination is the service to copy to (ServiceID required). If its
+	// ServiceVersion is zero, a new, empty version is created. Otherwise the
+	// objects are merged into that version, which must be unlocked.
+	Destination ServiceVersionRef
+	// ResourceIDs maps the IDs of the resources linked to the source version,
+	// such as KV stores, to the IDs of the resources to link to the
+	// destination version. Unmapped resources are linked as they are, which
+	// is only allowed if both services belong to the same customer.
+	ResourceIDs map[string]string
+	// Skip names the kinds of objects not to copy, e.g. "domain". Kinds are
+	// named as in a ConfigChange, or are one of "settings", "vcl",
+	// "dictionary", "acl" and "resource".
+	Skip []string
+	// Source is the version to copy (ServiceID and ServiceVersion required).
+	Source ServiceVersionRef
+}
+
+// CopyServiceConfigResult describes a copy made by CopyServiceConfig.
+type CopyServiceConfigResult struct {
+	// ACLIDs maps the IDs of the source ACLs to the IDs of the destination
+	// ACLs.
+	ACLIDs map[string]string
+	// DictionaryIDs maps the IDs of the source dictionaries to the IDs of the
+	// destination dictionaries.
+	DictionaryIDs map[string]string
+	// Version is the destination version.
+	Version *Version
+}
+
+// CopyServiceConfig reads the configuration of a source version and replays
+// it into a draft version of a destination service, which may belong to
+// another customer.
+//
+// Objects are created on the destination version, or updated if it already
+// has an object of the same kind and name. Objects of the destination
+// version which are not in the source are kept, but the backends of copied
+// directors are replaced. Dictionaries and ACLs are matched by name, and
+// their IDs in the destination are returned in the result.
+//
+// Dictionary items, ACL entries and the content of dynamic snippets are not
+// versioned, so copying them into objects the destination version already
+// has changes those objects in every version sharing them, including the
+// active one. Items and entries are only copied into dictionaries and ACLs
+// shared with locked versions if AllowSharedEntries is set.
+//
+// If an object cannot be copied, the result is returned along with the
+// error, so that the destination version can be inspected or discarded.
+// Rate limiters and Compute packages are not copied.
+func (c *Client) CopyServiceConfig(ctx context.Context, i *CopyServiceConfigInput) (*CopyServiceConfigResult, error) {
+	if i.Source.ServiceID == "" || i.Destination.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.Source.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+	for _, kind := range i.Skip {
+		if !slices.Contains(copyKinds(), kind) {
+			return nil, fmt.Errorf("unknown kind %q", kind)
+		}
+	}
+
+	srcCtx := customerContext(ctx, i.Source.CustomerID)
+	src, err := c.exportServiceVersion(srcCtx, &ExportServiceVersionInput{IncludeSecrets: true, ServiceID: i.Source.ServiceID, ServiceVersion: i.Source.ServiceVersion}, i.CopyEntries)
+	if err != nil {
+		return nil, fmt.Errorf("exporting source version: %w", err)
+	}
+	if !slices.Contains(i.Skip, "resource") {
+		for _, r := range src.Resources {
+			id := ToValue(r.ResourceID)
+			if _, ok := i.ResourceIDs[id]; !ok && i.Source.CustomerID != i.Destination.CustomerID {
+				return nil, fmt.Errorf("resource %q links %s, which is not mapped in ResourceIDs", ToValue(r.Name), id)
+			}
+		}
+	}
+
+	dstCtx := customerContext(ctx, i.Destination.CustomerID)
+	dstID := i.Destination.ServiceID
+	res := &CopyServiceConfigResult{ACLIDs: map[string]string{}, DictionaryIDs: map[string]string{}}
+	if i.Destination.ServiceVersion == 0 {
+		comment := i.Comment
+		if comment == nil {
+			comment = ToPointer(fmt.Sprintf("Copied from version %d of service %s", i.Source.ServiceVersion, i.Source.ServiceID))
+		}
+		res.Version, err = c.CreateVersion(dstCtx, &CreateVersionInput{ServiceID: dstID, Comment: comment})
+	} else {
+		res.Version, err = c.GetVersion(dstCtx, &GetVersionInput{ServiceID: dstID, ServiceVersion: i.Destination.ServiceVersion})
+	}
+	if err != nil {
+		return nil, err
+	}
+	dstVersion := ToValue(res.Version.Number)
+	dst, err := c.exportServiceVersion(dstCtx, &ExportServiceVersionInput{IncludeSecrets: true, ServiceID: dstID, ServiceVersion: dstVersion}, false)
+	if err != nil {
+		return res, fmt.Errorf("exporting destination version: %w", err)
+	}
+	if i.CopyEntries && !i.AllowSharedEntries {
+		shared, err := c.sharedEntryContainers(dstCtx, i, src, dst, dstVersion)
+		if err != nil {
+			return res, fmt.Errorf("listing destination versions: %w", err)
+		}
+		if len(shared) > 0 {
+			return res, fmt.Errorf("%w: %s", ErrSharedEntries, strings.Join(shared, ", "))
+		}
+	}
+
+	return res, c.copySnapshot(dstCtx, i, src, dst, res)
+}
+
+// sharedEntryContainers returns the dictionaries and ACLs of the destination
+// version which src copies entries into, and which a locked, active or
+// staged version also has, e.g. `dictionary "config" (version 1)`.
+func (c *Client) sharedEntryContainers(ctx context.Context, i *CopyServiceConfigInput, src, dst *ServiceVersionSnapshot, serviceVersion int) ([]string, error) {
+	// reused maps the IDs of the reused dictionaries and ACLs to their
+	// descriptions.
+	reused := map[string]string{}
+	if !slices.Contains(i.Skip, "dictionary") {
+		for _, d := range src.Dictionaries {
+			name := ToValue(d.Dictionary.Name)
+			n := slices.IndexFunc(dst.Dictionaries, func(ds *DictionarySnapshot) bool { return ToValue(ds.Dictionary.Name) == name })
+			if len(d.Items) > 0 && n >= 0 {
+				reused[ToValue(dst.Dictionaries[n].Dictionary.DictionaryID)] = fmt.Sprintf("dictionary %q", name)
+			}
+		}
+	}
+	if !slices.Contains(i.Skip, "acl") {
+		for _, a := range src.ACLs {
+			name := ToValue(a.ACL.Name)
+			n := slices.IndexFunc(dst.ACLs, func(as *ACLSnapshot) bool { return ToValue(as.ACL.Name) == name })
+			if len(a.Entries) > 0 && n >= 0 {
+				reused[ToValue(dst.ACLs[n].ACL.ACLID)] = fmt.Sprintf("acl %q", name)
+			}
+		}
+	}
+	if len(reused) == 0 {
+		return nil, nil
+	}
+
+	serviceID := i.Destination.ServiceID
+	versions, err := c.ListVersions(ctx, &ListVersionsInput{ServiceID: serviceID})
+	if err != nil {
+		return nil, err
+	}
+	var shared []string
+	// The newest versions are the most likely to share them.
+	for _, v := range slices.Backward(versions) {
+		number := ToValue(v.Number)
+		if number == serviceVersion || !ToValue(v.Locked) && !ToValue(v.Active) && !ToValue(v.Staging) {
+			continue
+		}
+		var ids []string
+		dicts, err := c.ListDictionaries(ctx, &ListDictionariesInput{ServiceID: serviceID, ServiceVersion: number})
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dicts {
+			ids = append(ids, ToValue(d.DictionaryID))
+		}
+		acls, err := c.ListACLs(ctx, &ListACLsInput{ServiceID: serviceID, ServiceVersion: number})
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range acls {
+			ids = append(ids, ToValue(a.ACLID))
+		}
+		for _, id := range ids {
+			if desc, ok := reused[id]; ok {
+				shared = append(shared, fmt.Sprintf("%s (version %d)", desc, number))
+				delete(reused, id)
+			}
+		}
+		if len(reused) == 0 {
+			break
+		}
+	}
+	return shared, nil
+}
+
+// copySnapshot merges the objects of src into the destination version, whose
+// current objects are dst.
+func (c *Client) copySnapshot(ctx context.Context, i *CopyServiceConfigInput, src, dst *ServiceVersionSnapshot, res *CopyServiceConfigResult) error {
+	serviceID, serviceVersion := i.Destination.ServiceID, ToValue(res.Version.Number)
+	skip := func(kind string) bool { return slices.Contains(i.Skip, kind) }
+
+	if !skip("dictionary") {
+		for _, d := range src.Dictionaries {
+			if err := c.copyDictionary(ctx, serviceID, serviceVersion, d, dst, res); err != nil {
+				return fmt.Errorf("copying dictionary %q: %w", ToValue(d.Dictionary.Name), err)
+			}
+		}
+	}
+	if !skip("acl") {
+		for _, a := range src.ACLs {
+			if err := c.copyACL(ctx, serviceID, serviceVersion, a, dst, res); err != nil {
+				return fmt.Errorf("copying acl %q: %w", ToValue(a.ACL.Name), err)
+			}
+		}
+	}
+
+	if src.Config != nil {
+		desired := &ServiceConfig{}
+		for _, k := range configKinds {
+			want, ok := k.objects(src.Config)
+			if !ok || skip(k.name) {
+				continue
+			}
+			have, _ := k.objects(dst.Config)
+			for _, obj := range have {
+				name := configObjectName(obj)
+				if !slices.ContainsFunc(want, func(o any) bool { return configObjectName(o) == name }) {
+					want = append(want, obj)
+				}
+			}
+			k.setObjects(desired, want)
+		}
+		plan := planServiceConfig(serviceID, serviceVersion, dst.Config, desired)
+		for _, cc := range plan.Changes {
+			if err := c.applyConfigChange(ctx, serviceID, serviceVersion, cc); err != nil {
+				return fmt.Errorf("copying %s %q: %w", cc.Kind, cc.Name, err)
+			}
+		}
+		if !skip("snippet") {
+			if err := c.copyDynamicSnippets(ctx, serviceID, src.Config.Snippets, dst.Config.Snippets, plan); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !skip("vcl") {
+		for _, vcl := range src.VCLs {
+			if err := c.copyVCL(ctx, serviceID, serviceVersion, vcl, dst); err != nil {
+				return fmt.Errorf("copying vcl %q: %w", ToValue(vcl.Name), err)
+			}
+		}
+	}
+
+	if st := src.Settings; st != nil && !skip("settings") {
+		_, err := c.UpdateSettings(ctx, &UpdateSettingsInput{
+			DefaultHost:     st.DefaultHost,
+			DefaultTTL:      st.DefaultTTL,
+			ServiceID:       serviceID,
+			ServiceVersion:  serviceVersion,
+			StaleIfError:    st.StaleIfError,
+			StaleIfErrorTTL: st.StaleIfErrorTTL,
+		})
+		if err != nil {
+			return fmt.Errorf("copying settings: %w", err)
+		}
+	}
+
+	if !skip("resource") {
+		for _, r := range src.Resources {
+			if err := c.copyResource(ctx, serviceID, serviceVersion, r, i.ResourceIDs, dst); err != nil {
+				return fmt.Errorf("copying resource %q: %w", ToValue(r.Name), err)
+			}
+		}
+	}
+	return nil
+}
+
+// copyDictionary copies a dictionary, and its items if any, to the given
+// version, reusing a dictionary of the same name in dst.
+func (c *Client) copyDictionary(ctx context.Context, serviceID string, serviceVersion int, d *DictionarySnapshot, dst *ServiceVersionSnapshot, res *CopyServiceConfigResult) error {
+	idx := slices.IndexFunc(dst.Dictionaries, func(ds *DictionarySnapshot) bool {
+		return ToValue(ds.Dictionary.Name) == ToValue(d.Dictionary.Name)
+	})
+	if idx < 0 {
+		dict, err := c.restoreDictionary(ctx, serviceID, serviceVersion, d)
+		if dict != nil {
+			res.DictionaryIDs[ToValue(d.Dictionary.DictionaryID)] = ToValue(dict.DictionaryID)
+		}
+		return err
+	}
+
+	id := ToValue(dst.Dictionaries[idx].Dictionary.DictionaryID)
+	res.DictionaryIDs[ToValue(d.Dictionary.DictionaryID)] = id
+	return c.writeDictionaryItems(ctx, serviceID, id, d.Items, UpsertBatchOperation)
+}
+
+// copyACL copies an ACL, and its entries if any, to the given version,
+// reusing an ACL of the same name in dst. Entries already in the ACL are not
+// copied again.
+func (c *Client) copyACL(ctx context.Context, serviceID string, serviceVersion int, a *ACLSnapshot, dst *ServiceVersionSnapshot, res *CopyServiceConfigResult) error {
+	idx := slices.IndexFunc(dst.ACLs, func(as *ACLSnapshot) bool {
+		return ToValue(as.ACL.Name) == ToValue(a.ACL.Name)
+	})
+	if idx < 0 {
+		acl, err := c.restoreACL(ctx, serviceID, serviceVersion, a)
+		if acl != nil {
+			res.ACLIDs[ToValue(a.ACL.ACLID)] = ToValue(acl.ACLID)
+		}
+		return err
+	}
+
+	id := ToValue(dst.ACLs[idx].ACL.ACLID)
+	res.ACLIDs[ToValue(a.ACL.ACLID)] = id
+	if len(a.Entries) == 0 {
+		return nil
+	}
+	existing, err := c.ListACLEntries(ctx, &ListACLEntriesInput{ServiceID: serviceID, ACLID: id})
+	if err != nil {
+		return err
+	}
+	entries := slices.DeleteFunc(slices.Clone(a.Entries), func(e *ACLEntry) bool {
+		return slices.ContainsFunc(existing, func(x *ACLEntry) bool {
+			return ToValue(x.IP) == ToValue(e.IP) && ToValue(x.Subnet) == ToValue(e.Subnet)
+		})
+	})
+	return c.createACLEntries(ctx, serviceID, id, entries)
+}
+
+// copyDynamicSnippets sets the content of the dynamic snippets of dst, which
+// the plan does not cover, to that of the snippets of the same name in src.
+// Snippets replaced by the plan were created with their content.
+func (c *Client) copyDynamicSnippets(ctx context.Context, serviceID string, src, dst []*Snippet, plan *ServiceConfigPlan) error {
+	for _, s := range src {
+		name := ToValue(s.Name)
+		n := slices.IndexFunc(dst, func(d *Snippet) bool { return ToValue(d.Name) == name })
+		if !isDynamicSnippet(s) || n < 0 || !isDynamicSnippet(dst[n]) || ToValue(dst[n].Content) == ToValue(s.Content) {
+			continue
+		}
+		if slices.ContainsFunc(plan.Changes, func(cc *ConfigChange) bool {
+			return cc.Kind == "snippet" && cc.Name == name && cc.Action == ConfigChangeReplace
+		}) {
+			continue
+		}
+		_, err := c.UpdateDynamicSnippet(ctx, &UpdateDynamicSnippetInput{Content: s.Content, ServiceID: serviceID, SnippetID: ToValue(dst[n].SnippetID)})
+		if err != nil {
+			return fmt.Errorf("copying content of dynamic snippet %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// copyVCL creates a custom VCL on the given version, or updates the VCL of
+// the same name in dst.
+func (c *Client) copyVCL(ctx context.Context, serviceID string, serviceVersion int, vcl *VCL, dst *ServiceVersionSnapshot) error {
+	name := ToValue(vcl.Name)
+	idx := slices.IndexFunc(dst.VCLs, func(v *VCL) bool { return ToValue(v.Name) == name })
+	if idx < 0 {
+		_, err := c.CreateVCL(ctx, &CreateVCLInput{
+			Content:        vcl.Content,
+			Main:           vcl.Main,
+			Name:           vcl.Name,
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion,
+		})
+		return err
+	}
+
+	cur := dst.VCLs[idx]
+	if ToValue(cur.Content) != ToValue(vcl.Content) {
+		_, err := c.UpdateVCL(ctx, &UpdateVCLInput{Content: vcl.Content, Name: name, ServiceID: serviceID, ServiceVersion: serviceVersion})
+		if err != nil {
+			return err
+		}
+	}
+	if ToValue(vcl.Main) && !ToValue(cur.Main) {
+		_, err := c.ActivateVCL(ctx, &ActivateVCLInput{Name: name, ServiceID: serviceID, ServiceVersion: serviceVersion})
+		return err
+	}
+	return nil
+}
+
+// copyResource links a resource to the given version, mapping its ID with
+// ids. A link of the same name in dst is replaced if it links another
+// resource.
+func (c *Client) copyResource(ctx context.Context, serviceID string, serviceVersion int, r *Resource, ids map[string]string, dst *ServiceVersionSnapshot) error {
+	id := ToValue(r.ResourceID)
+	if mapped, ok := ids[id]; ok {
+		id = mapped
+	}
+	idx := slices.IndexFunc(dst.Resources, func(x *Resource) bool { return ToValue(x.Name) == ToValue(r.Name) })
+	if idx >= 0 {
+		cur := dst.Resources[idx]
+		if ToValue(cur.ResourceID) == id {
+			return nil
+		}
+		err := c.DeleteResource(ctx, &DeleteResourceInput{ResourceID: ToValue(cur.LinkID), ServiceID: serviceID, ServiceVersion: serviceVersion})
+		if err != nil {
+			return err
+		}
+	}
+	_, err := c.CreateResource(ctx, &CreateResourceInput{
+		Name:           r.Name,
+		ResourceID:     &id,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	return err
+}
+
+// copyKinds returns the kinds of objects copied by CopyServiceConfig.
+func copyKinds() []string {
+	kinds := []string{"settings"}
+	for _, k := range configKinds {
+		kinds = append(kinds, k.name)
+	}
+	return append(kinds, "vcl", "dictionary", "acl", "resource")
+}
+
+// customerContext returns ctx for impersonating customerID, or ctx itself if
+// customerID is empty.
+func customerContext(ctx context.Context, customerID string) context.Context {
+	if customerID == "" {
+		return ctx
+	}
+	return impersonation.NewContextForCustomerID(ctx, customerID)
+}
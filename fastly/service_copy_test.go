@@ -0,0 +1,172 @@
+package fastly_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_CopyServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("source")})
+	require.NoError(t, err)
+	srcID := *svc.ServiceID
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("origin"), Address: fastly.ToPointer("origin.example.com")})
+	require.NoError(t, err)
+	_, err = c.CreateVCL(ctx, &fastly.CreateVCLInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("main"), Content: fastly.ToPointer("sub vcl_recv {\n  #FASTLY recv\n}\n"), Main: fastly.ToPointer(true)})
+	require.NoError(t, err)
+	_, err = c.CreateResource(ctx, &fastly.CreateResourceInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("kv"), ResourceID: fastly.ToPointer("store123")})
+	require.NoError(t, err)
+	dict, err := c.CreateDictionary(ctx, &fastly.CreateDictionaryInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("config")})
+	require.NoError(t, err)
+	_, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{ServiceID: srcID, DictionaryID: *dict.DictionaryID, ItemKey: fastly.ToPointer("k"), ItemValue: fastly.ToPointer("v")})
+	require.NoError(t, err)
+	acl, err := c.CreateACL(ctx, &fastly.CreateACLInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("block")})
+	require.NoError(t, err)
+	_, err = c.CreateACLEntry(ctx, &fastly.CreateACLEntryInput{ServiceID: srcID, ACLID: *acl.ACLID, IP: fastly.ToPointer("192.0.2.0"), Subnet: fastly.ToPointer(24)})
+	require.NoError(t, err)
+	dyn, err := c.CreateSnippet(ctx, &fastly.CreateSnippetInput{ServiceID: srcID, ServiceVersion: 1, Name: fastly.ToPointer("dyn"), Type: fastly.ToPointer(fastly.SnippetTypeRecv), Dynamic: fastly.ToPointer(1), Content: fastly.ToPointer("set req.http.X = \"1\";")})
+	require.NoError(t, err)
+
+	svc, err = c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("destination")})
+	require.NoError(t, err)
+	dstID := *svc.ServiceID
+
+	// Requests for each service impersonate its customer.
+	var (
+		mu        sync.Mutex
+		customers = map[string]map[string]bool{}
+	)
+	c.Use(func(next fastly.RequestHandler) fastly.RequestHandler {
+		return func(ctx context.Context, info *fastly.RequestInfo) (*http.Response, error) {
+			for _, id := range []string{srcID, dstID} {
+				if strings.HasPrefix(info.Path, "/service/"+id+"/") {
+					mu.Lock()
+					if customers[id] == nil {
+						customers[id] = map[string]bool{}
+					}
+					customers[id][info.CustomerID] = true
+					mu.Unlock()
+				}
+			}
+			return next(ctx, info)
+		}
+	})
+
+	_, err = c.CopyServiceConfig(ctx, &fastly.CopyServiceConfigInput{Source: fastly.ServiceVersionRef{ServiceID: srcID}, Destination: fastly.ServiceVersionRef{ServiceID: dstID}})
+	require.ErrorIs(t, err, fastly.ErrMissingServiceVersion)
+	_, err = c.CopyServiceConfig(ctx, &fastly.CopyServiceConfigInput{
+		Source:      fastly.ServiceVersionRef{CustomerID: "a", ServiceID: srcID, ServiceVersion: 1},
+		Destination: fastly.ServiceVersionRef{CustomerID: "b", ServiceID: dstID},
+	})
+	require.ErrorContains(t, err, "store123")
+	_, err = c.CopyServiceConfig(ctx, &fastly.CopyServiceConfigInput{
+		Source:      fastly.ServiceVersionRef{ServiceID: srcID, ServiceVersion: 1},
+		Destination: fastly.ServiceVersionRef{ServiceID: dstID},
+		Skip:        []string{"nothing"},
+	})
+	require.ErrorContains(t, err, `unknown kind "nothing"`)
+
+	res, err := c.CopyServiceConfig(ctx, &fastly.CopyServiceConfigInput{
+		CopyEntries: true,
+		Source:      fastly.ServiceVersionRef{CustomerID: "a", ServiceID: srcID, ServiceVersion: 1},
+		Destination: fastly.ServiceVersionRef{CustomerID: "b", ServiceID: dstID},
+		ResourceIDs: map[string]string{"store123": "store456"},
+		Skip:        []string{"domain"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, *res.Version.Number)
+	require.Equal(t, "Copied from version 1 of service "+srcID, *res.Version.Comment)
+	require.Equal(t, map[string]bool{"a": true}, customers[srcID])
+	require.Equal(t, map[string]bool{"b": true}, customers[dstID])
+
+	snap, err := c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: dstID, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.Empty(t, snap.Config.Domains)
+	require.Equal(t, "origin", *snap.Config.Backends[0].Name)
+	require.True(t, *snap.VCLs[0].Main)
+	require.Equal(t, "store456", *snap.Resources[0].ResourceID)
+	require.Equal(t, "v", *snap.Dictionaries[0].Items[0].ItemValue)
+	require.Equal(t, "192.0.2.0", *snap.ACLs[0].Entries[0].IP)
+	dstDict, err := c.GetDictionary(ctx, &fastly.GetDictionaryInput{ServiceID: dstID, ServiceVersion: 2, Name: "config"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{*dict.DictionaryID: *dstDict.DictionaryID}, res.DictionaryIDs)
+	require.Len(t, res.ACLIDs, 1)
+	require.Equal(t, `set req.http.X = "1";`, *snap.Config.Snippets[0].Content)
+
+	// Copying into an existing draft merges the objects, keeping those only
+	// in the destination and reusing dictionaries and ACLs by name.
+	_, err = c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: dstID, ServiceVersion: 2, Name: fastly.ToPointer("kept")})
+	require.NoError(t, err)
+	_, err = c.UpdateBackend(ctx, &fastly.UpdateBackendInput{ServiceID: srcID, ServiceVersion: 1, Name: "origin", Address: fastly.ToPointer("new.example.com")})
+	require.NoError(t, err)
+	_, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{ServiceID: srcID, DictionaryID: *dict.DictionaryID, ItemKey: fastly.ToPointer("k2"), ItemValue: fastly.ToPointer("v2")})
+	require.NoError(t, err)
+	_, err = c.UpdateDynamicSnippet(ctx, &fastly.UpdateDynamicSnippetInput{ServiceID: srcID, SnippetID: *dyn.SnippetID, Content: fastly.ToPointer(`set req.http.X = "2";`)})
+	require.NoError(t, err)
+
+	res, err = c.CopyServiceConfig(ctx, &fastly.CopyServiceConfigInput{
+		CopyEntries: true,
+		Source:      fastly.ServiceVersionRef{ServiceID: srcID, ServiceVersion: 1},
+		Destination: fastly.ServiceVersionRef{ServiceID: dstID, ServiceVersion: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, *dstDict.DictionaryID, res.DictionaryIDs[*dict.DictionaryID])
+
+	snap, err = c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: dstID, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.Len(t, snap.Config.Domains, 1)
+	require.Equal(t, "kept", *snap.Config.Headers[0].Name)
+	require.Equal(t, "new.example.com", *snap.Config.Backends[0].Address)
+	require.Len(t, snap.VCLs, 1)
+	require.Len(t, snap.Resources, 1)
+	require.Len(t, snap.Dictionaries, 1)
+	require.Len(t, snap.Dictionaries[0].Items, 2)
+	require.Len(t, snap.ACLs, 1)
+	require.Len(t, snap.ACLs[0].Entries, 1)
+	require.Equal(t, `set req.http.X = "2";`, *snap.Config.Snippets[0].Content)
+
+	// Entries are not copied into dictionaries and ACLs shared with the
+	// active version unless that is allowed.
+	_, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{ServiceID: srcID, DictionaryID: *dict.DictionaryID, ItemKey: fastly.ToPointer("k3"), ItemValue: fastly.ToPointer("v3")})
+	require.NoError(t, err)
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: dstID, ServiceVersion: 2})
+	require.NoError(t, err)
+	_, err = c.CloneVersion(ctx, &fastly.CloneVersionInput{ServiceID: dstID, ServiceVersion: 2})
+	require.NoError(t, err)
+
+	copyInput := &fastly.CopyServiceConfigInput{
+		CopyEntries: true,
+		Source:      fastly.ServiceVersionRef{ServiceID: srcID, ServiceVersion: 1},
+		Destination: fastly.ServiceVersionRef{ServiceID: dstID, ServiceVersion: 3},
+	}
+	_, err = c.CopyServiceConfig(ctx, copyInput)
+	require.ErrorIs(t, err, fastly.ErrSharedEntries)
+	require.ErrorContains(t, err, `dictionary "config" (version 2), acl "block" (version 2)`)
+	items, err := c.ListDictionaryItems(ctx, &fastly.ListDictionaryItemsInput{ServiceID: dstID, DictionaryID: *dstDict.DictionaryID})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	copyInput.AllowSharedEntries = true
+	_, err = c.CopyServiceConfig(ctx, copyInput)
+	require.NoError(t, err)
+	items, err = c.ListDictionaryItems(ctx, &fastly.ListDictionaryItemsInput{ServiceID: dstID, DictionaryID: *dstDict.DictionaryID})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+}
@@ -3,6 +3,7 @@ package fastly
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -82,6 +83,12 @@ func (c *Client) GetServices(ctx context.Context, i *GetServicesInput) *ListPagi
 	return NewPaginator[Service](ctx, c, input, "/service")
 }
 
+// AllServices returns an iterator over all services, fetching them a page at
+// a time (see Paginate).
+func (c *Client) AllServices(ctx context.Context) iter.Seq2[*Service, error] {
+	return c.GetServices(ctx, &GetServicesInput{}).All(ctx)
+}
+
 // ListServicesInput is used as input to the ListServices function.
 type ListServicesInput struct {
 	// Direction is the direction in which to sort results.
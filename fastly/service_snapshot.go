@@ -0,0 +1,677 @@
+package fastly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SnapshotFormatVersion is the version of the document format used to
+// encode a ServiceVersionSnapshot. Documents with a newer format version are
+// rejected when decoded.
+const SnapshotFormatVersion = 1
+
+// ServiceVersionSnapshot is a point-in-time copy of every configuration
+// object attached to a service version. It is created by
+// ExportServiceVersion and recreated by RestoreServiceVersion.
+//
+// A snapshot is encoded as a versioned document with encoding/json, or with
+// gopkg.in/yaml.v3, and decoded from either format with
+// ParseServiceVersionSnapshot. Objects are encoded with the field names used
+// by the API. Timestamps, IDs assigned by the API and the IDs of the
+// service and version an object belongs to are left out, so that snapshots
+// of versions with the same configuration only differ in their header.
+type ServiceVersionSnapshot struct {
+	// ACLs are the ACLs of the version and their entries.
+	ACLs []*ACLSnapshot
+	// Config holds the configuration objects of the version. Every kind is
+	// set, so that it can be used as the desired configuration of
+	// PlanServiceConfig.
+	Config *ServiceConfig
+	// Dictionaries are the dictionaries of the version and their items.
+	Dictionaries []*DictionarySnapshot
+	// ExportedAt is the time the snapshot was taken.
+	ExportedAt time.Time
+	// FormatVersion is the version of the document format the snapshot was
+	// decoded from, or SnapshotFormatVersion for exported snapshots.
+	FormatVersion int
+	// Package is the metadata of the Compute package of the version, or
+	// nil if the service is not a Compute service. The package itself is
+	// not included.
+	Package *Package
+	// Resources are the resource links of the version.
+	Resources []*Resource
+	// ServiceID is the ID of the exported service.
+	ServiceID string
+	// ServiceName is the name of the exported service.
+	ServiceName string
+	// ServiceType is the type of the exported service (vcl, wasm).
+	ServiceType string
+	// ServiceVersion is the exported version.
+	ServiceVersion int
+	// Settings are the general settings of the version.
+	Settings *Settings
+	// VCLs are the custom VCL files of the version.
+	VCLs []*VCL
+}
+
+// DictionarySnapshot is a dictionary in a ServiceVersionSnapshot.
+type DictionarySnapshot struct {
+	// Dictionary is the dictionary.
+	Dictionary *Dictionary
+	// Items are the items of the dictionary. Items of write-only
+	// dictionaries cannot be read, and are not exported.
+	Items []*DictionaryItem
+}
+
+// ACLSnapshot is an ACL in a ServiceVersionSnapshot.
+type ACLSnapshot struct {
+	// ACL is the ACL.
+	ACL *ACL
+	// Entries are the entries of the ACL.
+	Entries []*ACLEntry
+}
+
+// snapshotDocument is the encoded form of a ServiceVersionSnapshot.
+// Dictionaries and ACLs are encoded with their items and entries in an
+// "items" or "entries" field.
+type snapshotDocument struct {
+	FormatVersion  int                         `json:"format_version"`
+	ExportedAt     time.Time                   `json:"exported_at"`
+	ServiceID      string                      `json:"service_id"`
+	ServiceName    string                      `json:"service_name,omitempty"`
+	ServiceType    string                      `json:"service_type,omitempty"`
+	ServiceVersion int                         `json:"service_version"`
+	Settings       map[string]any              `json:"settings,omitempty"`
+	Config         map[string][]map[string]any `json:"config,omitempty"`
+	VCLs           []map[string]any            `json:"vcls,omitempty"`
+	Dictionaries   []map[string]any            `json:"dictionaries,omitempty"`
+	ACLs           []map[string]any            `json:"acls,omitempty"`
+	Resources      []map[string]any            `json:"resources,omitempty"`
+	Package        map[string]any              `json:"package,omitempty"`
+}
+
+// snapshotOmittedFields are the fields of objects which are not encoded in
+// snapshots.
+var snapshotOmittedFields = []string{"acl_id", "created_at", "deleted_at", "dictionary_id", "id", "service_id", "updated_at", "version"}
+
+// MarshalJSON encodes the snapshot as a JSON document.
+func (s *ServiceVersionSnapshot) MarshalJSON() ([]byte, error) {
+	doc := snapshotDocument{
+		FormatVersion:  s.FormatVersion,
+		ExportedAt:     s.ExportedAt,
+		ServiceID:      s.ServiceID,
+		ServiceName:    s.ServiceName,
+		ServiceType:    s.ServiceType,
+		ServiceVersion: s.ServiceVersion,
+		Settings:       snapshotObject(s.Settings),
+		VCLs:           snapshotObjects(s.VCLs),
+		Resources:      snapshotObjects(s.Resources),
+		Package:        snapshotObject(s.Package),
+	}
+	if s.Config != nil {
+		doc.Config = map[string][]map[string]any{}
+		for _, k := range configKinds {
+			if objs, ok := k.objects(s.Config); ok {
+				doc.Config[k.name] = snapshotObjects(objs)
+			}
+		}
+	}
+	for _, d := range s.Dictionaries {
+		obj := snapshotObject(d.Dictionary)
+		obj["items"] = snapshotObjects(d.Items)
+		doc.Dictionaries = append(doc.Dictionaries, obj)
+	}
+	for _, a := range s.ACLs {
+		obj := snapshotObject(a.ACL)
+		obj["entries"] = snapshotObjects(a.Entries)
+		doc.ACLs = append(doc.ACLs, obj)
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON decodes a snapshot from a JSON document.
+func (s *ServiceVersionSnapshot) UnmarshalJSON(data []byte) error {
+	var doc snapshotDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc.FormatVersion < 1 || doc.FormatVersion > SnapshotFormatVersion {
+		return fmt.Errorf("unsupported snapshot format version %d", doc.FormatVersion)
+	}
+
+	out := ServiceVersionSnapshot{
+		ExportedAt:     doc.ExportedAt,
+		FormatVersion:  doc.FormatVersion,
+		ServiceID:      doc.ServiceID,
+		ServiceName:    doc.ServiceName,
+		ServiceType:    doc.ServiceType,
+		ServiceVersion: doc.ServiceVersion,
+	}
+	if doc.Settings != nil {
+		if err := decodeMap(doc.Settings, &out.Settings); err != nil {
+			return fmt.Errorf("decoding settings: %w", err)
+		}
+	}
+	if doc.Package != nil {
+		if err := decodeMap(doc.Package, &out.Package); err != nil {
+			return fmt.Errorf("decoding package: %w", err)
+		}
+	}
+	if err := decodeMap(doc.VCLs, &out.VCLs); err != nil {
+		return fmt.Errorf("decoding vcls: %w", err)
+	}
+	if err := decodeMap(doc.Resources, &out.Resources); err != nil {
+		return fmt.Errorf("decoding resources: %w", err)
+	}
+
+	if doc.Config != nil {
+		out.Config = &ServiceConfig{}
+		cfg := reflect.ValueOf(out.Config).Elem()
+		for _, k := range configKinds {
+			objs, ok := doc.Config[k.name]
+			if !ok {
+				continue
+			}
+			if err := decodeMap(objs, cfg.FieldByName(k.field).Addr().Interface()); err != nil {
+				return fmt.Errorf("decoding %s: %w", k.name, err)
+			}
+		}
+	}
+
+	for _, obj := range doc.Dictionaries {
+		d := &DictionarySnapshot{}
+		if err := decodeMap(obj["items"], &d.Items); err != nil {
+			return fmt.Errorf("decoding dictionary items: %w", err)
+		}
+		delete(obj, "items")
+		if err := decodeMap(obj, &d.Dictionary); err != nil {
+			return fmt.Errorf("decoding dictionary: %w", err)
+		}
+		out.Dictionaries = append(out.Dictionaries, d)
+	}
+	for _, obj := range doc.ACLs {
+		a := &ACLSnapshot{}
+		if err := decodeMap(obj["entries"], &a.Entries); err != nil {
+			return fmt.Errorf("decoding acl entries: %w", err)
+		}
+		delete(obj, "entries")
+		if err := decodeMap(obj, &a.ACL); err != nil {
+			return fmt.Errorf("decoding acl: %w", err)
+		}
+		out.ACLs = append(out.ACLs, a)
+	}
+
+	*s = out
+	return nil
+}
+
+// MarshalYAML encodes the snapshot as a YAML document with the same
+// structure as its JSON encoding. It implements the yaml.v3 Marshaler
+// interface.
+func (s *ServiceVersionSnapshot) MarshalYAML() (any, error) {
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	// JSON is decoded with flow and quoted styles; reset them so that the
+	// document is written in block style.
+	var reset func(n *yaml.Node)
+	reset = func(n *yaml.Node) {
+		n.Style = 0
+		for _, c := range n.Content {
+			reset(c)
+		}
+	}
+	reset(&doc)
+	return doc.Content[0], nil
+}
+
+// UnmarshalYAML decodes a snapshot from a YAML document. It implements the
+// yaml.v3 Unmarshaler interface.
+func (s *ServiceVersionSnapshot) UnmarshalYAML(value *yaml.Node) error {
+	var v any
+	if err := value.Decode(&v); err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
+
+// ParseServiceVersionSnapshot decodes a snapshot encoded as JSON or YAML.
+func ParseServiceVersionSnapshot(data []byte) (*ServiceVersionSnapshot, error) {
+	s := &ServiceVersionSnapshot{}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, s); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// snapshotObjects encodes the objects of a slice with snapshotObject.
+func snapshotObjects[T any](objs []T) []map[string]any {
+	out := make([]map[string]any, 0, len(objs))
+	for _, o := range objs {
+		out = append(out, snapshotObject(o))
+	}
+	return out
+}
+
+// snapshotObject encodes a pointer to a mapstructure tagged struct as a map
+// keyed by the tags of its fields. Nil fields and snapshotOmittedFields are
+// left out. It returns nil if obj is nil.
+func snapshotObject(obj any) map[string]any {
+	v := reflect.ValueOf(obj)
+	if !v.IsValid() || v.IsNil() {
+		return nil
+	}
+	v = v.Elem()
+	out := map[string]any{}
+	for i := range v.NumField() {
+		tag := fieldTag(v.Type().Field(i), "mapstructure")
+		if tag == "" || slices.Contains(snapshotOmittedFields, tag) || isNilField(v.Field(i)) {
+			continue
+		}
+		out[tag] = snapshotValue(v.Field(i))
+	}
+	return out
+}
+
+// snapshotValue returns the value of a field for snapshotObject. Pointers
+// are dereferenced, nested structs are encoded with snapshotObject, and
+// values of named basic types, such as Compatibool, are converted to their
+// underlying type so that they are not encoded with custom marshalers.
+func snapshotValue(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer {
+		if v.Elem().Kind() == reflect.Struct && v.Type().Elem() != reflect.TypeFor[time.Time]() {
+			return snapshotObject(v.Interface())
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	}
+	return v.Interface()
+}
+
+// ExportServiceVersionInput is used as input to the ExportServiceVersion
+// function.
+type ExportServiceVersionInput struct {
+	// IncludeSecrets exports the credentials of configuration objects, such
+	// as the passwords and tokens of logging endpoints, in plaintext. By
+	// default they are replaced with RedactedValue.
+	IncludeSecrets bool
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// ExportServiceVersion collects every configuration object attached to a
+// service version into a snapshot: the objects of a ServiceConfig, the
+// settings, custom VCLs, dictionaries and their items, ACLs and their
+// entries, resource links, and the metadata of the Compute package. The
+// current content of dynamic snippets is included.
+//
+// Unless IncludeSecrets is set, the values of the credential fields of
+// configuration objects, named as in DefaultRedactor, are masked. Such a
+// snapshot can only be restored once the secrets are supplied again.
+func (c *Client) ExportServiceVersion(ctx context.Context, i *ExportServiceVersionInput) (*ServiceVersionSnapshot, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+	return c.exportServiceVersion(ctx, i, true)
+}
+
+// exportServiceVersion implements ExportServiceVersion. Dictionary items and
+// ACL entries are only collected if entries is true.
+func (c *Client) exportServiceVersion(ctx context.Context, i *ExportServiceVersionInput, entries bool) (*ServiceVersionSnapshot, error) {
+	svc, err := c.GetService(ctx, &GetServiceInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return nil, err
+	}
+	s := &ServiceVersionSnapshot{
+		ExportedAt:     time.Now().UTC().Truncate(time.Second),
+		FormatVersion:  SnapshotFormatVersion,
+		ServiceID:      i.ServiceID,
+		ServiceName:    ToValue(svc.Name),
+		ServiceType:    ToValue(svc.Type),
+		ServiceVersion: i.ServiceVersion,
+	}
+
+	s.Config, err = c.GetServiceConfig(ctx, &GetServiceConfigInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, err
+	}
+	for _, sn := range s.Config.Snippets {
+		if ToValue(sn.Dynamic) != 1 {
+			continue
+		}
+		// The content of dynamic snippets is not versioned, and is not
+		// returned with them.
+		dyn, err := c.GetDynamicSnippet(ctx, &GetDynamicSnippetInput{ServiceID: i.ServiceID, SnippetID: ToValue(sn.SnippetID)})
+		if err != nil {
+			return nil, fmt.Errorf("getting content of dynamic snippet %q: %w", ToValue(sn.Name), err)
+		}
+		sn.Content = dyn.Content
+	}
+	if !i.IncludeSecrets {
+		secretFields(s.Config, func(_ *configKind, _ any, _ string, f reflect.Value) {
+			f.Set(reflect.ValueOf(ToPointer(RedactedValue)))
+		})
+	}
+	s.Settings, err = c.GetSettings(ctx, &GetSettingsInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("getting settings: %w", err)
+	}
+	s.VCLs, err = c.ListVCLs(ctx, &ListVCLsInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("listing vcls: %w", err)
+	}
+	s.Resources, err = c.ListResources(ctx, &ListResourcesInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("listing resources: %w", err)
+	}
+
+	dicts, err := c.ListDictionaries(ctx, &ListDictionariesInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("listing dictionaries: %w", err)
+	}
+	for _, d := range dicts {
+		ds := &DictionarySnapshot{Dictionary: d}
+		if entries && !ToValue(d.WriteOnly) {
+			ds.Items, err = c.ListDictionaryItems(ctx, &ListDictionaryItemsInput{ServiceID: i.ServiceID, DictionaryID: ToValue(d.DictionaryID)})
+			if err != nil {
+				return nil, fmt.Errorf("listing items of dictionary %q: %w", ToValue(d.Name), err)
+			}
+		}
+		s.Dictionaries = append(s.Dictionaries, ds)
+	}
+
+	acls, err := c.ListACLs(ctx, &ListACLsInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("listing acls: %w", err)
+	}
+	for _, a := range acls {
+		as := &ACLSnapshot{ACL: a}
+		if entries {
+			as.Entries, err = c.ListACLEntries(ctx, &ListACLEntriesInput{ServiceID: i.ServiceID, ACLID: ToValue(a.ACLID)})
+			if err != nil {
+				return nil, fmt.Errorf("listing entries of acl %q: %w", ToValue(a.Name), err)
+			}
+		}
+		s.ACLs = append(s.ACLs, as)
+	}
+
+	if s.ServiceType == "wasm" {
+		s.Package, err = c.GetPackage(ctx, &GetPackageInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.IsNotFound() {
+			// No package has been uploaded to the version.
+			err = nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting package: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+// secretFields calls fn for each credential field of the objects of cfg
+// which is set, i.e. each non-empty string field named as one of the fields
+// of DefaultRedactor.
+func secretFields(cfg *ServiceConfig, fn func(k *configKind, obj any, tag string, f reflect.Value)) {
+	r := DefaultRedactor()
+	for _, k := range configKinds {
+		objs, _ := k.objects(cfg)
+		for _, obj := range objs {
+			v := reflect.ValueOf(obj).Elem()
+			for i := range v.NumField() {
+				f := v.Field(i)
+				tag := fieldTag(v.Type().Field(i), "mapstructure")
+				if tag == "" || !r.sensitive(tag) || f.Type() != reflect.TypeFor[*string]() || f.IsNil() || f.Elem().String() == "" {
+					continue
+				}
+				fn(k, obj, tag, f)
+			}
+		}
+	}
+}
+
+// RestoreServiceVersionInput is used as input to the RestoreServiceVersion
+// function.
+type RestoreServiceVersionInput struct {
+	// Comment is the comment of the new version. It defaults to a note
+	// naming the exported service and version.
+	Comment *string
+	// ServiceID is the ID of the service to restore the snapshot to, which
+	// may differ from the exported service (required).
+	ServiceID string
+	// Snapshot is the snapshot to restore (required).
+	Snapshot *ServiceVersionSnapshot
+}
+
+// RestoreServiceVersion creates a new, empty draft version of a service and
+// recreates the objects of a snapshot on it, returning the new version. If
+// an object cannot be created, the new version is returned along with the
+// error, so that it can be inspected or discarded.
+//
+// Compute packages are not part of snapshots, and must be uploaded to the
+// new version separately. Resource links refer to the same resources as in
+// the snapshot.
+//
+// Snapshots exported without IncludeSecrets hold masked credentials. They
+// must be replaced with the actual secrets in the snapshot's Config before
+// it is restored; otherwise ErrMaskedSecrets is returned and no version is
+// created.
+func (c *Client) RestoreServiceVersion(ctx context.Context, i *RestoreServiceVersionInput) (*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.Snapshot == nil {
+		return nil, ErrMissingSnapshot
+	}
+	s := i.Snapshot
+	if s.Config != nil {
+		var masked []string
+		secretFields(s.Config, func(k *configKind, obj any, tag string, f reflect.Value) {
+			if f.Elem().String() == RedactedValue {
+				masked = append(masked, fmt.Sprintf("%s %q (%s)", k.name, configObjectName(obj), tag))
+			}
+		})
+		if len(masked) > 0 {
+			return nil, fmt.Errorf("%w: %s", ErrMaskedSecrets, strings.Join(masked, ", "))
+		}
+	}
+
+	comment := i.Comment
+	if comment == nil {
+		comment = ToPointer(fmt.Sprintf("Restored from version %d of service %s", s.ServiceVersion, s.ServiceID))
+	}
+	v, err := c.CreateVersion(ctx, &CreateVersionInput{ServiceID: i.ServiceID, Comment: comment})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.restoreSnapshot(ctx, i.ServiceID, *v.Number, s); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// restoreSnapshot creates the objects of s on the given version.
+func (c *Client) restoreSnapshot(ctx context.Context, serviceID string, serviceVersion int, s *ServiceVersionSnapshot) error {
+	for _, d := range s.Dictionaries {
+		if _, err := c.restoreDictionary(ctx, serviceID, serviceVersion, d); err != nil {
+			return fmt.Errorf("restoring dictionary %q: %w", ToValue(d.Dictionary.Name), err)
+		}
+	}
+	for _, a := range s.ACLs {
+		if _, err := c.restoreACL(ctx, serviceID, serviceVersion, a); err != nil {
+			return fmt.Errorf("restoring acl %q: %w", ToValue(a.ACL.Name), err)
+		}
+	}
+
+	if s.Config != nil {
+		plan := planServiceConfig(serviceID, serviceVersion, &ServiceConfig{}, s.Config)
+		for _, cc := range plan.Changes {
+			if err := c.applyConfigChange(ctx, serviceID, serviceVersion, cc); err != nil {
+				return fmt.Errorf("restoring %s %q: %w", cc.Kind, cc.Name, err)
+			}
+		}
+	}
+
+	for _, vcl := range s.VCLs {
+		_, err := c.CreateVCL(ctx, &CreateVCLInput{
+			Content:        vcl.Content,
+			Main:           vcl.Main,
+			Name:           vcl.Name,
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("restoring vcl %q: %w", ToValue(vcl.Name), err)
+		}
+	}
+
+	if st := s.Settings; st != nil {
+		_, err := c.UpdateSettings(ctx, &UpdateSettingsInput{
+			DefaultHost:     st.DefaultHost,
+			DefaultTTL:      st.DefaultTTL,
+			ServiceID:       serviceID,
+			ServiceVersion:  serviceVersion,
+			StaleIfError:    st.StaleIfError,
+			StaleIfErrorTTL: st.StaleIfErrorTTL,
+		})
+		if err != nil {
+			return fmt.Errorf("restoring settings: %w", err)
+		}
+	}
+
+	for _, r := range s.Resources {
+		_, err := c.CreateResource(ctx, &CreateResourceInput{
+			Name:           r.Name,
+			ResourceID:     r.ResourceID,
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("restoring resource %q: %w", ToValue(r.Name), err)
+		}
+	}
+	return nil
+}
+
+// restoreDictionary creates a dictionary and its items on the given
+// version.
+func (c *Client) restoreDictionary(ctx context.Context, serviceID string, serviceVersion int, d *DictionarySnapshot) (*Dictionary, error) {
+	in := &CreateDictionaryInput{
+		Name:           d.Dictionary.Name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	}
+	if d.Dictionary.WriteOnly != nil {
+		in.WriteOnly = ToPointer(Compatibool(*d.Dictionary.WriteOnly))
+	}
+	dict, err := c.CreateDictionary(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return dict, c.writeDictionaryItems(ctx, serviceID, ToValue(dict.DictionaryID), d.Items, CreateBatchOperation)
+}
+
+// writeDictionaryItems writes items to a dictionary in batches, using op
+// for each item.
+func (c *Client) writeDictionaryItems(ctx context.Context, serviceID, dictionaryID string, items []*DictionaryItem, op BatchOperation) error {
+	for batch := range slices.Chunk(items, BatchModifyMaximumOperations) {
+		bitems := make([]*BatchDictionaryItem, len(batch))
+		for j, item := range batch {
+			bitems[j] = &BatchDictionaryItem{
+				ItemKey:   item.ItemKey,
+				ItemValue: item.ItemValue,
+				Operation: ToPointer(op),
+			}
+		}
+		err := c.BatchModifyDictionaryItems(ctx, &BatchModifyDictionaryItemsInput{
+			DictionaryID: dictionaryID,
+			Items:        bitems,
+			ServiceID:    serviceID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreACL creates an ACL and its entries on the given version.
+func (c *Client) restoreACL(ctx context.Context, serviceID string, serviceVersion int, a *ACLSnapshot) (*ACL, error) {
+	acl, err := c.CreateACL(ctx, &CreateACLInput{
+		Name:           a.ACL.Name,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return acl, c.createACLEntries(ctx, serviceID, ToValue(acl.ACLID), a.Entries)
+}
+
+// createACLEntries creates entries in an ACL in batches.
+func (c *Client) createACLEntries(ctx context.Context, serviceID, aclID string, entries []*ACLEntry) error {
+	for batch := range slices.Chunk(entries, BatchModifyMaximumOperations) {
+		bentries := make([]*BatchACLEntry, len(batch))
+		for j, e := range batch {
+			bentries[j] = &BatchACLEntry{
+				Comment:   e.Comment,
+				IP:        e.IP,
+				Operation: ToPointer(CreateBatchOperation),
+				Subnet:    e.Subnet,
+			}
+			if e.Negated != nil {
+				bentries[j].Negated = ToPointer(Compatibool(*e.Negated))
+			}
+		}
+		err := c.BatchModifyACLEntries(ctx, &BatchModifyACLEntriesInput{
+			ACLID:     aclID,
+			Entries:   bentries,
+			ServiceID: serviceID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
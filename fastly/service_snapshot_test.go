@@ -0,0 +1,156 @@
+package fastly_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_ExportRestoreServiceVersion(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("source")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("origin"),
+		Address:        fastly.ToPointer("origin.example.com"),
+		Port:           fastly.ToPointer(443),
+		UseSSL:         fastly.ToPointer(fastly.Compatibool(true)),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateVCL(ctx, &fastly.CreateVCLInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("main"),
+		Content:        fastly.ToPointer("sub vcl_recv {\n  #FASTLY recv\n}\n"),
+		Main:           fastly.ToPointer(true),
+	})
+	require.NoError(t, err)
+	_, err = c.UpdateSettings(ctx, &fastly.UpdateSettingsInput{ServiceID: id, ServiceVersion: 1, DefaultTTL: fastly.ToPointer(uint(60))})
+	require.NoError(t, err)
+	_, err = c.CreateResource(ctx, &fastly.CreateResourceInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("kv"), ResourceID: fastly.ToPointer("store123")})
+	require.NoError(t, err)
+
+	dict, err := c.CreateDictionary(ctx, &fastly.CreateDictionaryInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("config")})
+	require.NoError(t, err)
+	_, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{ServiceID: id, DictionaryID: *dict.DictionaryID, ItemKey: fastly.ToPointer("k"), ItemValue: fastly.ToPointer("v")})
+	require.NoError(t, err)
+	acl, err := c.CreateACL(ctx, &fastly.CreateACLInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("block")})
+	require.NoError(t, err)
+	_, err = c.CreateACLEntry(ctx, &fastly.CreateACLEntryInput{ServiceID: id, ACLID: *acl.ACLID, IP: fastly.ToPointer("192.0.2.0"), Subnet: fastly.ToPointer(24), Negated: fastly.ToPointer(fastly.Compatibool(true))})
+	require.NoError(t, err)
+
+	_, err = c.CreateSnippet(ctx, &fastly.CreateSnippetInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("dyn"),
+		Content:        fastly.ToPointer("set req.http.X-Dynamic = 1;"),
+		Type:           fastly.ToPointer(fastly.SnippetTypeRecv),
+		Dynamic:        fastly.ToPointer(1),
+	})
+	require.NoError(t, err)
+	_, err = c.CreateFTP(ctx, &fastly.CreateFTPInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("ftp"), Password: fastly.ToPointer("hunter2")})
+	require.NoError(t, err)
+
+	_, err = c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: id})
+	require.ErrorIs(t, err, fastly.ErrMissingServiceVersion)
+
+	snap, err := c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.Equal(t, fastly.SnapshotFormatVersion, snap.FormatVersion)
+	require.Equal(t, "source", snap.ServiceName)
+	require.Len(t, snap.Config.Backends, 1)
+	require.NotNil(t, snap.Config.Conditions)
+	require.EqualValues(t, 60, *snap.Settings.DefaultTTL)
+	require.True(t, *snap.VCLs[0].Main)
+	require.Equal(t, "store123", *snap.Resources[0].ResourceID)
+	require.Equal(t, "v", *snap.Dictionaries[0].Items[0].ItemValue)
+	require.Equal(t, "192.0.2.0", *snap.ACLs[0].Entries[0].IP)
+	require.Nil(t, snap.Package)
+	// The content of dynamic snippets is exported, and secrets are masked
+	// unless they are requested.
+	require.Equal(t, "set req.http.X-Dynamic = 1;", *snap.Config.Snippets[0].Content)
+	require.Equal(t, fastly.RedactedValue, *snap.Config.LoggingFTP[0].Password)
+	withSecrets, err := c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: id, ServiceVersion: 1, IncludeSecrets: true})
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", *withSecrets.Config.LoggingFTP[0].Password)
+
+	doc, err := json.Marshal(snap)
+	require.NoError(t, err)
+	require.NotContains(t, string(doc), *dict.DictionaryID)
+	require.NotContains(t, string(doc), "created_at")
+	require.NotContains(t, string(doc), "hunter2")
+
+	// JSON and YAML documents decode to the same snapshot.
+	fromJSON, err := fastly.ParseServiceVersionSnapshot(doc)
+	require.NoError(t, err)
+	out, err := json.Marshal(fromJSON)
+	require.NoError(t, err)
+	require.JSONEq(t, string(doc), string(out))
+
+	y, err := yaml.Marshal(snap)
+	require.NoError(t, err)
+	require.Contains(t, string(y), "format_version: 1\n")
+	require.Contains(t, string(y), "content: |\n")
+	fromYAML, err := fastly.ParseServiceVersionSnapshot(y)
+	require.NoError(t, err)
+	out, err = json.Marshal(fromYAML)
+	require.NoError(t, err)
+	require.JSONEq(t, string(doc), string(out))
+
+	_, err = fastly.ParseServiceVersionSnapshot([]byte(strings.Replace(string(doc), `"format_version":1`, `"format_version":2`, 1)))
+	require.ErrorContains(t, err, "unsupported snapshot format version 2")
+
+	// Restore to another service.
+	dst, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("destination")})
+	require.NoError(t, err)
+	_, err = c.RestoreServiceVersion(ctx, &fastly.RestoreServiceVersionInput{ServiceID: *dst.ServiceID})
+	require.ErrorIs(t, err, fastly.ErrMissingSnapshot)
+
+	// Masked secrets must be supplied again.
+	_, err = c.RestoreServiceVersion(ctx, &fastly.RestoreServiceVersionInput{ServiceID: *dst.ServiceID, Snapshot: fromYAML})
+	require.ErrorIs(t, err, fastly.ErrMaskedSecrets)
+	require.ErrorContains(t, err, `logging/ftp "ftp" (password)`)
+	fromYAML.Config.LoggingFTP[0].Password = fastly.ToPointer("hunter2")
+
+	v, err := c.RestoreServiceVersion(ctx, &fastly.RestoreServiceVersionInput{ServiceID: *dst.ServiceID, Snapshot: fromYAML})
+	require.NoError(t, err)
+	require.Equal(t, 2, *v.Number)
+	require.Equal(t, "Restored from version 1 of service "+id, *v.Comment)
+
+	restored, err := c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: *dst.ServiceID, ServiceVersion: 2})
+	require.NoError(t, err)
+	restored.ExportedAt = snap.ExportedAt
+	restored.ServiceID = snap.ServiceID
+	restored.ServiceName = snap.ServiceName
+	restored.ServiceVersion = snap.ServiceVersion
+	out, err = json.Marshal(restored)
+	require.NoError(t, err)
+	require.JSONEq(t, string(doc), string(out))
+
+	restored, err = c.ExportServiceVersion(ctx, &fastly.ExportServiceVersionInput{ServiceID: *dst.ServiceID, ServiceVersion: 2, IncludeSecrets: true})
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", *restored.Config.LoggingFTP[0].Password)
+	dyn, err := c.GetDynamicSnippet(ctx, &fastly.GetDynamicSnippetInput{ServiceID: *dst.ServiceID, SnippetID: *restored.Config.Snippets[0].SnippetID})
+	require.NoError(t, err)
+	require.Equal(t, "set req.http.X-Dynamic = 1;", *dyn.Content)
+}
@@ -0,0 +1,173 @@
+package fastly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the API token used to authenticate each request.
+//
+// Implementations must be safe to use from concurrent goroutines.
+type TokenSource interface {
+	// Token returns the API token to use for a request.
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenRefresher is implemented by a [TokenSource] that can be asked to
+// discard a cached token, e.g. after the API rejected it with a 401.
+type TokenRefresher interface {
+	// Refresh reloads the token from its origin.
+	Refresh(ctx context.Context) error
+}
+
+// StaticTokenSource returns a TokenSource that always returns token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// EnvTokenSource returns a TokenSource that reads the token from the named
+// environment variable on every request, e.g. APIKeyEnvVar.
+func EnvTokenSource(name string) TokenSource {
+	return envTokenSource(name)
+}
+
+type envTokenSource string
+
+func (e envTokenSource) Token(context.Context) (string, error) {
+	token, ok := os.LookupEnv(string(e))
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", string(e))
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// Ensure FileTokenSource implements TokenSource and TokenRefresher.
+var (
+	_ TokenSource    = (*FileTokenSource)(nil)
+	_ TokenRefresher = (*FileTokenSource)(nil)
+)
+
+// FileTokenSource is a [TokenSource] that reads the token from a file, such
+// as one maintained by a secrets manager. The file is re-read whenever its
+// modification time or size changes. Surrounding whitespace is trimmed.
+//
+// This structure is safe to use from concurrent goroutines.
+type FileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+	loaded  bool
+}
+
+// NewFileTokenSource returns a FileTokenSource reading from path.
+func NewFileTokenSource(path string) *FileTokenSource {
+	return &FileTokenSource{path: filepath.Clean(path)}
+}
+
+// Token implements the TokenSource interface.
+func (f *FileTokenSource) Token(context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("error reading token file: %w", err)
+	}
+	if f.loaded && fi.ModTime().Equal(f.modTime) && fi.Size() == f.size {
+		return f.token, nil
+	}
+	return f.load(fi)
+}
+
+// Refresh implements the TokenRefresher interface. It re-reads the file
+// even if it appears unchanged.
+func (f *FileTokenSource) Refresh(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("error reading token file: %w", err)
+	}
+	_, err = f.load(fi)
+	return err
+}
+
+// load reads the token file. f.mu must be held.
+func (f *FileTokenSource) load(fi os.FileInfo) (string, error) {
+	b, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("error reading token file: %w", err)
+	}
+	f.token = strings.TrimSpace(string(b))
+	f.modTime = fi.ModTime()
+	f.size = fi.Size()
+	f.loaded = true
+	return f.token, nil
+}
+
+// token returns the API token for a request, from the TokenSource if one
+// is configured.
+func (c *Client) token(ctx context.Context) (string, error) {
+	if c.TokenSource != nil {
+		return c.TokenSource.Token(ctx)
+	}
+	return c.apiKey, nil
+}
+
+// resendUnauthorized refreshes the token and sends req once more if it was
+// rejected with a 401 and the TokenSource now yields a different token.
+// Otherwise the original resp and err are returned.
+func (c *Client) resendUnauthorized(ctx context.Context, req *http.Request, resp *http.Response, err error) (*http.Response, error) {
+	if c.TokenSource == nil || !errors.Is(err, ErrUnauthorized) {
+		return resp, err
+	}
+	if r, ok := c.TokenSource.(TokenRefresher); ok {
+		if rerr := r.Refresh(ctx); rerr != nil {
+			return resp, err
+		}
+	}
+	token, terr := c.TokenSource.Token(ctx)
+	if terr != nil || token == "" || token == req.Header.Get(APIKeyHeader) {
+		return resp, err
+	}
+	if !rewindBody(req) {
+		return resp, err
+	}
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+
+	req.Header.Set(APIKeyHeader, token)
+	return c.send(req)
+}
+
+// TokenExpiresWithin reports whether the token used by the client expires
+// within d, using GetTokenSelf. Tokens without an expiry never expire. The
+// token details are returned so callers can inspect ExpiresAt.
+func (c *Client) TokenExpiresWithin(ctx context.Context, d time.Duration) (bool, *Token, error) {
+	t, err := c.GetTokenSelf(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	if t.ExpiresAt == nil || t.ExpiresAt.IsZero() {
+		return false, t, nil
+	}
+	return time.Until(*t.ExpiresAt) < d, t, nil
+}
@@ -0,0 +1,182 @@
+package fastly
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tokenRoundTripper rejects every token other than valid with a 401.
+type tokenRoundTripper struct {
+	valid string
+	seen  []string
+	body  string
+	// pages, if set, holds the response bodies by request URL.
+	pages map[string]string
+	// served is called after each successful response.
+	served func()
+}
+
+func (rt *tokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.seen = append(rt.seen, req.Header.Get(APIKeyHeader))
+	if req.Header.Get(APIKeyHeader) != rt.valid {
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"msg":"Provided credentials are missing or invalid"}`)),
+		}, nil
+	}
+	body := rt.body
+	if rt.pages != nil {
+		body = rt.pages[req.URL.String()]
+	}
+	if rt.served != nil {
+		rt.served()
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{JSONMimeType}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func TestFileTokenSource(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	ts := NewFileTokenSource(path)
+	token, err := ts.Token(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	// A rotated file is picked up on the next call.
+	require.NoError(t, os.WriteFile(path, []byte("second-token"), 0o600))
+	token, err = ts.Token(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, "second-token", token)
+
+	require.NoError(t, os.Remove(path))
+	_, err = ts.Token(context.TODO())
+	require.Error(t, err)
+}
+
+func TestEnvTokenSource(t *testing.T) {
+	t.Setenv("GO_FASTLY_TEST_TOKEN", "from-env")
+
+	token, err := EnvTokenSource("GO_FASTLY_TEST_TOKEN").Token(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, "from-env", token)
+
+	_, err = EnvTokenSource("GO_FASTLY_TEST_TOKEN_UNSET").Token(context.TODO())
+	require.Error(t, err)
+}
+
+func TestClient_TokenSourceRefresh(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o600))
+
+	rt := &tokenRoundTripper{valid: "old"}
+	c, err := New(
+		WithEnvironment(false),
+		WithTokenSource(NewFileTokenSource(path)),
+		WithHTTPClient(&http.Client{Transport: rt}),
+	)
+	require.NoError(t, err)
+
+	_, err = c.PutJSON(context.TODO(), "/test", map[string]string{"a": "b"}, CreateRequestOptions())
+	require.NoError(t, err)
+
+	// The secrets manager rotates the token. Even if the file's metadata
+	// looks unchanged, the 401 triggers a refresh and a single resend.
+	rt.valid = "new"
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte("new"), 0o600))
+	require.NoError(t, os.Chtimes(path, fi.ModTime(), fi.ModTime()))
+
+	_, err = c.PutJSON(context.TODO(), "/test", map[string]string{"a": "b"}, CreateRequestOptions())
+	require.NoError(t, err)
+	require.Equal(t, []string{"old", "old", "new"}, rt.seen)
+
+	// If the token does not change, the 401 is returned without a resend.
+	rt.valid = "newer"
+	_, err = c.PutJSON(context.TODO(), "/test", map[string]string{"a": "b"}, CreateRequestOptions())
+	require.Error(t, err)
+	require.Equal(t, []string{"old", "old", "new", "new"}, rt.seen)
+}
+
+func TestClient_TokenSourceRefreshPagination(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o600))
+
+	const next = "https://api.fastly.com/events?page%5Bnumber%5D=2"
+	rt := &tokenRoundTripper{
+		valid: "old",
+		pages: map[string]string{
+			"https://api.fastly.com/events": `{"data":[{"type":"event","id":"e1"}],"links":{"next":"` + next + `"}}`,
+			next:                            `{"data":[{"type":"event","id":"e2"}],"links":{}}`,
+		},
+	}
+	// The token is rotated once the first page has been served, with the
+	// file's metadata unchanged, so the request for the next page, which is
+	// sent with SimpleGet, is rejected and must be refreshed and resent.
+	rt.served = func() {
+		if rt.valid == "old" {
+			rt.valid = "new"
+			fi, err := os.Stat(path)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, []byte("new"), 0o600))
+			require.NoError(t, os.Chtimes(path, fi.ModTime(), fi.ModTime()))
+		}
+	}
+	c, err := New(
+		WithEnvironment(false),
+		WithTokenSource(NewFileTokenSource(path)),
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithEndpoint("https://api.fastly.com"),
+	)
+	require.NoError(t, err)
+
+	var ids []string
+	for e, err := range c.AllAPIEvents(context.TODO(), &GetAPIEventsFilterInput{}) {
+		require.NoError(t, err)
+		ids = append(ids, e.ID)
+	}
+	require.Equal(t, []string{"e1", "e2"}, ids)
+	require.Equal(t, []string{"old", "old", "new"}, rt.seen)
+}
+
+func TestClient_TokenExpiresWithin(t *testing.T) {
+	t.Parallel()
+
+	expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	rt := &tokenRoundTripper{valid: "key", body: `{"id":"t1","expires_at":"` + expires + `"}`}
+	c, err := New(
+		WithEnvironment(false),
+		WithTokenSource(StaticTokenSource("key")),
+		WithHTTPClient(&http.Client{Transport: rt}),
+	)
+	require.NoError(t, err)
+
+	soon, tok, err := c.TokenExpiresWithin(context.TODO(), 2*time.Hour)
+	require.NoError(t, err)
+	require.True(t, soon)
+	require.Equal(t, "t1", *tok.TokenID)
+
+	soon, _, err = c.TokenExpiresWithin(context.TODO(), time.Minute)
+	require.NoError(t, err)
+	require.False(t, soon)
+}
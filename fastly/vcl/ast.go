@@ -0,0 +1,476 @@
+package vcl
+
+// Node is a node of the AST.
+type Node interface {
+	// Position returns the position of the first token of the node.
+	Position() Pos
+}
+
+// Decl is a top-level declaration.
+type Decl interface {
+	Node
+	decl()
+}
+
+// Stmt is a statement.
+type Stmt interface {
+	Node
+	stmt()
+}
+
+// Expr is an expression.
+type Expr interface {
+	Node
+	expr()
+}
+
+// node holds the position of a node.
+type node struct {
+	Pos Pos
+}
+
+// Position returns the position of the first token of the node.
+func (n *node) Position() Pos {
+	return n.Pos
+}
+
+// File is a parsed VCL file.
+type File struct {
+	node
+	// Name is the name the file was parsed with.
+	Name string
+	// Decls are the top-level declarations, in source order.
+	Decls []Decl
+}
+
+// SubDecl declares a subroutine, e.g. "sub vcl_recv { ... }".
+type SubDecl struct {
+	node
+	// Name is the name of the subroutine.
+	Name string
+	// ReturnType is the type returned by a function, e.g. "STRING", or ""
+	// for a subroutine without a return value.
+	ReturnType string
+	// Body is the body of the subroutine.
+	Body *Block
+}
+
+// BackendDecl declares a backend, e.g. "backend F_origin { .port = "443"; }".
+type BackendDecl struct {
+	node
+	// Name is the name of the backend.
+	Name string
+	// Properties are the properties of the backend, such as ".host".
+	Properties []*Property
+}
+
+// DirectorDecl declares a director, e.g. "director d random { ... }".
+type DirectorDecl struct {
+	node
+	// Name is the name of the director.
+	Name string
+	// Type is the type of the director, e.g. "random" or "hash".
+	Type string
+	// Properties are the properties of the director, such as ".quorum".
+	Properties []*Property
+	// Backends are the backend entries of the director, each a list of
+	// properties such as ".backend" and ".weight".
+	Backends [][]*Property
+}
+
+// TableDecl declares a table, e.g. `table redirects STRING { "/a": "/b" }`.
+type TableDecl struct {
+	node
+	// Name is the name of the table.
+	Name string
+	// Type is the type of the values, or "" for the default STRING.
+	Type string
+	// Entries are the entries of the table, in source order.
+	Entries []*TableEntry
+}
+
+// TableEntry is an entry of a table.
+type TableEntry struct {
+	node
+	// Key is the key of the entry.
+	Key string
+	// Value is the value of the entry.
+	Value Expr
+}
+
+// ACLDecl declares an ACL, e.g. `acl internal { "192.0.2.0"/24; }`.
+type ACLDecl struct {
+	node
+	// Name is the name of the ACL.
+	Name string
+	// Entries are the entries of the ACL, in source order.
+	Entries []*ACLEntry
+}
+
+// ACLEntry is an entry of an ACL.
+type ACLEntry struct {
+	node
+	// Negated reports whether the entry is excluded with "!".
+	Negated bool
+	// Address is the IP address.
+	Address string
+	// Subnet is the prefix length, or -1 if none is given.
+	Subnet int
+}
+
+// ObjectDecl declares another named object with properties, such as a
+// "ratecounter" or "penaltybox".
+type ObjectDecl struct {
+	node
+	// Kind is the declaration keyword, e.g. "ratecounter".
+	Kind string
+	// Name is the name of the object.
+	Name string
+	// Properties are the properties of the object.
+	Properties []*Property
+}
+
+// ImportDecl imports a module, e.g. "import querystring;".
+type ImportDecl struct {
+	node
+	// Name is the name of the module.
+	Name string
+}
+
+// IncludeDecl includes another VCL file or snippet, e.g. `include "snippet::x";`.
+type IncludeDecl struct {
+	node
+	// Path is the included name.
+	Path string
+}
+
+// Property is a property of a backend, director or other object, e.g.
+// `.port = "443";`. Nested objects, such as the ".probe" of a backend, have
+// Properties instead of a Value.
+type Property struct {
+	node
+	// Name is the name of the property, without the leading dot.
+	Name string
+	// Value is the value of the property, or nil for nested objects.
+	Value Expr
+	// Properties are the properties of a nested object.
+	Properties []*Property
+}
+
+// Block is a list of statements enclosed in braces, or the content of a
+// snippet.
+type Block struct {
+	node
+	// Stmts are the statements, in source order.
+	Stmts []Stmt
+}
+
+// MacroStmt is a "#FASTLY" macro, e.g. "#FASTLY recv", where the VCL
+// generated by Fastly for the service is inserted.
+type MacroStmt struct {
+	node
+	// Name is the lower-cased name of the macro, e.g. "recv".
+	Name string
+}
+
+// SetStmt assigns to a variable, e.g. "set req.http.X = "1";".
+type SetStmt struct {
+	node
+	// Var is the name of the variable.
+	Var string
+	// Op is the assignment operator, e.g. "=" or "+=".
+	Op string
+	// Value is the assigned value.
+	Value Expr
+}
+
+// AddStmt adds a header, e.g. `add resp.http.Set-Cookie = "a=b";`.
+type AddStmt struct {
+	node
+	// Var is the name of the header variable.
+	Var string
+	// Value is the added value.
+	Value Expr
+}
+
+// UnsetStmt removes a variable, e.g. "unset req.http.Cookie;".
+type UnsetStmt struct {
+	node
+	// Keyword is "unset" or its synonym "remove".
+	Keyword string
+	// Var is the name of the variable.
+	Var string
+}
+
+// DeclareStmt declares a local variable, e.g. "declare local var.n INTEGER;".
+type DeclareStmt struct {
+	node
+	// Var is the name of the variable, e.g. "var.n".
+	Var string
+	// Type is the type of the variable, e.g. "INTEGER".
+	Type string
+}
+
+// IfStmt is a conditional statement.
+type IfStmt struct {
+	node
+	// Cond is the condition.
+	Cond Expr
+	// Then is run if Cond is true.
+	Then *Block
+	// Else is run otherwise. It is nil, an *IfStmt for "else if" or a
+	// *Block.
+	Else Stmt
+}
+
+// ReturnStmt returns from a subroutine, e.g. "return(pass);".
+type ReturnStmt struct {
+	node
+	// Action is the state to move to, e.g. "pass", or "" if none is given.
+	Action string
+	// Value is the returned value of a function, or nil.
+	Value Expr
+}
+
+// CallStmt calls a subroutine, e.g. "call check_auth;".
+type CallStmt struct {
+	node
+	// Name is the name of the subroutine.
+	Name string
+}
+
+// ErrorStmt raises an error, e.g. `error 403 "Forbidden";`.
+type ErrorStmt struct {
+	node
+	// Status is the status code, or nil.
+	Status Expr
+	// Response is the response message, or nil.
+	Response Expr
+}
+
+// SyntheticStmt sets the body of a synthetic response, e.g. `synthetic "ok";`.
+type SyntheticStmt struct {
+	node
+	// Base64 reports whether the statement is "synthetic.base64".
+	Base64 bool
+	// Value is the body.
+	Value Expr
+}
+
+// LogStmt emits a log line, e.g. `log "syslog " req.service_id " logs :: " req.url;`.
+type LogStmt struct {
+	node
+	// Value is the logged line.
+	Value Expr
+}
+
+// GotoStmt jumps to a label, e.g. "goto done;".
+type GotoStmt struct {
+	node
+	// Label is the target label.
+	Label string
+}
+
+// LabelStmt is a label, e.g. "done:".
+type LabelStmt struct {
+	node
+	// Label is the name of the label.
+	Label string
+}
+
+// KeywordStmt is a statement consisting of a keyword, i.e. "restart;" or
+// "esi;".
+type KeywordStmt struct {
+	node
+	// Keyword is the keyword.
+	Keyword string
+}
+
+// Ident is a variable or other name, e.g. "req.http.Host" or "F_origin".
+type Ident struct {
+	node
+	// Name is the name.
+	Name string
+}
+
+// StringLit is a string literal.
+type StringLit struct {
+	node
+	// Value is the value, without quotes.
+	Value string
+}
+
+// NumberLit is a numeric literal, which may be hexadecimal or have a time
+// unit or percent sign, e.g. "10", "0x1F", "1.5", "30s" or "50%".
+type NumberLit struct {
+	node
+	// Raw is the literal as written.
+	Raw string
+}
+
+// CallExpr calls a function, e.g. `regsub(req.url, "^/a", "/b")`.
+type CallExpr struct {
+	node
+	// Func is the name of the function.
+	Func string
+	// Args are the arguments.
+	Args []Expr
+}
+
+// UnaryExpr is a unary operation, e.g. "!req.http.X".
+type UnaryExpr struct {
+	node
+	// Op is the operator, "!" or "-".
+	Op string
+	// X is the operand.
+	X Expr
+}
+
+// BinaryExpr is a binary operation, e.g. `req.url ~ "^/api/"`.
+type BinaryExpr struct {
+	node
+	// Op is the operator, e.g. "&&" or "~".
+	Op string
+	// X is the left operand.
+	X Expr
+	// Y is the right operand.
+	Y Expr
+}
+
+// ConcatExpr is the concatenation of juxtaposed expressions, e.g.
+// `"https://" req.http.Host req.url`.
+type ConcatExpr struct {
+	node
+	// Parts are the concatenated expressions.
+	Parts []Expr
+}
+
+// ParenExpr is a parenthesized expression.
+type ParenExpr struct {
+	node
+	// X is the enclosed expression.
+	X Expr
+}
+
+func (*SubDecl) decl()      {}
+func (*BackendDecl) decl()  {}
+func (*DirectorDecl) decl() {}
+func (*TableDecl) decl()    {}
+func (*ACLDecl) decl()      {}
+func (*ObjectDecl) decl()   {}
+func (*ImportDecl) decl()   {}
+func (*IncludeDecl) decl()  {}
+
+func (*Block) stmt()         {}
+func (*MacroStmt) stmt()     {}
+func (*SetStmt) stmt()       {}
+func (*AddStmt) stmt()       {}
+func (*UnsetStmt) stmt()     {}
+func (*DeclareStmt) stmt()   {}
+func (*IfStmt) stmt()        {}
+func (*ReturnStmt) stmt()    {}
+func (*CallStmt) stmt()      {}
+func (*ErrorStmt) stmt()     {}
+func (*SyntheticStmt) stmt() {}
+func (*LogStmt) stmt()       {}
+func (*GotoStmt) stmt()      {}
+func (*LabelStmt) stmt()     {}
+func (*KeywordStmt) stmt()   {}
+func (*IncludeDecl) stmt()   {}
+
+func (*Ident) expr()      {}
+func (*StringLit) expr()  {}
+func (*NumberLit) expr()  {}
+func (*CallExpr) expr()   {}
+func (*UnaryExpr) expr()  {}
+func (*BinaryExpr) expr() {}
+func (*ConcatExpr) expr() {}
+func (*ParenExpr) expr()  {}
+
+// Walk calls fn for n and, if fn returns true, for each of the nodes
+// within n, depth-first in source order.
+func Walk(n Node, fn func(Node) bool) {
+	if n == nil || !fn(n) {
+		return
+	}
+	walkProperties := func(props []*Property) {
+		for _, p := range props {
+			Walk(p, fn)
+		}
+	}
+	switch n := n.(type) {
+	case *File:
+		for _, d := range n.Decls {
+			Walk(d, fn)
+		}
+	case *SubDecl:
+		Walk(n.Body, fn)
+	case *BackendDecl:
+		walkProperties(n.Properties)
+	case *DirectorDecl:
+		walkProperties(n.Properties)
+		for _, b := range n.Backends {
+			walkProperties(b)
+		}
+	case *TableDecl:
+		for _, e := range n.Entries {
+			Walk(e, fn)
+		}
+	case *TableEntry:
+		walkExpr(n.Value, fn)
+	case *ACLDecl:
+		for _, e := range n.Entries {
+			Walk(e, fn)
+		}
+	case *ObjectDecl:
+		walkProperties(n.Properties)
+	case *Property:
+		walkExpr(n.Value, fn)
+		walkProperties(n.Properties)
+	case *Block:
+		for _, s := range n.Stmts {
+			Walk(s, fn)
+		}
+	case *SetStmt:
+		walkExpr(n.Value, fn)
+	case *AddStmt:
+		walkExpr(n.Value, fn)
+	case *IfStmt:
+		walkExpr(n.Cond, fn)
+		Walk(n.Then, fn)
+		if n.Else != nil {
+			Walk(n.Else, fn)
+		}
+	case *ReturnStmt:
+		walkExpr(n.Value, fn)
+	case *ErrorStmt:
+		walkExpr(n.Status, fn)
+		walkExpr(n.Response, fn)
+	case *SyntheticStmt:
+		walkExpr(n.Value, fn)
+	case *LogStmt:
+		walkExpr(n.Value, fn)
+	case *CallExpr:
+		for _, a := range n.Args {
+			walkExpr(a, fn)
+		}
+	case *UnaryExpr:
+		walkExpr(n.X, fn)
+	case *BinaryExpr:
+		walkExpr(n.X, fn)
+		walkExpr(n.Y, fn)
+	case *ConcatExpr:
+		for _, p := range n.Parts {
+			walkExpr(p, fn)
+		}
+	case *ParenExpr:
+		walkExpr(n.X, fn)
+	}
+}
+
+// walkExpr walks x, which may be a nil interface.
+func walkExpr(x Expr, fn func(Node) bool) {
+	if x != nil {
+		Walk(x, fn)
+	}
+}
@@ -0,0 +1,17 @@
+// Package vcl parses Fastly VCL offline, so that custom VCL and VCL
+// snippets can be checked before they are uploaded with CreateVCL,
+// UpdateVCL, CreateSnippet or UpdateDynamicSnippet, instead of when the
+// service version is validated.
+//
+// Parse reads a complete VCL file, such as the main VCL of a version, and
+// ParseStatements reads the body of a subroutine, such as the content of a
+// "recv" snippet. Both return an AST and a list of syntax errors with
+// their line and column. Lint and LintSnippet additionally report
+// problems which are syntactically valid but rejected or misbehaving on
+// Fastly, such as duplicate #FASTLY macros or undefined backends.
+//
+// The parser accepts the constructs in common use (declarations of
+// subroutines, backends, directors, tables and ACLs, #FASTLY macros,
+// statements, and expressions with implicit string concatenation) but does
+// not type-check expressions.
+package vcl
@@ -0,0 +1,276 @@
+package vcl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pos is a position in VCL source.
+type Pos struct {
+	// Line is the line number, starting at 1.
+	Line int
+	// Column is the byte offset in the line, starting at 1.
+	Column int
+}
+
+// String returns the position as "line:column".
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// tokenKind is the kind of a lexical token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenMacro
+	tokenOp
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of file"
+	case tokenIdent:
+		return "identifier"
+	case tokenString:
+		return "string"
+	case tokenNumber:
+		return "number"
+	case tokenMacro:
+		return "#FASTLY macro"
+	}
+	return "operator"
+}
+
+// token is a lexical token. For strings, text is the unquoted value; for
+// macros, it is the macro name, e.g. "recv".
+type token struct {
+	kind tokenKind
+	text string
+	pos  Pos
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return t.kind.String()
+	case tokenString:
+		return fmt.Sprintf("string %q", t.text)
+	case tokenMacro:
+		return "#FASTLY " + t.text
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// operators are the operator and punctuation tokens, longest first.
+var operators = []string{
+	"<<=", ">>=", "||=", "&&=",
+	"==", "!=", "!~", "<=", ">=", "&&", "||", "+=", "-=", "*=", "/=", "%=", "|=", "&=", "^=", "<<", ">>",
+	"{", "}", "(", ")", ";", ",", "=", "~", "!", "<", ">", "+", "-", "*", "/", "%", ".", ":", "&", "|", "^",
+}
+
+// lexer splits VCL source into tokens, skipping whitespace and comments.
+type lexer struct {
+	src  string
+	off  int
+	pos  Pos
+	errs func(Pos, string)
+}
+
+func newLexer(src string, errs func(Pos, string)) *lexer {
+	return &lexer{src: src, pos: Pos{Line: 1, Column: 1}, errs: errs}
+}
+
+// advance moves past n bytes of source.
+func (l *lexer) advance(n int) {
+	for _, c := range l.src[l.off : l.off+n] {
+		if c == '\n' {
+			l.pos.Line++
+			l.pos.Column = 1
+		} else {
+			l.pos.Column += len(string(c))
+		}
+	}
+	l.off += n
+}
+
+func (l *lexer) rest() string {
+	return l.src[l.off:]
+}
+
+// next returns the next token.
+func (l *lexer) next() token {
+	for {
+		l.skipSpace()
+		rest := l.rest()
+		start := l.pos
+		switch {
+		case rest == "":
+			return token{kind: tokenEOF, pos: start}
+		case rest[0] == '#':
+			line, _, _ := strings.Cut(rest, "\n")
+			l.advance(len(line))
+			if name, ok := strings.CutPrefix(strings.TrimSpace(line[1:]), "FASTLY"); ok && (name == "" || name[0] == ' ' || name[0] == '\t') {
+				return token{kind: tokenMacro, text: strings.ToLower(strings.TrimSpace(name)), pos: start}
+			}
+		case strings.HasPrefix(rest, "//"):
+			line, _, _ := strings.Cut(rest, "\n")
+			l.advance(len(line))
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				l.errs(start, "unterminated comment")
+				l.advance(len(rest))
+				continue
+			}
+			l.advance(end + 4)
+		case rest[0] == '"':
+			return l.string(start)
+		case isDigit(rest[0]):
+			return l.number(start)
+		case isIdentStart(rest[0]):
+			n := 1
+			for n < len(rest) && isIdentPart(rest[n]) {
+				n++
+			}
+			l.advance(n)
+			return token{kind: tokenIdent, text: rest[:n], pos: start}
+		default:
+			for _, op := range operators {
+				if strings.HasPrefix(rest, op) {
+					l.advance(len(op))
+					return token{kind: tokenOp, text: op, pos: start}
+				}
+			}
+			l.errs(start, fmt.Sprintf("unexpected character %q", rest[0]))
+			l.advance(1)
+		}
+	}
+}
+
+func (l *lexer) skipSpace() {
+	n := 0
+	for n < len(l.rest()) && strings.ContainsRune(" \t\r\n", rune(l.rest()[n])) {
+		n++
+	}
+	l.advance(n)
+}
+
+// string lexes a "..." string, which may not span lines.
+func (l *lexer) string(start Pos) token {
+	rest := l.rest()
+	end := strings.IndexAny(rest[1:], "\"\n")
+	if end < 0 || rest[1+end] == '\n' {
+		l.errs(start, "unterminated string")
+		line, _, _ := strings.Cut(rest, "\n")
+		l.advance(len(line))
+		return token{kind: tokenString, text: line[1:], pos: start}
+	}
+	l.advance(end + 2)
+	return token{kind: tokenString, text: rest[1 : end+1], pos: start}
+}
+
+// longStringStart returns the length of the opening delimiter of a long
+// string at the start of s, e.g. 2 for `{"` and 5 for `{ABC"`, or 0.
+func longStringStart(s string) int {
+	n := 1
+	for n < len(s) && (isLetter(s[n]) || s[n] == '_') {
+		n++
+	}
+	if n < len(s) && s[n] == '"' {
+		return n + 1
+	}
+	return 0
+}
+
+// longStringAt lexes the long string starting at t, a "{" which must be the
+// last token returned by next. Since "{" also opens tables and blocks, as in
+// `table t {"a": "b"}`, the parser only calls it in expression position.
+// It returns false, leaving the lexer unchanged, if no long string starts
+// at t.
+func (l *lexer) longStringAt(t token) (token, bool) {
+	if longStringStart(l.src[l.off-1:]) == 0 {
+		return t, false
+	}
+	l.off--
+	l.pos = t.pos
+	return l.longString(t.pos), true
+}
+
+// longString lexes a {"..."} string, which may span lines and contain
+// quotes. The braces may enclose a delimiter, as in {ABC"..."ABC}.
+func (l *lexer) longString(start Pos) token {
+	rest := l.rest()
+	open := longStringStart(rest)
+	closing := `"` + rest[1:open-1] + "}"
+	end := strings.Index(rest[open:], closing)
+	if end < 0 {
+		l.errs(start, "unterminated long string")
+		l.advance(len(rest))
+		return token{kind: tokenString, text: rest[open:], pos: start}
+	}
+	l.advance(open + end + len(closing))
+	return token{kind: tokenString, text: rest[open : open+end], pos: start}
+}
+
+// number lexes an integer, hexadecimal integer (e.g. "0x1F"), float,
+// relative time (e.g. "10s" or "500ms") or percentage.
+func (l *lexer) number(start Pos) token {
+	rest := l.rest()
+	if len(rest) > 1 && rest[0] == '0' && (rest[1] == 'x' || rest[1] == 'X') {
+		n := 2
+		for n < len(rest) && isHexDigit(rest[n]) {
+			n++
+		}
+		if n == 2 {
+			l.errs(start, "invalid hexadecimal number")
+		}
+		l.advance(n)
+		return token{kind: tokenNumber, text: rest[:n], pos: start}
+	}
+	n := 0
+	for n < len(rest) && isDigit(rest[n]) {
+		n++
+	}
+	if n+1 < len(rest) && rest[n] == '.' && isDigit(rest[n+1]) {
+		n++
+		for n < len(rest) && isDigit(rest[n]) {
+			n++
+		}
+	}
+	for _, unit := range []string{"ms", "s", "m", "h", "d", "y", "%"} {
+		if strings.HasPrefix(rest[n:], unit) && (n+len(unit) == len(rest) || !isIdentPart(rest[n+len(unit)])) {
+			n += len(unit)
+			break
+		}
+	}
+	l.advance(n)
+	return token{kind: tokenNumber, text: rest[:n], pos: start}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentStart(c byte) bool {
+	return isLetter(c) || c == '_'
+}
+
+// isIdentPart reports whether c may continue an identifier. Identifiers
+// include dots and dashes, as in req.http.X-Forwarded-For, and colons, as
+// in req.http.Cookie:session.
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '.' || c == '-' || c == ':'
+}
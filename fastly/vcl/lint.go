@@ -0,0 +1,313 @@
+package vcl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+)
+
+// Lint rules, as reported in Problem.Rule.
+const (
+	// RuleUnknownSubroutine reports a "vcl_" subroutine which Fastly does
+	// not call, e.g. "vcl_recieve".
+	RuleUnknownSubroutine = "unknown-subroutine"
+	// RuleUnknownSnippetType reports a snippet type which does not name a
+	// Fastly subroutine.
+	RuleUnknownSnippetType = "unknown-snippet-type"
+	// RuleUnknownMacro reports a #FASTLY macro which does not name a
+	// Fastly subroutine.
+	RuleUnknownMacro = "unknown-macro"
+	// RuleMisplacedMacro reports a #FASTLY macro outside of the subroutine
+	// it is named after, or in a snippet.
+	RuleMisplacedMacro = "misplaced-macro"
+	// RuleDuplicateMacro reports a #FASTLY macro appearing more than once
+	// in main VCL.
+	RuleDuplicateMacro = "duplicate-macro"
+	// RuleMissingMacro reports a Fastly subroutine of main VCL without its
+	// #FASTLY macro, which leaves out the VCL generated for the service.
+	RuleMissingMacro = "missing-macro"
+	// RuleUndefinedBackend reports a reference to a backend or director
+	// which is neither declared in the VCL nor listed in LintOptions.
+	RuleUndefinedBackend = "undefined-backend"
+)
+
+// Subroutines are the subroutines Fastly calls, in the order of the
+// request lifecycle. Each has a #FASTLY macro and a SnippetType named
+// after it without the "vcl_" prefix.
+var Subroutines = subroutines(
+	fastly.SnippetTypeRecv,
+	fastly.SnippetTypeHash,
+	fastly.SnippetTypeHit,
+	fastly.SnippetTypeMiss,
+	fastly.SnippetTypePass,
+	fastly.SnippetTypeFetch,
+	fastly.SnippetTypeError,
+	fastly.SnippetTypeDeliver,
+	fastly.SnippetTypeLog,
+)
+
+// subroutines returns the names of the subroutines the snippet types are
+// inserted into.
+func subroutines(types ...fastly.SnippetType) []string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = "vcl_" + string(t)
+	}
+	return names
+}
+
+// backendVars are the variables holding a backend.
+var backendVars = []string{"req.backend", "bereq.backend"}
+
+// Problem is a problem reported by Lint or LintSnippet.
+type Problem struct {
+	// Name is the name of the linted source.
+	Name string
+	// Pos is the position of the problem.
+	Pos Pos
+	// Rule is the rule reporting the problem, e.g. RuleDuplicateMacro.
+	Rule string
+	// Msg describes the problem.
+	Msg string
+}
+
+// String returns the problem as "name:line:column: message (rule)".
+func (p *Problem) String() string {
+	if p.Name == "" {
+		return fmt.Sprintf("%s: %s (%s)", p.Pos, p.Msg, p.Rule)
+	}
+	return fmt.Sprintf("%s:%s: %s (%s)", p.Name, p.Pos, p.Msg, p.Rule)
+}
+
+// LintOptions configures Lint and LintSnippet.
+type LintOptions struct {
+	// Backends are the names of the backends and directors of the service
+	// version, as returned by the API, e.g. "origin". VCL may refer to them
+	// by name or by the name Fastly declares them with (see BackendName).
+	// If Backends is nil, references to backends are only checked in VCL
+	// declaring backends or directors.
+	Backends []string
+	// Main reports whether the linted VCL is the main VCL of the version,
+	// in which each #FASTLY macro must appear once, in its subroutine.
+	Main bool
+}
+
+// BackendName returns the name Fastly declares a backend or director of a
+// service with in the generated VCL, e.g. "F_my_origin" for "my origin".
+func BackendName(name string) string {
+	return "F_" + strings.Map(func(r rune) rune {
+		if r < 128 && (isLetter(byte(r)) || isDigit(byte(r)) || r == '_') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// Lint reports the problems in a parsed VCL file. The options may be nil.
+func Lint(f *File, o *LintOptions) []*Problem {
+	if o == nil {
+		o = &LintOptions{}
+	}
+	l := newLinter(f.Name, o)
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *BackendDecl:
+			l.declareBackend(d.Name)
+		case *DirectorDecl:
+			l.declareBackend(d.Name)
+		}
+	}
+
+	for _, d := range f.Decls {
+		sub, ok := d.(*SubDecl)
+		if !ok {
+			l.checkBackends(d)
+			continue
+		}
+		if strings.HasPrefix(sub.Name, "vcl_") && !slices.Contains(Subroutines, sub.Name) {
+			l.report(sub.Pos, RuleUnknownSubroutine, "%s is not a Fastly subroutine", sub.Name)
+		}
+		l.lintBlock(sub.Name, sub.Body)
+		if o.Main && slices.Contains(Subroutines, sub.Name) && !hasMacro(sub.Body, strings.TrimPrefix(sub.Name, "vcl_")) {
+			l.report(sub.Pos, RuleMissingMacro, "%s has no #FASTLY %s macro", sub.Name, strings.TrimPrefix(sub.Name, "vcl_"))
+		}
+	}
+	return l.sorted()
+}
+
+// LintSnippet parses and lints the content of a snippet of the given type.
+// Snippets of type "init" are parsed as a VCL file, and those of type
+// "none", which are included explicitly, as a VCL file or else as
+// statements. Other snippets are parsed as statements of the subroutine
+// they are inserted into. The options may be nil; their Main field is
+// ignored.
+//
+// The returned error is the syntax error, if any, in which case the
+// problems found in the valid parts of the content are still returned.
+func LintSnippet(name string, typ fastly.SnippetType, content string, o *LintOptions) ([]*Problem, error) {
+	opts := &LintOptions{}
+	if o != nil {
+		opts.Backends = o.Backends
+	}
+	switch typ {
+	case fastly.SnippetTypeInit:
+		f, err := Parse(name, content)
+		return Lint(f, opts), err
+	case fastly.SnippetTypeNone:
+		f, err := Parse(name, content)
+		if err == nil {
+			return Lint(f, opts), nil
+		}
+		if b, err := ParseStatements(name, content); err == nil {
+			return newLinter(name, opts).lintSnippet(b), nil
+		}
+		return Lint(f, opts), err
+	}
+
+	b, err := ParseStatements(name, content)
+	l := newLinter(name, opts)
+	if !slices.Contains(Subroutines, "vcl_"+string(typ)) {
+		l.report(b.Pos, RuleUnknownSnippetType, "snippet type %q is not a Fastly subroutine", typ)
+	}
+	return l.lintSnippet(b), err
+}
+
+// linter collects the problems of a VCL source.
+type linter struct {
+	name string
+	// backends are the declared backends, or nil if references to backends
+	// are not checked.
+	backends map[string]bool
+	main     bool
+	macros   map[string]Pos
+	problems []*Problem
+}
+
+func newLinter(name string, o *LintOptions) *linter {
+	l := &linter{name: name, main: o.Main, macros: map[string]Pos{}}
+	if o.Backends != nil {
+		l.backends = map[string]bool{}
+		for _, b := range o.Backends {
+			l.backends[b] = true
+			l.backends[BackendName(b)] = true
+		}
+	}
+	return l
+}
+
+func (l *linter) declareBackend(name string) {
+	if l.backends == nil {
+		l.backends = map[string]bool{}
+	}
+	l.backends[name] = true
+}
+
+func (l *linter) report(pos Pos, rule, format string, args ...any) {
+	l.problems = append(l.problems, &Problem{Name: l.name, Pos: pos, Rule: rule, Msg: fmt.Sprintf(format, args...)})
+}
+
+// sorted returns the problems ordered by position.
+func (l *linter) sorted() []*Problem {
+	slices.SortStableFunc(l.problems, func(a, b *Problem) int {
+		if a.Pos.Line != b.Pos.Line {
+			return a.Pos.Line - b.Pos.Line
+		}
+		return a.Pos.Column - b.Pos.Column
+	})
+	return l.problems
+}
+
+// lintSnippet lints the statements of a snippet.
+func (l *linter) lintSnippet(b *Block) []*Problem {
+	Walk(b, func(n Node) bool {
+		if m, ok := n.(*MacroStmt); ok {
+			l.report(m.Pos, RuleMisplacedMacro, "#FASTLY %s in a snippet is not expanded", m.Name)
+		}
+		return true
+	})
+	l.checkBackends(b)
+	return l.sorted()
+}
+
+// lintBlock lints the body of the subroutine sub.
+func (l *linter) lintBlock(sub string, b *Block) {
+	Walk(b, func(n Node) bool {
+		m, ok := n.(*MacroStmt)
+		if !ok {
+			return true
+		}
+		switch {
+		case !slices.Contains(Subroutines, "vcl_"+m.Name):
+			l.report(m.Pos, RuleUnknownMacro, "#FASTLY %s does not name a Fastly subroutine", m.Name)
+		case sub != "vcl_"+m.Name:
+			l.report(m.Pos, RuleMisplacedMacro, "#FASTLY %s belongs in vcl_%s, not %s", m.Name, m.Name, sub)
+		}
+		if l.main {
+			if prev, ok := l.macros[m.Name]; ok {
+				l.report(m.Pos, RuleDuplicateMacro, "#FASTLY %s already appears at %s", m.Name, prev)
+			} else {
+				l.macros[m.Name] = m.Pos
+			}
+		}
+		return true
+	})
+	l.checkBackends(b)
+}
+
+// hasMacro reports whether b contains the #FASTLY macro name.
+func hasMacro(b *Block, name string) bool {
+	found := false
+	Walk(b, func(n Node) bool {
+		if m, ok := n.(*MacroStmt); ok && m.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// checkBackends reports the references to undefined backends in n: the
+// values assigned to, or compared with, backend variables, and the
+// backends of directors.
+func (l *linter) checkBackends(n Node) {
+	if l.backends == nil {
+		return
+	}
+	check := func(x Expr) {
+		id, ok := x.(*Ident)
+		if ok && !strings.Contains(id.Name, ".") && !l.backends[id.Name] {
+			l.report(id.Pos, RuleUndefinedBackend, "backend %s is not defined", id.Name)
+		}
+	}
+	isBackendVar := func(x Expr) bool {
+		id, ok := x.(*Ident)
+		return ok && slices.Contains(backendVars, id.Name)
+	}
+	Walk(n, func(n Node) bool {
+		switch n := n.(type) {
+		case *SetStmt:
+			if slices.Contains(backendVars, n.Var) {
+				check(n.Value)
+			}
+		case *BinaryExpr:
+			if n.Op == "==" || n.Op == "!=" {
+				if isBackendVar(n.X) {
+					check(n.Y)
+				} else if isBackendVar(n.Y) {
+					check(n.X)
+				}
+			}
+		case *DirectorDecl:
+			for _, props := range n.Backends {
+				for _, p := range props {
+					if p.Name == "backend" {
+						check(p.Value)
+					}
+				}
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,142 @@
+package vcl
+
+import (
+	"go/ast"
+	goparser "go/parser"
+	gotoken "go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+)
+
+func lintStrings(problems []*Problem) []string {
+	var out []string
+	for _, p := range problems {
+		out = append(out, p.String())
+	}
+	return out
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	f, err := Parse("main.vcl", mainVCL)
+	require.NoError(t, err)
+	require.Empty(t, Lint(f, &LintOptions{Main: true}))
+
+	f, err = Parse("main.vcl", `sub vcl_recv {
+  #FASTLY recv
+  if (req.url ~ "^/api/") {
+    set req.backend = F_api;
+  } else if (req.backend == F_legacy) {
+    #FASTLY recv
+  }
+  set req.backend = F_origin;
+}
+
+sub vcl_recieve {
+  #FASTLY fetch
+}
+
+sub vcl_deliver {
+  #FASTLY delivr
+}
+
+sub vcl_fetch {
+  set beresp.ttl = 1h;
+}
+`)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"main.vcl:4:23: backend F_api is not defined (undefined-backend)",
+		"main.vcl:5:29: backend F_legacy is not defined (undefined-backend)",
+		"main.vcl:6:5: #FASTLY recv already appears at 2:3 (duplicate-macro)",
+		"main.vcl:11:1: vcl_recieve is not a Fastly subroutine (unknown-subroutine)",
+		"main.vcl:12:3: #FASTLY fetch belongs in vcl_fetch, not vcl_recieve (misplaced-macro)",
+		"main.vcl:15:1: vcl_deliver has no #FASTLY deliver macro (missing-macro)",
+		"main.vcl:16:3: #FASTLY delivr does not name a Fastly subroutine (unknown-macro)",
+		"main.vcl:19:1: vcl_fetch has no #FASTLY fetch macro (missing-macro)",
+	}, lintStrings(Lint(f, &LintOptions{Backends: []string{"origin"}, Main: true})))
+
+	// Macros may repeat outside main VCL, and backends are not checked
+	// unless they are known.
+	require.Equal(t, []string{
+		"main.vcl:11:1: vcl_recieve is not a Fastly subroutine (unknown-subroutine)",
+		"main.vcl:12:3: #FASTLY fetch belongs in vcl_fetch, not vcl_recieve (misplaced-macro)",
+		"main.vcl:16:3: #FASTLY delivr does not name a Fastly subroutine (unknown-macro)",
+	}, lintStrings(Lint(f, nil)))
+
+	// Directors refer to backends declared in the VCL.
+	f, err = Parse("", "backend a {}\ndirector d random {\n  { .backend = a; }\n  { .backend = b; }\n}\n")
+	require.NoError(t, err)
+	require.Equal(t, []string{"4:16: backend b is not defined (undefined-backend)"}, lintStrings(Lint(f, nil)))
+}
+
+func TestLintSnippet(t *testing.T) {
+	t.Parallel()
+
+	problems, err := LintSnippet("s", fastly.SnippetTypeRecv, `set req.backend = F_my_origin;
+#FASTLY recv
+`, &LintOptions{Backends: []string{"my origin"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"s:2:1: #FASTLY recv in a snippet is not expanded (misplaced-macro)"}, lintStrings(problems))
+
+	problems, err = LintSnippet("s", fastly.SnippetType("recieve"), `set req.backend = F_x;`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{`s:1:1: snippet type "recieve" is not a Fastly subroutine (unknown-snippet-type)`}, lintStrings(problems))
+
+	problems, err = LintSnippet("s", fastly.SnippetTypeInit, "sub vcl_hitt {}\nsub helper {\n  set req.backend = F_x;\n}\n", &LintOptions{Backends: []string{}})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"s:1:1: vcl_hitt is not a Fastly subroutine (unknown-subroutine)",
+		"s:3:21: backend F_x is not defined (undefined-backend)",
+	}, lintStrings(problems))
+
+	// Snippets of type none may hold declarations or statements.
+	_, err = LintSnippet("s", fastly.SnippetTypeNone, "table t { \"a\": \"b\" }", nil)
+	require.NoError(t, err)
+	_, err = LintSnippet("s", fastly.SnippetTypeNone, "unset req.http.Cookie;", nil)
+	require.NoError(t, err)
+
+	_, err = LintSnippet("s", fastly.SnippetTypeDeliver, "set resp.http.X = ;", nil)
+	require.EqualError(t, err, `s:1:19: unexpected ";", expected an expression`)
+}
+
+// TestSubroutines checks that Subroutines covers the snippet types declared
+// in the fastly package, other than "init" and "none".
+func TestSubroutines(t *testing.T) {
+	t.Parallel()
+
+	f, err := goparser.ParseFile(gotoken.NewFileSet(), "../vcl_snippets.go", nil, 0)
+	require.NoError(t, err)
+	var names []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		if id, ok := spec.Type.(*ast.Ident); !ok || id.Name != "SnippetType" {
+			return true
+		}
+		for _, v := range spec.Values {
+			lit, ok := v.(*ast.BasicLit)
+			if !ok {
+				continue
+			}
+			if typ := lit.Value; typ != `"init"` && typ != `"none"` {
+				names = append(names, "vcl_"+typ[1:len(typ)-1])
+			}
+		}
+		return true
+	})
+	require.NotEmpty(t, names)
+	require.ElementsMatch(t, names, Subroutines)
+}
+
+func TestBackendName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "F_my_origin_2", BackendName("my origin-2"))
+}
@@ -0,0 +1,603 @@
+package vcl
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Error is a syntax error.
+type Error struct {
+	// Name is the name of the parsed source.
+	Name string
+	// Pos is the position of the error.
+	Pos Pos
+	// Msg describes the error.
+	Msg string
+}
+
+// Error returns the error as "name:line:column: message".
+func (e *Error) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("%s: %s", e.Pos, e.Msg)
+	}
+	return fmt.Sprintf("%s:%s: %s", e.Name, e.Pos, e.Msg)
+}
+
+// ErrorList is a list of syntax errors, ordered by position.
+type ErrorList []*Error
+
+// Error returns the first error, followed by the number of other errors.
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// err returns l, or nil if l is empty.
+func (l ErrorList) err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// Parse parses a complete VCL file, such as the main VCL of a service
+// version or the content of an "init" snippet. The name is used in errors.
+//
+// The parser recovers from syntax errors by skipping to the end of the
+// statement or declaration, so the returned file is never nil. If there
+// were syntax errors, the returned error is an ErrorList.
+func Parse(name, src string) (*File, error) {
+	p := newParser(name, src)
+	f := &File{node: node{Pos: p.tok.pos}, Name: name}
+	for p.tok.kind != tokenEOF {
+		if d := p.decl(); d != nil {
+			f.Decls = append(f.Decls, d)
+		}
+	}
+	return f, p.errs.err()
+}
+
+// ParseStatements parses a list of statements, such as the content of a
+// snippet inserted into a subroutine. The name is used in errors. Errors
+// are handled as by Parse.
+func ParseStatements(name, src string) (*Block, error) {
+	p := newParser(name, src)
+	b := &Block{node: node{Pos: p.tok.pos}}
+	for p.tok.kind != tokenEOF {
+		if p.isOp("}") {
+			p.errorf(p.tok.pos, "unexpected %s", p.tok)
+			p.next()
+			continue
+		}
+		if s := p.stmt(); s != nil {
+			b.Stmts = append(b.Stmts, s)
+		}
+	}
+	return b, p.errs.err()
+}
+
+// bailout is panicked with to abandon the current statement or
+// declaration after a syntax error.
+type bailout struct{}
+
+type parser struct {
+	name string
+	lex  *lexer
+	tok  token
+	errs ErrorList
+	// depth is the number of unclosed braces before tok.
+	depth int
+}
+
+func newParser(name, src string) *parser {
+	p := &parser{name: name}
+	p.lex = newLexer(src, func(pos Pos, msg string) { p.errorf(pos, "%s", msg) })
+	p.next()
+	return p
+}
+
+func (p *parser) next() {
+	switch {
+	case p.isOp("{"):
+		p.depth++
+	case p.isOp("}") && p.depth > 0:
+		p.depth--
+	}
+	p.tok = p.lex.next()
+}
+
+// errorf records an error, unless it repeats the previous one, as when
+// nested blocks are unterminated.
+func (p *parser) errorf(pos Pos, format string, args ...any) {
+	e := &Error{Name: p.name, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+	if n := len(p.errs); n > 0 && *p.errs[n-1] == *e {
+		return
+	}
+	p.errs = append(p.errs, e)
+}
+
+// fail records an error at the current token and abandons the current
+// statement or declaration.
+func (p *parser) fail(format string, args ...any) {
+	p.errorf(p.tok.pos, format, args...)
+	panic(bailout{})
+}
+
+func (p *parser) isOp(op string) bool {
+	return p.tok.kind == tokenOp && p.tok.text == op
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	return p.tok.kind == tokenIdent && p.tok.text == kw
+}
+
+// expect consumes the operator op.
+func (p *parser) expect(op string) Pos {
+	if !p.isOp(op) {
+		p.fail("expected %q, found %s", op, p.tok)
+	}
+	pos := p.tok.pos
+	p.next()
+	return pos
+}
+
+// ident consumes an identifier and returns its name.
+func (p *parser) ident(what string) string {
+	if p.tok.kind != tokenIdent {
+		p.fail("expected %s, found %s", what, p.tok)
+	}
+	name := p.tok.text
+	p.next()
+	return name
+}
+
+// str consumes a string and returns its value.
+func (p *parser) str(what string) string {
+	if p.tok.kind != tokenString {
+		p.fail("expected %s, found %s", what, p.tok)
+	}
+	s := p.tok.text
+	p.next()
+	return s
+}
+
+// sync handles a bailout from a statement or declaration which started at
+// the given brace depth. It skips to the end of that statement or
+// declaration, i.e. past the next ";" or "}" at that depth, or up to the
+// closing brace of the enclosing block.
+func (p *parser) sync(depth int) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if _, ok := r.(bailout); !ok {
+		panic(r)
+	}
+	for p.tok.kind != tokenEOF {
+		if p.depth == depth && p.isOp("}") {
+			return
+		}
+		end := p.isOp(";") || p.isOp("}")
+		p.next()
+		if end && p.depth == depth {
+			return
+		}
+	}
+}
+
+// decl parses a top-level declaration, returning nil after an error.
+func (p *parser) decl() (d Decl) {
+	if p.isOp("}") {
+		p.errorf(p.tok.pos, "unexpected %s", p.tok)
+		p.next()
+		return nil
+	}
+	defer p.sync(p.depth)
+	pos := p.tok.pos
+	kw := p.ident("declaration")
+	n := node{Pos: pos}
+	switch kw {
+	case "sub":
+		s := &SubDecl{node: n, Name: p.ident("subroutine name")}
+		if p.tok.kind == tokenIdent {
+			s.ReturnType = p.tok.text
+			p.next()
+		}
+		s.Body = p.block()
+		return s
+	case "backend":
+		b := &BackendDecl{node: n, Name: p.ident("backend name")}
+		b.Properties = p.properties()
+		return b
+	case "director":
+		d := &DirectorDecl{node: n, Name: p.ident("director name"), Type: p.ident("director type")}
+		p.expect("{")
+		for !p.isOp("}") {
+			if p.isOp("{") {
+				p.next()
+				var props []*Property
+				for !p.isOp("}") {
+					props = append(props, p.property())
+				}
+				p.next()
+				d.Backends = append(d.Backends, props)
+				continue
+			}
+			d.Properties = append(d.Properties, p.property())
+		}
+		p.next()
+		return d
+	case "table":
+		t := &TableDecl{node: n, Name: p.ident("table name")}
+		if p.tok.kind == tokenIdent {
+			t.Type = p.tok.text
+			p.next()
+		}
+		p.expect("{")
+		for !p.isOp("}") {
+			e := &TableEntry{node: node{Pos: p.tok.pos}, Key: p.str("table key")}
+			p.expect(":")
+			e.Value = p.expr()
+			t.Entries = append(t.Entries, e)
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		p.expect("}")
+		return t
+	case "acl":
+		a := &ACLDecl{node: n, Name: p.ident("acl name")}
+		p.expect("{")
+		for !p.isOp("}") {
+			e := &ACLEntry{node: node{Pos: p.tok.pos}, Subnet: -1}
+			if p.isOp("!") {
+				e.Negated = true
+				p.next()
+			}
+			e.Address = p.str("address")
+			if p.isOp("/") {
+				p.next()
+				if p.tok.kind != tokenNumber {
+					p.fail("expected subnet, found %s", p.tok)
+				}
+				subnet, err := strconv.Atoi(p.tok.text)
+				if err != nil {
+					p.fail("invalid subnet %q", p.tok.text)
+				}
+				e.Subnet = subnet
+				p.next()
+			}
+			p.expect(";")
+			a.Entries = append(a.Entries, e)
+		}
+		p.next()
+		return a
+	case "import":
+		i := &ImportDecl{node: n, Name: p.ident("module name")}
+		p.expect(";")
+		return i
+	case "include":
+		i := &IncludeDecl{node: n, Path: p.str("include path")}
+		p.expect(";")
+		return i
+	case "ratecounter", "penaltybox":
+		o := &ObjectDecl{node: n, Kind: kw, Name: p.ident(kw + " name")}
+		o.Properties = p.properties()
+		return o
+	case "pragma":
+		for !p.isOp(";") && p.tok.kind != tokenEOF {
+			p.next()
+		}
+		p.expect(";")
+		return nil
+	}
+	p.errorf(pos, "unexpected %q, expected a declaration", kw)
+	panic(bailout{})
+}
+
+// properties parses a brace-enclosed list of properties.
+func (p *parser) properties() []*Property {
+	p.expect("{")
+	var props []*Property
+	for !p.isOp("}") {
+		props = append(props, p.property())
+	}
+	p.next()
+	return props
+}
+
+// property parses a property, e.g. `.port = "443";` or ".probe = { ... }".
+func (p *parser) property() *Property {
+	pos := p.expect(".")
+	prop := &Property{node: node{Pos: pos}, Name: p.ident("property name")}
+	p.expect("=")
+	if p.isOp("{") {
+		prop.Properties = p.properties()
+		// The closing brace of a nested object may be followed by ";".
+		if p.isOp(";") {
+			p.next()
+		}
+		return prop
+	}
+	prop.Value = p.expr()
+	p.expect(";")
+	return prop
+}
+
+// block parses a brace-enclosed list of statements.
+func (p *parser) block() *Block {
+	b := &Block{node: node{Pos: p.expect("{")}}
+	for !p.isOp("}") {
+		if p.tok.kind == tokenEOF {
+			p.fail("expected %q, found %s", "}", p.tok)
+		}
+		if s := p.stmt(); s != nil {
+			b.Stmts = append(b.Stmts, s)
+		}
+	}
+	p.next()
+	return b
+}
+
+// stmt parses a statement, returning nil after an error.
+func (p *parser) stmt() (s Stmt) {
+	defer p.sync(p.depth)
+	pos := p.tok.pos
+	n := node{Pos: pos}
+	switch p.tok.kind {
+	case tokenMacro:
+		name := p.tok.text
+		if name == "" {
+			p.fail("#FASTLY macro without a name")
+		}
+		p.next()
+		return &MacroStmt{node: n, Name: name}
+	case tokenIdent:
+	default:
+		if p.isOp("{") {
+			return p.block()
+		}
+		p.fail("unexpected %s, expected a statement", p.tok)
+	}
+
+	kw := p.tok.text
+	p.next()
+	switch kw {
+	case "set":
+		s := &SetStmt{node: n, Var: p.ident("variable")}
+		if p.tok.kind != tokenOp || !strings.HasSuffix(p.tok.text, "=") || p.tok.text == "==" || p.tok.text == "!=" || p.tok.text == "<=" || p.tok.text == ">=" {
+			p.fail("expected assignment operator, found %s", p.tok)
+		}
+		s.Op = p.tok.text
+		p.next()
+		s.Value = p.expr()
+		p.expect(";")
+		return s
+	case "add":
+		s := &AddStmt{node: n, Var: p.ident("variable")}
+		p.expect("=")
+		s.Value = p.expr()
+		p.expect(";")
+		return s
+	case "unset", "remove":
+		s := &UnsetStmt{node: n, Keyword: kw, Var: p.ident("variable")}
+		p.expect(";")
+		return s
+	case "declare":
+		if !p.isKeyword("local") {
+			p.fail("expected %q, found %s", "local", p.tok)
+		}
+		p.next()
+		s := &DeclareStmt{node: n, Var: p.ident("variable")}
+		if !strings.HasPrefix(s.Var, "var.") {
+			p.errorf(pos, "local variable %q must start with %q", s.Var, "var.")
+		}
+		s.Type = p.ident("type")
+		p.expect(";")
+		return s
+	case "if":
+		return p.ifStmt(n)
+	case "return":
+		s := &ReturnStmt{node: n}
+		if p.isOp(";") {
+			p.next()
+			return s
+		}
+		s.Value = p.expr()
+		if paren, ok := s.Value.(*ParenExpr); ok {
+			if id, ok := paren.X.(*Ident); ok {
+				s.Action, s.Value = id.Name, nil
+			}
+		}
+		p.expect(";")
+		return s
+	case "call":
+		s := &CallStmt{node: n, Name: p.ident("subroutine name")}
+		p.expect(";")
+		return s
+	case "error":
+		s := &ErrorStmt{node: n}
+		if !p.isOp(";") {
+			s.Status = p.unary()
+			if !p.isOp(";") {
+				s.Response = p.expr()
+			}
+		}
+		p.expect(";")
+		return s
+	case "synthetic", "synthetic.base64":
+		s := &SyntheticStmt{node: n, Base64: kw == "synthetic.base64", Value: p.expr()}
+		p.expect(";")
+		return s
+	case "log":
+		s := &LogStmt{node: n, Value: p.expr()}
+		p.expect(";")
+		return s
+	case "goto":
+		s := &GotoStmt{node: n, Label: p.ident("label")}
+		p.expect(";")
+		return s
+	case "restart", "esi":
+		p.expect(";")
+		return &KeywordStmt{node: n, Keyword: kw}
+	case "include":
+		s := &IncludeDecl{node: n, Path: p.str("include path")}
+		p.expect(";")
+		return s
+	}
+	if label, ok := strings.CutSuffix(kw, ":"); ok && label != "" && !strings.Contains(label, ".") {
+		return &LabelStmt{node: n, Label: label}
+	}
+	p.errorf(pos, "unexpected %q, expected a statement", kw)
+	panic(bailout{})
+}
+
+// ifStmt parses the rest of an if statement, after "if".
+func (p *parser) ifStmt(n node) *IfStmt {
+	p.expect("(")
+	s := &IfStmt{node: n, Cond: p.expr()}
+	p.expect(")")
+	s.Then = p.block()
+	pos := p.tok.pos
+	switch {
+	case p.isKeyword("else"):
+		p.next()
+		if p.isKeyword("if") {
+			pos = p.tok.pos
+			p.next()
+			s.Else = p.ifStmt(node{Pos: pos})
+		} else {
+			s.Else = p.block()
+		}
+	case p.isKeyword("elseif"), p.isKeyword("elsif"), p.isKeyword("elif"):
+		p.next()
+		s.Else = p.ifStmt(node{Pos: pos})
+	}
+	return s
+}
+
+// binaryLevels are the binary operators by increasing precedence. String
+// concatenation by juxtaposition binds tighter than comparisons and looser
+// than "+" and "-".
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "~", "!~", "<", ">", "<=", ">="},
+	nil, // concatenation
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// expr parses an expression.
+func (p *parser) expr() Expr {
+	return p.binary(0)
+}
+
+func (p *parser) binary(level int) Expr {
+	if level == len(binaryLevels) {
+		return p.unary()
+	}
+	if binaryLevels[level] == nil {
+		return p.concat(level)
+	}
+	x := p.binary(level + 1)
+	for p.tok.kind == tokenOp && slices.Contains(binaryLevels[level], p.tok.text) {
+		op, pos := p.tok.text, p.tok.pos
+		p.next()
+		y := p.binary(level + 1)
+		x = &BinaryExpr{node: node{Pos: pos}, Op: op, X: x, Y: y}
+	}
+	return x
+}
+
+// statementKeywords are the keywords starting a statement, which do not
+// continue a concatenation, so that a missing ";" is reported where the
+// next statement starts.
+var statementKeywords = []string{
+	"add", "call", "declare", "error", "esi", "goto", "if", "include", "log", "remove",
+	"restart", "return", "set", "synthetic", "synthetic.base64", "unset",
+}
+
+// concat parses juxtaposed expressions at the given level.
+func (p *parser) concat(level int) Expr {
+	x := p.binary(level + 1)
+	parts := []Expr{x}
+	for p.tok.kind == tokenString || p.tok.kind == tokenNumber || p.tok.kind == tokenIdent && !slices.Contains(statementKeywords, p.tok.text) || p.longString() {
+		parts = append(parts, p.binary(level+1))
+	}
+	if len(parts) == 1 {
+		return x
+	}
+	return &ConcatExpr{node: node{Pos: x.Position()}, Parts: parts}
+}
+
+func (p *parser) unary() Expr {
+	if p.isOp("!") || p.isOp("-") {
+		op, pos := p.tok.text, p.tok.pos
+		p.next()
+		return &UnaryExpr{node: node{Pos: pos}, Op: op, X: p.unary()}
+	}
+	return p.primary()
+}
+
+// longString reports whether tok is the "{" of a long string, which the
+// lexer only lexes as such in expression position, and if so makes the
+// long string tok.
+func (p *parser) longString() bool {
+	if !p.isOp("{") {
+		return false
+	}
+	t, ok := p.lex.longStringAt(p.tok)
+	if ok {
+		p.tok = t
+	}
+	return ok
+}
+
+func (p *parser) primary() Expr {
+	n := node{Pos: p.tok.pos}
+	p.longString()
+	switch p.tok.kind {
+	case tokenString:
+		s := &StringLit{node: n, Value: p.tok.text}
+		p.next()
+		return s
+	case tokenNumber:
+		num := &NumberLit{node: n, Raw: p.tok.text}
+		p.next()
+		return num
+	case tokenIdent:
+		name := p.tok.text
+		p.next()
+		if !p.isOp("(") {
+			return &Ident{node: n, Name: name}
+		}
+		p.next()
+		call := &CallExpr{node: n, Func: name}
+		for !p.isOp(")") {
+			call.Args = append(call.Args, p.expr())
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		p.expect(")")
+		return call
+	}
+	if p.isOp("(") {
+		p.next()
+		x := &ParenExpr{node: n, X: p.expr()}
+		p.expect(")")
+		return x
+	}
+	p.fail("unexpected %s, expected an expression", p.tok)
+	return nil
+}
@@ -0,0 +1,244 @@
+package vcl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const mainVCL = `import querystring;
+include "snippet::shared";
+
+backend F_origin {
+  .host = "origin.example.com";
+  .port = "443";
+  .probe = {
+    .request = "HEAD / HTTP/1.1" "Host: origin.example.com" "Connection: close";
+    .threshold = 1;
+  }
+}
+
+director pool random {
+  .quorum = 50%;
+  { .backend = F_origin; .weight = 1; }
+}
+
+table redirects STRING {
+  "/old": "/new",
+  "/a": "/b",
+}
+
+acl internal {
+  "192.0.2.0"/24;
+  !"192.0.2.1";
+}
+
+/* Routes requests. */
+sub vcl_recv {
+  #FASTLY recv
+  declare local var.path STRING;
+  set var.path = querystring.remove(req.url);
+  if (req.http.Cookie:session && client.ip ~ internal) {
+    set req.http.X-Internal = "1";
+  } else if (table.contains(redirects, var.path)) {
+    error 801 table.lookup(redirects, var.path);
+  } elsif (req.url ~ "^/api/") {
+    set req.backend = pool;
+  } else {
+    unset req.http.Cookie;
+  }
+  set req.http.X-Ratio = if(req.http.A, "1", "0");
+  set req.http.X-Count += 1;
+  return(lookup);
+}
+
+sub vcl_error {
+  #FASTLY error
+  if (obj.status == 801) {
+    set obj.status = 301;
+    set obj.http.Location = "https://" req.http.host obj.response;
+    synthetic {"<p>Moved: "quoted" text</p>"};
+    return(deliver);
+  }
+}
+
+sub slug STRING {
+  return regsub(req.url, "^/([^/]+).*", "\1");
+}
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	f, err := Parse("main.vcl", mainVCL)
+	require.NoError(t, err)
+	require.Len(t, f.Decls, 9)
+
+	require.Equal(t, "querystring", f.Decls[0].(*ImportDecl).Name)
+	require.Equal(t, "snippet::shared", f.Decls[1].(*IncludeDecl).Path)
+
+	backend := f.Decls[2].(*BackendDecl)
+	require.Equal(t, "F_origin", backend.Name)
+	require.Equal(t, "443", backend.Properties[1].Value.(*StringLit).Value)
+	probe := backend.Properties[2]
+	require.Equal(t, "probe", probe.Name)
+	require.Len(t, probe.Properties, 2)
+	require.Len(t, probe.Properties[0].Value.(*ConcatExpr).Parts, 3)
+
+	director := f.Decls[3].(*DirectorDecl)
+	require.Equal(t, "random", director.Type)
+	require.Equal(t, "50%", director.Properties[0].Value.(*NumberLit).Raw)
+	require.Equal(t, "F_origin", director.Backends[0][0].Value.(*Ident).Name)
+
+	table := f.Decls[4].(*TableDecl)
+	require.Equal(t, "STRING", table.Type)
+	require.Len(t, table.Entries, 2)
+	require.Equal(t, "/old", table.Entries[0].Key)
+
+	acl := f.Decls[5].(*ACLDecl)
+	require.Equal(t, &ACLEntry{node: node{Pos: Pos{Line: 24, Column: 3}}, Address: "192.0.2.0", Subnet: 24}, acl.Entries[0])
+	require.True(t, acl.Entries[1].Negated)
+	require.Equal(t, -1, acl.Entries[1].Subnet)
+
+	recv := f.Decls[6].(*SubDecl)
+	require.Equal(t, "vcl_recv", recv.Name)
+	require.Equal(t, Pos{Line: 29, Column: 1}, recv.Pos)
+	require.Equal(t, "recv", recv.Body.Stmts[0].(*MacroStmt).Name)
+	require.Equal(t, "STRING", recv.Body.Stmts[1].(*DeclareStmt).Type)
+	ifStmt := recv.Body.Stmts[3].(*IfStmt)
+	cond := ifStmt.Cond.(*BinaryExpr)
+	require.Equal(t, "&&", cond.Op)
+	require.Equal(t, "req.http.Cookie:session", cond.X.(*Ident).Name)
+	require.Equal(t, "~", cond.Y.(*BinaryExpr).Op)
+	elseIf := ifStmt.Else.(*IfStmt)
+	require.Equal(t, "table.contains", elseIf.Cond.(*CallExpr).Func)
+	require.Equal(t, "table.lookup", elseIf.Then.Stmts[0].(*ErrorStmt).Response.(*CallExpr).Func)
+	elsif := elseIf.Else.(*IfStmt)
+	require.IsType(t, &Block{}, elsif.Else)
+	require.Equal(t, "if", recv.Body.Stmts[4].(*SetStmt).Value.(*CallExpr).Func)
+	require.Equal(t, "+=", recv.Body.Stmts[5].(*SetStmt).Op)
+	require.Equal(t, "lookup", recv.Body.Stmts[6].(*ReturnStmt).Action)
+
+	errSub := f.Decls[7].(*SubDecl)
+	then := errSub.Body.Stmts[1].(*IfStmt).Then
+	require.Len(t, then.Stmts[1].(*SetStmt).Value.(*ConcatExpr).Parts, 3)
+	require.Equal(t, `<p>Moved: "quoted" text</p>`, then.Stmts[2].(*SyntheticStmt).Value.(*StringLit).Value)
+
+	slug := f.Decls[8].(*SubDecl)
+	require.Equal(t, "STRING", slug.ReturnType)
+	require.Equal(t, "regsub", slug.Body.Stmts[0].(*ReturnStmt).Value.(*CallExpr).Func)
+
+	var idents int
+	Walk(f, func(n Node) bool {
+		if _, ok := n.(*Ident); ok {
+			idents++
+		}
+		return true
+	})
+	require.Equal(t, 16, idents)
+}
+
+func TestParse_literals(t *testing.T) {
+	t.Parallel()
+
+	// Braces hugging a table key do not open a long string.
+	f, err := Parse("", `table t {"a": "b", "c": {"d"}}`)
+	require.NoError(t, err)
+	table := f.Decls[0].(*TableDecl)
+	require.Len(t, table.Entries, 2)
+	require.Equal(t, "a", table.Entries[0].Key)
+	require.Equal(t, "b", table.Entries[0].Value.(*StringLit).Value)
+	require.Equal(t, "d", table.Entries[1].Value.(*StringLit).Value)
+
+	b, err := ParseStatements("", `set req.http.A = "a" {"b"} {X"c"X};
+set req.http.B = 0x1F;
+`)
+	require.NoError(t, err)
+	parts := b.Stmts[0].(*SetStmt).Value.(*ConcatExpr).Parts
+	require.Len(t, parts, 3)
+	require.Equal(t, "c", parts[2].(*StringLit).Value)
+	require.Equal(t, "0x1F", b.Stmts[1].(*SetStmt).Value.(*NumberLit).Raw)
+}
+
+func TestParse_errors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name, src string
+		errs      []string
+	}{
+		{
+			name: "missing semicolon",
+			src:  "sub vcl_recv {\n  set req.http.A = \"1\"\n  set req.http.B = \"2\";\n}\n",
+			errs: []string{`t.vcl:3:3: expected ";", found "set"`},
+		},
+		{
+			name: "recovers per statement",
+			src:  "sub vcl_recv {\n  set = 1;\n  unset;\n  return(pass);\n}\n",
+			errs: []string{`t.vcl:2:7: expected variable, found "="`, `t.vcl:3:8: expected variable, found ";"`},
+		},
+		{
+			name: "recovers per declaration",
+			src:  "backend b {\n  .port \"443\";\n}\nfoo bar;\nsub vcl_recv {\n}\n",
+			errs: []string{`t.vcl:2:9: expected "=", found string "443"`, `t.vcl:4:1: unexpected "foo", expected a declaration`},
+		},
+		{
+			name: "unterminated string",
+			src:  "sub vcl_recv {\n  set req.http.A = \"1;\n}\n",
+			errs: []string{`t.vcl:2:20: unterminated string`, `t.vcl:3:1: expected ";", found "}"`},
+		},
+		{
+			name: "bad hexadecimal number",
+			src:  "sub vcl_recv {\n  set req.http.A = 0x;\n}\n",
+			errs: []string{`t.vcl:2:20: invalid hexadecimal number`},
+		},
+		{
+			name: "unclosed subroutine",
+			src:  "sub vcl_recv {\n  if (req.url) {\n",
+			errs: []string{`t.vcl:3:1: expected "}", found end of file`},
+		},
+		{
+			name: "bad local variable",
+			src:  "sub vcl_recv {\n  declare local x STRING;\n}\n",
+			errs: []string{`t.vcl:2:3: local variable "x" must start with "var."`},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse("t.vcl", tc.src)
+			var list ErrorList
+			require.True(t, errors.As(err, &list), "error %v", err)
+			var msgs []string
+			for _, e := range list {
+				msgs = append(msgs, e.Error())
+			}
+			require.Equal(t, tc.errs, msgs)
+		})
+	}
+}
+
+func TestParseStatements(t *testing.T) {
+	t.Parallel()
+
+	b, err := ParseStatements("", `
+if (req.http.X-Debug) {
+  log "syslog " req.service_id " logs :: " req.url;
+  goto done;
+}
+add resp.http.Set-Cookie = "a=b";
+remove resp.http.X-Internal;
+done:
+esi;
+`)
+	require.NoError(t, err)
+	require.Len(t, b.Stmts, 5)
+	require.Equal(t, "done", b.Stmts[0].(*IfStmt).Then.Stmts[1].(*GotoStmt).Label)
+	require.Equal(t, "remove", b.Stmts[2].(*UnsetStmt).Keyword)
+	require.Equal(t, "done", b.Stmts[3].(*LabelStmt).Label)
+	require.Equal(t, "esi", b.Stmts[4].(*KeywordStmt).Keyword)
+
+	_, err = ParseStatements("", "set req.http.A = ;\n}\n")
+	require.EqualError(t, err, `1:18: unexpected ";", expected an expression (and 1 more errors)`)
+}
@@ -0,0 +1,510 @@
+package fastly
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// DiffAction is the kind of difference between the objects of two versions.
+type DiffAction string
+
+const (
+	// DiffAdded is an object which only exists in the newer version.
+	DiffAdded DiffAction = "added"
+	// DiffRemoved is an object which only exists in the older version.
+	DiffRemoved DiffAction = "removed"
+	// DiffChanged is an object whose fields differ between the versions.
+	DiffChanged DiffAction = "changed"
+)
+
+// FieldDiff is a difference in a single field of an object.
+type FieldDiff struct {
+	// Field is the name of the field, as used by the API. Fields of nested
+	// objects are joined with a dot, e.g. "response.status".
+	Field string `json:"field"`
+	// Old is the value in the older version, or nil if it is not set.
+	Old any `json:"old,omitempty"`
+	// New is the value in the newer version, or nil if it is not set.
+	New any `json:"new,omitempty"`
+}
+
+// ObjectDiff is a difference between the objects of two versions.
+type ObjectDiff struct {
+	// Action is the kind of difference.
+	Action DiffAction `json:"action"`
+	// Kind is the kind of object, as in ConfigChange, e.g. "backend",
+	// "logging/s3", "dictionary_item" or "rate_limiter".
+	Kind string `json:"kind"`
+	// Name identifies the object within its kind. It is empty for objects
+	// of which a version has a single one, such as "settings". Dictionary
+	// items and ACL entries are named after their dictionary or ACL, e.g.
+	// "config/key" or "block/192.0.2.0/24".
+	Name string `json:"name,omitempty"`
+	// Fields are the differing fields, ordered by name. For added and
+	// removed objects, they are all of the fields which are set.
+	Fields []*FieldDiff `json:"fields"`
+}
+
+// String returns a description of the object, e.g. `backend "origin"`.
+func (d *ObjectDiff) String() string {
+	if d.Name == "" {
+		return d.Kind
+	}
+	return fmt.Sprintf("%s %q", d.Kind, d.Name)
+}
+
+// VersionDiff is the object-level difference between two versions of a
+// service, as returned by DiffVersions. It is rendered as text by String,
+// as JSON by encoding/json and as a unified diff by Unified.
+type VersionDiff struct {
+	// ServiceID is the ID of the service.
+	ServiceID string `json:"service_id"`
+	// From is the older version.
+	From int `json:"from"`
+	// To is the newer version.
+	To int `json:"to"`
+	// Objects are the differing objects, ordered by kind and name.
+	Objects []*ObjectDiff `json:"objects"`
+}
+
+// Empty reports whether the versions have no differences.
+func (d *VersionDiff) Empty() bool {
+	return len(d.Objects) == 0
+}
+
+// String returns a human-readable summary of the differences, listing the
+// fields of added and changed objects. Multi-line values, such as VCL, are
+// summarized by their number of lines; see Unified for their differences.
+func (d *VersionDiff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service %s version %d -> %d: ", d.ServiceID, d.From, d.To)
+	if d.Empty() {
+		b.WriteString("no differences\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "%d difference(s)\n", len(d.Objects))
+	for _, o := range d.Objects {
+		symbol := map[DiffAction]string{DiffAdded: "+", DiffRemoved: "-", DiffChanged: "~"}[o.Action]
+		fmt.Fprintf(&b, "%s %s\n", symbol, o)
+		for _, f := range o.Fields {
+			switch o.Action {
+			case DiffAdded:
+				fmt.Fprintf(&b, "    %s: %s\n", f.Field, diffSummary(f.New))
+			case DiffChanged:
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", f.Field, diffSummary(f.Old), diffSummary(f.New))
+			}
+		}
+	}
+	return b.String()
+}
+
+// Unified renders the differences as a unified diff, in which each object
+// is a file named after its kind and name, and each field a line. The
+// lines of multi-line values are compared individually.
+func (d *VersionDiff) Unified() string {
+	var b strings.Builder
+	for _, o := range d.Objects {
+		name := o.Kind
+		if o.Name != "" {
+			name += "/" + o.Name
+		}
+		from, to := "a/"+name, "b/"+name
+		var before, after []string
+		for _, f := range o.Fields {
+			before = append(before, diffLines(f.Field, f.Old)...)
+			after = append(after, diffLines(f.Field, f.New)...)
+		}
+		switch o.Action {
+		case DiffAdded:
+			from = "/dev/null"
+		case DiffRemoved:
+			to = "/dev/null"
+		}
+		fmt.Fprintf(&b, "--- %s\n+++ %s\n", from, to)
+		writeHunks(&b, before, after, 3)
+	}
+	return b.String()
+}
+
+// diffSummary formats a value for VersionDiff.String.
+func diffSummary(v any) string {
+	if v == nil {
+		return "(unset)"
+	}
+	if s, ok := v.(string); ok && strings.Contains(s, "\n") {
+		return fmt.Sprintf("(%d lines)", strings.Count(strings.TrimSuffix(s, "\n"), "\n")+1)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// diffLines formats a field as the lines of VersionDiff.Unified. Multi-line
+// strings are written as a YAML style literal block, so that each of their
+// lines is compared.
+func diffLines(field string, v any) []string {
+	if v == nil {
+		return nil
+	}
+	if s, ok := v.(string); ok && strings.Contains(s, "\n") {
+		lines := []string{field + ": |"}
+		for l := range strings.Lines(s) {
+			lines = append(lines, "  "+strings.TrimSuffix(l, "\n"))
+		}
+		return lines
+	}
+	return []string{field + ": " + diffSummary(v)}
+}
+
+// lineOp is a line of a line-by-line comparison: ' ' for a line in both
+// inputs, '-' for a line only in the old one, and '+' for a line only in
+// the new one.
+type lineOp struct {
+	op   byte
+	line string
+}
+
+// maxLineDiffCells bounds the size of the table used to compare lines.
+// Beyond it, the differing part of the inputs is reported as replaced.
+const maxLineDiffCells = 4 << 20
+
+// compareLines compares two sequences of lines, returning a minimal edit
+// script when the lines after their common prefix and suffix are few
+// enough to compare.
+func compareLines(a, b []string) []lineOp {
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []lineOp
+	for _, l := range a[:prefix] {
+		ops = append(ops, lineOp{' ', l})
+	}
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if (len(ma)+1)*(len(mb)+1) > maxLineDiffCells {
+		for _, l := range ma {
+			ops = append(ops, lineOp{'-', l})
+		}
+		for _, l := range mb {
+			ops = append(ops, lineOp{'+', l})
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of
+		// ma[i:] and mb[j:].
+		lcs := make([][]int32, len(ma)+1)
+		for i := range lcs {
+			lcs[i] = make([]int32, len(mb)+1)
+		}
+		for i := len(ma) - 1; i >= 0; i-- {
+			for j := len(mb) - 1; j >= 0; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(ma) || j < len(mb) {
+			switch {
+			case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+				ops = append(ops, lineOp{' ', ma[i]})
+				i++
+				j++
+			case j == len(mb) || (i < len(ma) && lcs[i+1][j] >= lcs[i][j+1]):
+				ops = append(ops, lineOp{'-', ma[i]})
+				i++
+			default:
+				ops = append(ops, lineOp{'+', mb[j]})
+				j++
+			}
+		}
+	}
+	for _, l := range a[len(a)-suffix:] {
+		ops = append(ops, lineOp{' ', l})
+	}
+	return ops
+}
+
+// writeHunks writes the differences between two sequences of lines as
+// unified diff hunks, with the given number of lines of context.
+func writeHunks(b *strings.Builder, a, c []string, context int) {
+	ops := compareLines(a, c)
+	for start := 0; start < len(ops); {
+		// Find the next change, and extend the hunk until the changes are
+		// more than twice the context apart.
+		first := slices.IndexFunc(ops[start:], func(o lineOp) bool { return o.op != ' ' })
+		if first < 0 {
+			return
+		}
+		first += start
+		last := first
+		for i := first + 1; i < len(ops) && i <= last+2*context; i++ {
+			if ops[i].op != ' ' {
+				last = i
+			}
+		}
+		from, to := max(first-context, start), min(last+context+1, len(ops))
+
+		// Line numbers are 1-based, and refer to the line before the hunk
+		// when it has no lines on one side.
+		oldStart, newStart := 1, 1
+		for _, o := range ops[:from] {
+			if o.op != '+' {
+				oldStart++
+			}
+			if o.op != '-' {
+				newStart++
+			}
+		}
+		var oldLines, newLines int
+		for _, o := range ops[from:to] {
+			if o.op != '+' {
+				oldLines++
+			}
+			if o.op != '-' {
+				newLines++
+			}
+		}
+		if oldLines == 0 {
+			oldStart--
+		}
+		if newLines == 0 {
+			newStart--
+		}
+		fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", oldStart, oldLines, newStart, newLines)
+		for _, o := range ops[from:to] {
+			fmt.Fprintf(b, "%c%s\n", o.op, o.line)
+		}
+		start = to
+	}
+}
+
+// DiffVersionsInput is used as input to the DiffVersions function.
+type DiffVersionsInput struct {
+	// From is the version to diff from (required).
+	From int
+	// IncludeEntries also compares dictionary items and ACL entries. They
+	// are not versioned, so they only differ between versions whose
+	// dictionaries or ACLs were created separately, and listing them takes
+	// a request per dictionary and ACL.
+	IncludeEntries bool
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// To is the version to diff up to (required).
+	To int
+}
+
+// DiffVersions compares the objects attached to two versions of a service
+// field by field: the objects of a ServiceVersionSnapshot, and the rate
+// limiters. Unlike GetDiff, it works for both VCL and Compute services.
+//
+// Objects are matched by kind and name, so a renamed object is reported as
+// removed and added. Fields which always differ between versions, such as
+// CreatedAt, UpdatedAt, ServiceVersion and IDs assigned by the API, are
+// ignored. Credentials, named as in DefaultRedactor, are compared but their
+// values are reported as RedactedValue.
+func (c *Client) DiffVersions(ctx context.Context, i *DiffVersionsInput) (*VersionDiff, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.From == 0 {
+		return nil, ErrMissingFrom
+	}
+	if i.To == 0 {
+		return nil, ErrMissingTo
+	}
+
+	from, err := c.versionObjects(ctx, i.ServiceID, i.From, i.IncludeEntries)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.versionObjects(ctx, i.ServiceID, i.To, i.IncludeEntries)
+	if err != nil {
+		return nil, err
+	}
+	return &VersionDiff{
+		ServiceID: i.ServiceID,
+		From:      i.From,
+		To:        i.To,
+		Objects:   diffVersionObjects(from, to),
+	}, nil
+}
+
+// versionObject is an object compared by DiffVersions.
+type versionObject struct {
+	kind   string
+	name   string
+	fields map[string]any
+}
+
+// versionObjectKinds orders the kinds of objects compared by DiffVersions.
+func versionObjectKinds() []string {
+	kinds := []string{"settings"}
+	for _, k := range configKinds {
+		kinds = append(kinds, k.name)
+	}
+	return append(kinds, "rate_limiter", "vcl", "dictionary", "dictionary_item", "acl", "acl_entry", "resource", "package")
+}
+
+// versionObjects returns the objects of a version compared by DiffVersions.
+// Dictionary items and ACL entries are only included if entries is true.
+func (c *Client) versionObjects(ctx context.Context, serviceID string, serviceVersion int, entries bool) ([]versionObject, error) {
+	s, err := c.exportServiceVersion(ctx, &ExportServiceVersionInput{IncludeSecrets: true, ServiceID: serviceID, ServiceVersion: serviceVersion}, entries)
+	if err != nil {
+		return nil, err
+	}
+	erls, err := c.ListERLs(ctx, &ListERLsInput{ServiceID: serviceID, ServiceVersion: serviceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("listing rate limiters: %w", err)
+	}
+
+	var objs []versionObject
+	add := func(kind, name string, obj map[string]any) {
+		if obj == nil {
+			return
+		}
+		fields := map[string]any{}
+		flattenFields(fields, "", obj)
+		delete(fields, "name")
+		objs = append(objs, versionObject{kind: kind, name: name, fields: fields})
+	}
+	named := func(obj map[string]any) string {
+		name, _ := obj["name"].(string)
+		return name
+	}
+
+	add("settings", "", snapshotObject(s.Settings))
+	for _, k := range configKinds {
+		all, _ := k.objects(s.Config)
+		for _, o := range snapshotObjects(all) {
+			add(k.name, named(o), o)
+		}
+	}
+	for _, o := range snapshotObjects(erls) {
+		add("rate_limiter", named(o), o)
+	}
+	for _, o := range snapshotObjects(s.VCLs) {
+		add("vcl", named(o), o)
+	}
+	for _, d := range s.Dictionaries {
+		dict := ToValue(d.Dictionary.Name)
+		add("dictionary", dict, snapshotObject(d.Dictionary))
+		for _, o := range snapshotObjects(d.Items) {
+			key, _ := o["item_key"].(string)
+			add("dictionary_item", dict+"/"+key, o)
+		}
+	}
+	for _, a := range s.ACLs {
+		acl := ToValue(a.ACL.Name)
+		add("acl", acl, snapshotObject(a.ACL))
+		for _, e := range a.Entries {
+			name := acl + "/" + ToValue(e.IP)
+			if e.Subnet != nil {
+				name += fmt.Sprintf("/%d", *e.Subnet)
+			}
+			add("acl_entry", name, snapshotObject(e))
+		}
+	}
+	for _, o := range snapshotObjects(s.Resources) {
+		add("resource", named(o), o)
+	}
+	add("package", "", snapshotObject(s.Package))
+	return objs, nil
+}
+
+// flattenFields copies the fields of obj into fields, joining the names of
+// nested objects' fields to their parent's with a dot.
+func flattenFields(fields map[string]any, prefix string, obj map[string]any) {
+	for k, v := range obj {
+		if nested, ok := v.(map[string]any); ok {
+			flattenFields(fields, prefix+k+".", nested)
+			continue
+		}
+		fields[prefix+k] = v
+	}
+}
+
+// diffVersionObjects compares the objects of two versions.
+func diffVersionObjects(from, to []versionObject) []*ObjectDiff {
+	type key struct{ kind, name string }
+	previous := make(map[key]versionObject, len(from))
+	for _, o := range from {
+		previous[key{o.kind, o.name}] = o
+	}
+	seen := map[key]bool{}
+
+	var diffs []*ObjectDiff
+	for _, o := range to {
+		k := key{o.kind, o.name}
+		seen[k] = true
+		prev, ok := previous[k]
+		if !ok {
+			diffs = append(diffs, &ObjectDiff{Action: DiffAdded, Kind: o.kind, Name: o.name, Fields: diffFields(nil, o.fields)})
+			continue
+		}
+		if fields := diffFields(prev.fields, o.fields); len(fields) > 0 {
+			diffs = append(diffs, &ObjectDiff{Action: DiffChanged, Kind: o.kind, Name: o.name, Fields: fields})
+		}
+	}
+	for _, o := range from {
+		if !seen[key{o.kind, o.name}] {
+			diffs = append(diffs, &ObjectDiff{Action: DiffRemoved, Kind: o.kind, Name: o.name, Fields: diffFields(o.fields, nil)})
+		}
+	}
+
+	kinds := versionObjectKinds()
+	slices.SortStableFunc(diffs, func(a, b *ObjectDiff) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(kinds, a.Kind), slices.Index(kinds, b.Kind)),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return diffs
+}
+
+// maskedValue returns RedactedValue in place of v, unless v is not set.
+func maskedValue(v any) any {
+	if v == nil || v == "" {
+		return v
+	}
+	return RedactedValue
+}
+
+// diffFields returns the fields which differ between before and after,
+// ordered by name. The values of credential fields are masked.
+func diffFields(before, after map[string]any) []*FieldDiff {
+	r := DefaultRedactor()
+	names := slices.Sorted(maps.Keys(before))
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			names = append(names, k)
+		}
+	}
+	slices.Sort(names)
+
+	diffs := []*FieldDiff{}
+	for _, name := range names {
+		o, n := before[name], after[name]
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		if r.sensitive(name[strings.LastIndexByte(name, '.')+1:]) {
+			o, n = maskedValue(o), maskedValue(n)
+		}
+		diffs = append(diffs, &FieldDiff{Field: name, Old: o, New: n})
+	}
+	return diffs
+}
@@ -0,0 +1,162 @@
+package fastly_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_DiffVersions(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("diff")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("a"), Port: fastly.ToPointer(443)})
+	require.NoError(t, err)
+	_, err = c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("h"), Destination: fastly.ToPointer("http.X")})
+	require.NoError(t, err)
+	_, err = c.CreateVCL(ctx, &fastly.CreateVCLInput{
+		ServiceID:      id,
+		ServiceVersion: 1,
+		Name:           fastly.ToPointer("main"),
+		Content:        fastly.ToPointer("sub vcl_recv {\n  #FASTLY recv\n  set req.http.A = \"1\";\n  return(lookup);\n}\n"),
+	})
+	require.NoError(t, err)
+	erl, err := c.CreateERL(ctx, &fastly.CreateERLInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("limit"), RpsLimit: fastly.ToPointer(100)})
+	require.NoError(t, err)
+
+	_, err = c.CloneVersion(ctx, &fastly.CloneVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+
+	diff, err := c.DiffVersions(ctx, &fastly.DiffVersionsInput{ServiceID: id, From: 1, To: 2})
+	require.NoError(t, err)
+	require.True(t, diff.Empty())
+	require.Equal(t, "Service "+id+" version 1 -> 2: no differences\n", diff.String())
+
+	_, err = c.UpdateBackend(ctx, &fastly.UpdateBackendInput{ServiceID: id, ServiceVersion: 2, Name: "a", Port: fastly.ToPointer(8080)})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: 2, Name: fastly.ToPointer("b"), Address: fastly.ToPointer("b.example.com")})
+	require.NoError(t, err)
+	err = c.DeleteHeader(ctx, &fastly.DeleteHeaderInput{ServiceID: id, ServiceVersion: 2, Name: "h"})
+	require.NoError(t, err)
+	_, err = c.UpdateVCL(ctx, &fastly.UpdateVCLInput{
+		ServiceID:      id,
+		ServiceVersion: 2,
+		Name:           "main",
+		Content:        fastly.ToPointer("sub vcl_recv {\n  #FASTLY recv\n  set req.http.A = \"2\";\n  return(lookup);\n}\n"),
+	})
+	require.NoError(t, err)
+	erls, err := c.ListERLs(ctx, &fastly.ListERLsInput{ServiceID: id, ServiceVersion: 2})
+	require.NoError(t, err)
+	require.NotEqual(t, *erl.RateLimiterID, *erls[0].RateLimiterID)
+	_, err = c.UpdateERL(ctx, &fastly.UpdateERLInput{ERLID: *erls[0].RateLimiterID, RpsLimit: fastly.ToPointer(200)})
+	require.NoError(t, err)
+
+	_, err = c.DiffVersions(ctx, &fastly.DiffVersionsInput{ServiceID: id, From: 1})
+	require.ErrorIs(t, err, fastly.ErrMissingTo)
+
+	diff, err = c.DiffVersions(ctx, &fastly.DiffVersionsInput{ServiceID: id, From: 1, To: 2})
+	require.NoError(t, err)
+	require.Equal(t, `Service `+id+` version 1 -> 2: 5 difference(s)
+~ backend "a"
+    port: 443 -> 8080
++ backend "b"
+    address: "b.example.com"
+- header "h"
+~ rate_limiter "limit"
+    rps_limit: 100 -> 200
+~ vcl "main"
+    content: (5 lines) -> (5 lines)
+`, diff.String())
+
+	require.Equal(t, `--- a/backend/a
++++ b/backend/a
+@@ -1,1 +1,1 @@
+-port: 443
++port: 8080
+--- /dev/null
++++ b/backend/b
+@@ -0,0 +1,1 @@
++address: "b.example.com"
+--- a/header/h
++++ /dev/null
+@@ -1,1 +0,0 @@
+-dst: "http.X"
+--- a/rate_limiter/limit
++++ b/rate_limiter/limit
+@@ -1,1 +1,1 @@
+-rps_limit: 100
++rps_limit: 200
+--- a/vcl/main
++++ b/vcl/main
+@@ -1,6 +1,6 @@
+ content: |
+   sub vcl_recv {
+     #FASTLY recv
+-    set req.http.A = "1";
++    set req.http.A = "2";
+     return(lookup);
+   }
+`, diff.Unified())
+
+	out, err := json.Marshal(diff)
+	require.NoError(t, err)
+	var decoded struct {
+		Objects []struct {
+			Action string `json:"action"`
+			Kind   string `json:"kind"`
+			Name   string `json:"name"`
+			Fields []struct {
+				Field string `json:"field"`
+				Old   any    `json:"old"`
+				New   any    `json:"new"`
+			} `json:"fields"`
+		} `json:"objects"`
+	}
+	require.NoError(t, json.Unmarshal(out, &decoded))
+	require.Len(t, decoded.Objects, 5)
+	require.Equal(t, "changed", decoded.Objects[0].Action)
+	require.Equal(t, "port", decoded.Objects[0].Fields[0].Field)
+	require.EqualValues(t, 443, decoded.Objects[0].Fields[0].Old)
+	require.EqualValues(t, 8080, decoded.Objects[0].Fields[0].New)
+	require.Equal(t, "removed", decoded.Objects[2].Action)
+	require.Nil(t, decoded.Objects[2].Fields[0].New)
+
+	// Credentials are compared, but masked.
+	_, err = c.CreateFTP(ctx, &fastly.CreateFTPInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("ftp"), Password: fastly.ToPointer("old-password")})
+	require.NoError(t, err)
+	_, err = c.CreateFTP(ctx, &fastly.CreateFTPInput{ServiceID: id, ServiceVersion: 2, Name: fastly.ToPointer("ftp"), Password: fastly.ToPointer("new-password")})
+	require.NoError(t, err)
+
+	// Dictionary items are only compared on request.
+	dict, err := c.CreateDictionary(ctx, &fastly.CreateDictionaryInput{ServiceID: id, ServiceVersion: 2, Name: fastly.ToPointer("config")})
+	require.NoError(t, err)
+	_, err = c.CreateDictionaryItem(ctx, &fastly.CreateDictionaryItemInput{ServiceID: id, DictionaryID: *dict.DictionaryID, ItemKey: fastly.ToPointer("k"), ItemValue: fastly.ToPointer("v")})
+	require.NoError(t, err)
+
+	diff, err = c.DiffVersions(ctx, &fastly.DiffVersionsInput{ServiceID: id, From: 1, To: 2})
+	require.NoError(t, err)
+	require.Len(t, diff.Objects, 7)
+	require.NotContains(t, diff.String(), "password\"")
+	require.Contains(t, diff.String(), "~ logging/ftp \"ftp\"\n    password: \"[REDACTED]\" -> \"[REDACTED]\"\n")
+	require.Contains(t, diff.String(), "+ dictionary \"config\"\n")
+	require.NotContains(t, diff.String(), "dictionary_item")
+
+	diff, err = c.DiffVersions(ctx, &fastly.DiffVersionsInput{ServiceID: id, From: 1, To: 2, IncludeEntries: true})
+	require.NoError(t, err)
+	require.Len(t, diff.Objects, 8)
+	require.Contains(t, diff.String(), "+ dictionary_item \"config/k\"\n")
+}
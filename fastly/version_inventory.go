@@ -0,0 +1,346 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// VersionState classifies a service version in a [VersionInventory].
+type VersionState string
+
+const (
+	// VersionStateActive is the version active in production.
+	VersionStateActive VersionState = "active"
+	// VersionStateStaged is a version active in another environment, such as
+	// staging.
+	VersionStateStaged VersionState = "staged"
+	// VersionStateLocked is a locked version which is no longer active.
+	VersionStateLocked VersionState = "locked"
+	// VersionStateDraft is an unlocked version newer than every locked
+	// version, which may still be activated.
+	VersionStateDraft VersionState = "draft"
+	// VersionStateOrphaned is an unlocked version older than a locked
+	// version, i.e. a draft that was superseded without being activated.
+	VersionStateOrphaned VersionState = "orphaned"
+	// VersionStateStale is an unlocked version which was last updated before
+	// the stale age of the inventory, or which is tagged as stale.
+	VersionStateStale VersionState = "stale"
+)
+
+// DefaultStaleDraftAge is the age after which an unlocked version is stale
+// if the MaxAge of GetVersionInventoryInput is zero.
+const DefaultStaleDraftAge = 30 * 24 * time.Hour
+
+// DefaultStaleDraftTag is the tag CleanupStaleDrafts adds to the comment of
+// stale drafts if its Tag is empty.
+const DefaultStaleDraftTag = "[stale-draft]"
+
+// DefaultReuseMaxDrafts is the number of drafts ReuseOrCloneVersion compares
+// with the base version if the MaxDrafts of ReuseOrCloneVersionInput is
+// zero.
+const DefaultReuseMaxDrafts = 3
+
+// VersionInventoryEntry is a classified version.
+type VersionInventoryEntry struct {
+	// State is the class of the version.
+	State VersionState
+	// Version is the version.
+	Version *Version
+}
+
+// VersionInventory classifies the versions of a service.
+type VersionInventory struct {
+	// Entries are the classified versions, ordered by version number.
+	Entries []*VersionInventoryEntry
+	// ServiceID is the ID of the service.
+	ServiceID string
+}
+
+// Versions returns the versions in the given states, ordered by version
+// number.
+func (inv *VersionInventory) Versions(states ...VersionState) []*Version {
+	var out []*Version
+	for _, e := range inv.Entries {
+		for _, state := range states {
+			if e.State == state {
+				out = append(out, e.Version)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// String returns a human-readable summary of the inventory, with the number
+// of versions in each state followed by the version numbers.
+func (inv *VersionInventory) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service %s: %d version(s)\n", inv.ServiceID, len(inv.Entries))
+	for _, state := range []VersionState{VersionStateActive, VersionStateStaged, VersionStateDraft, VersionStateLocked, VersionStateOrphaned, VersionStateStale} {
+		versions := inv.Versions(state)
+		if len(versions) == 0 {
+			continue
+		}
+		numbers := make([]string, len(versions))
+		for n, v := range versions {
+			numbers[n] = fmt.Sprint(ToValue(v.Number))
+		}
+		fmt.Fprintf(&b, "  %s: %d (%s)\n", state, len(versions), strings.Join(numbers, ", "))
+	}
+	return b.String()
+}
+
+// GetVersionInventoryInput is used as input to the GetVersionInventory
+// function.
+type GetVersionInventoryInput struct {
+	// MaxAge is the age after which an unlocked version is stale. It defaults
+	// to DefaultStaleDraftAge.
+	MaxAge time.Duration
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// Tag marks stale versions in their comment. It defaults to
+	// DefaultStaleDraftTag.
+	Tag string
+}
+
+// GetVersionInventory lists the versions of a service and classifies them.
+//
+// A version is active if it is active in production, staged if it is active
+// in any other environment, and locked if it is otherwise locked. Unlocked
+// versions are stale if they were last updated more than MaxAge ago or their
+// comment contains Tag, orphaned if a newer version is locked, and drafts
+// otherwise.
+func (c *Client) GetVersionInventory(ctx context.Context, i *GetVersionInventoryInput) (*VersionInventory, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	maxAge := i.MaxAge
+	if maxAge == 0 {
+		maxAge = DefaultStaleDraftAge
+	}
+	tag := i.Tag
+	if tag == "" {
+		tag = DefaultStaleDraftTag
+	}
+
+	versions, err := c.ListVersions(ctx, &ListVersionsInput{ServiceID: i.ServiceID})
+	if err != nil {
+		return nil, err
+	}
+
+	var newestLocked int
+	for _, v := range versions {
+		if ToValue(v.Locked) || ToValue(v.Active) {
+			newestLocked = max(newestLocked, ToValue(v.Number))
+		}
+	}
+	cutoff := time.Now().Add(-maxAge)
+	inv := &VersionInventory{ServiceID: i.ServiceID}
+	for _, v := range versions {
+		e := &VersionInventoryEntry{Version: v}
+		switch {
+		case ToValue(v.Active):
+			e.State = VersionStateActive
+		case versionStaged(v):
+			e.State = VersionStateStaged
+		case ToValue(v.Locked):
+			e.State = VersionStateLocked
+		case strings.Contains(ToValue(v.Comment), tag) || versionUpdatedAt(v).Before(cutoff):
+			e.State = VersionStateStale
+		case ToValue(v.Number) < newestLocked:
+			e.State = VersionStateOrphaned
+		default:
+			e.State = VersionStateDraft
+		}
+		inv.Entries = append(inv.Entries, e)
+	}
+	return inv, nil
+}
+
+// versionStaged reports whether v is active in a non-production
+// environment.
+func versionStaged(v *Version) bool {
+	if ToValue(v.Staging) {
+		return true
+	}
+	for _, env := range v.Environments {
+		if env != nil && env.ServiceVersion != nil && int(*env.ServiceVersion) == ToValue(v.Number) {
+			return true
+		}
+	}
+	return false
+}
+
+// versionUpdatedAt returns the time v was last updated, or created if the
+// update time is unknown.
+func versionUpdatedAt(v *Version) time.Time {
+	switch {
+	case v.UpdatedAt != nil:
+		return *v.UpdatedAt
+	case v.CreatedAt != nil:
+		return *v.CreatedAt
+	}
+	return time.Time{}
+}
+
+// StaleDraftAction is the action CleanupStaleDrafts takes on stale drafts.
+type StaleDraftAction string
+
+const (
+	// StaleDraftLock locks stale drafts.
+	StaleDraftLock StaleDraftAction = "lock"
+	// StaleDraftTag prefixes the comment of stale drafts with a tag.
+	StaleDraftTag StaleDraftAction = "tag"
+)
+
+// CleanupStaleDraftsInput is used as input to the CleanupStaleDrafts
+// function.
+type CleanupStaleDraftsInput struct {
+	// Action is the action to take on each stale draft (required).
+	Action StaleDraftAction
+	// IncludeOrphaned also applies Action to orphaned drafts.
+	IncludeOrphaned bool
+	// MaxAge is the age after which an unlocked version is stale. It defaults
+	// to DefaultStaleDraftAge.
+	MaxAge time.Duration
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// Tag is the tag added by StaleDraftTag, which also marks versions as
+	// stale in later inventories. It defaults to DefaultStaleDraftTag.
+	Tag string
+}
+
+// CleanupStaleDrafts locks or tags the stale drafts of a service, so they
+// are not reused by accident, and returns the updated versions. Versions
+// which are already tagged are not tagged again.
+//
+// If an update fails, the versions updated so far are returned along with
+// the error.
+func (c *Client) CleanupStaleDrafts(ctx context.Context, i *CleanupStaleDraftsInput) ([]*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.Action != StaleDraftLock && i.Action != StaleDraftTag {
+		return nil, ErrMissingAction
+	}
+	tag := i.Tag
+	if tag == "" {
+		tag = DefaultStaleDraftTag
+	}
+
+	inv, err := c.GetVersionInventory(ctx, &GetVersionInventoryInput{MaxAge: i.MaxAge, ServiceID: i.ServiceID, Tag: tag})
+	if err != nil {
+		return nil, err
+	}
+	states := []VersionState{VersionStateStale}
+	if i.IncludeOrphaned {
+		states = append(states, VersionStateOrphaned)
+	}
+
+	var updated []*Version
+	for _, v := range inv.Versions(states...) {
+		number := ToValue(v.Number)
+		var (
+			u   *Version
+			err error
+		)
+		if i.Action == StaleDraftLock {
+			u, err = c.LockVersion(ctx, &LockVersionInput{ServiceID: i.ServiceID, ServiceVersion: number})
+		} else {
+			comment := ToValue(v.Comment)
+			if strings.Contains(comment, tag) {
+				continue
+			}
+			comment = strings.TrimSpace(tag + " " + comment)
+			u, err = c.UpdateVersion(ctx, &UpdateVersionInput{ServiceID: i.ServiceID, ServiceVersion: number, Comment: &comment})
+		}
+		if err != nil {
+			return updated, fmt.Errorf("version %d: %w", number, err)
+		}
+		updated = append(updated, u)
+	}
+	return updated, nil
+}
+
+// ReuseOrCloneVersionInput is used as input to the ReuseOrCloneVersion
+// function.
+type ReuseOrCloneVersionInput struct {
+	// MaxAge is the age after which an unlocked version is stale. It defaults
+	// to DefaultStaleDraftAge.
+	MaxAge time.Duration
+	// MaxDrafts is the number of drafts, newest first, compared with
+	// ServiceVersion. Each comparison exports a version. It defaults to
+	// DefaultReuseMaxDrafts.
+	MaxDrafts int
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// ServiceVersion is the version to clone, and which a reused draft must
+	// be identical to. It defaults to the active version, or else the newest
+	// locked version.
+	ServiceVersion int
+	// Tag marks stale versions in their comment. It defaults to
+	// DefaultStaleDraftTag.
+	Tag string
+}
+
+// ReuseOrCloneVersion returns the newest clean draft of a service, or else a
+// new clone of ServiceVersion. A clean draft is a version in the
+// VersionStateDraft state whose configuration does not differ from
+// ServiceVersion (see DiffVersions). Only the newest MaxDrafts drafts are
+// compared, without their dictionary items and ACL entries. If the service
+// has no base version to compare with, the newest draft is clean.
+func (c *Client) ReuseOrCloneVersion(ctx context.Context, i *ReuseOrCloneVersionInput) (*Version, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+
+	inv, err := c.GetVersionInventory(ctx, &GetVersionInventoryInput{MaxAge: i.MaxAge, ServiceID: i.ServiceID, Tag: i.Tag})
+	if err != nil {
+		return nil, err
+	}
+	base := i.ServiceVersion
+	if base == 0 {
+		for _, v := range inv.Versions(VersionStateActive, VersionStateLocked, VersionStateStaged) {
+			if ToValue(v.Active) {
+				base = ToValue(v.Number)
+				break
+			}
+			base = ToValue(v.Number)
+		}
+	}
+
+	drafts := inv.Versions(VersionStateDraft)
+	if base == 0 {
+		if len(drafts) == 0 {
+			return nil, fmt.Errorf("service %s has no version to clone", i.ServiceID)
+		}
+		return drafts[len(drafts)-1], nil
+	}
+	maxDrafts := i.MaxDrafts
+	if maxDrafts == 0 {
+		maxDrafts = DefaultReuseMaxDrafts
+	}
+	var baseObjects []versionObject
+	for n := len(drafts) - 1; n >= 0 && maxDrafts > 0; n-- {
+		v := drafts[n]
+		if ToValue(v.Number) == base {
+			continue
+		}
+		maxDrafts--
+		if baseObjects == nil {
+			if baseObjects, err = c.versionObjects(ctx, i.ServiceID, base, false); err != nil {
+				return nil, err
+			}
+		}
+		objects, err := c.versionObjects(ctx, i.ServiceID, ToValue(v.Number), false)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffVersionObjects(baseObjects, objects)) == 0 {
+			return v, nil
+		}
+	}
+	return c.CloneVersion(ctx, &CloneVersionInput{ServiceID: i.ServiceID, ServiceVersion: base})
+}
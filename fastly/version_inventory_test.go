@@ -0,0 +1,120 @@
+package fastly_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_VersionInventory(t *testing.T) {
+	t.Parallel()
+
+	var now atomic.Pointer[time.Time]
+	setNow := func(t time.Time) { now.Store(&t) }
+	setNow(time.Now().Add(-60 * 24 * time.Hour))
+	srv := fastlytest.NewServer(fastlytest.WithClock(func() time.Time { return *now.Load() }))
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("inventory")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+	clone := func(from int) int {
+		v, err := c.CloneVersion(ctx, &fastly.CloneVersionInput{ServiceID: id, ServiceVersion: from})
+		require.NoError(t, err)
+		return *v.Number
+	}
+
+	// Version 1 was active and version 2 abandoned two months ago.
+	_, err = c.CreateDomain(ctx, &fastly.CreateDomainInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("example.com")})
+	require.NoError(t, err)
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	clone(1)
+
+	// Version 3 was superseded by version 4 an hour ago, version 5 is staged
+	// and versions 6 and 7 are drafts, of which only 6 is unchanged.
+	setNow(time.Now().Add(-time.Hour))
+	clone(1)
+	clone(1)
+	_, err = c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: id, ServiceVersion: 4, Name: fastly.ToPointer("h")})
+	require.NoError(t, err)
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 4})
+	require.NoError(t, err)
+	clone(4)
+	_, err = c.ActivateVersion(ctx, &fastly.ActivateVersionInput{ServiceID: id, ServiceVersion: 5, Environment: "staging"})
+	require.NoError(t, err)
+	clone(4)
+	clone(4)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: 7, Name: fastly.ToPointer("new")})
+	require.NoError(t, err)
+
+	inv, err := c.GetVersionInventory(ctx, &fastly.GetVersionInventoryInput{ServiceID: id})
+	require.NoError(t, err)
+	require.Equal(t, `Service `+id+`: 7 version(s)
+  active: 1 (4)
+  staged: 1 (5)
+  draft: 2 (6, 7)
+  locked: 1 (1)
+  orphaned: 1 (3)
+  stale: 1 (2)
+`, inv.String())
+
+	// The unchanged draft is reused, and drafts differing from the base
+	// version are not.
+	v, err := c.ReuseOrCloneVersion(ctx, &fastly.ReuseOrCloneVersionInput{ServiceID: id})
+	require.NoError(t, err)
+	require.Equal(t, 6, *v.Number)
+	v, err = c.ReuseOrCloneVersion(ctx, &fastly.ReuseOrCloneVersionInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.Equal(t, 8, *v.Number)
+
+	// Only the newest drafts are compared.
+	v, err = c.ReuseOrCloneVersion(ctx, &fastly.ReuseOrCloneVersionInput{ServiceID: id, ServiceVersion: 4})
+	require.NoError(t, err)
+	require.Equal(t, 6, *v.Number)
+	v, err = c.ReuseOrCloneVersion(ctx, &fastly.ReuseOrCloneVersionInput{MaxDrafts: 1, ServiceID: id, ServiceVersion: 4})
+	require.NoError(t, err)
+	require.Equal(t, 9, *v.Number)
+
+	_, err = c.CleanupStaleDrafts(ctx, &fastly.CleanupStaleDraftsInput{ServiceID: id})
+	require.ErrorIs(t, err, fastly.ErrMissingAction)
+
+	// Tagged drafts are stale from then on.
+	setNow(time.Now())
+	updated, err := c.CleanupStaleDrafts(ctx, &fastly.CleanupStaleDraftsInput{ServiceID: id, Action: fastly.StaleDraftTag, IncludeOrphaned: true})
+	require.NoError(t, err)
+	require.Len(t, updated, 2)
+	require.Equal(t, fastly.DefaultStaleDraftTag, *updated[0].Comment)
+	inv, err = c.GetVersionInventory(ctx, &fastly.GetVersionInventoryInput{ServiceID: id})
+	require.NoError(t, err)
+	require.Len(t, inv.Versions(fastly.VersionStateStale), 2)
+
+	updated, err = c.CleanupStaleDrafts(ctx, &fastly.CleanupStaleDraftsInput{ServiceID: id, Action: fastly.StaleDraftTag})
+	require.NoError(t, err)
+	require.Empty(t, updated)
+
+	updated, err = c.CleanupStaleDrafts(ctx, &fastly.CleanupStaleDraftsInput{ServiceID: id, Action: fastly.StaleDraftLock})
+	require.NoError(t, err)
+	require.Len(t, updated, 2)
+	require.True(t, *updated[1].Locked)
+	inv, err = c.GetVersionInventory(ctx, &fastly.GetVersionInventoryInput{ServiceID: id})
+	require.NoError(t, err)
+	require.Empty(t, inv.Versions(fastly.VersionStateStale))
+	require.Len(t, inv.Versions(fastly.VersionStateLocked), 3)
+
+	// Without a base version, the newest draft is reused.
+	svc, err = c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("fresh")})
+	require.NoError(t, err)
+	v, err = c.ReuseOrCloneVersion(ctx, &fastly.ReuseOrCloneVersionInput{ServiceID: *svc.ServiceID})
+	require.NoError(t, err)
+	require.Equal(t, 1, *v.Number)
+}
@@ -0,0 +1,320 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ReferenceProblem is the kind of problem found by CheckVersionReferences.
+type ReferenceProblem string
+
+const (
+	// ReferenceMissing is a reference to an object which does not exist.
+	ReferenceMissing ReferenceProblem = "missing"
+	// ReferenceTypeMismatch is a reference to a condition of another type
+	// than the referring field expects.
+	ReferenceTypeMismatch ReferenceProblem = "type_mismatch"
+	// ReferenceInvalid is a field holding a value which is not allowed, such
+	// as an unknown logging placement.
+	ReferenceInvalid ReferenceProblem = "invalid"
+	// ReferenceUnused is an object which nothing refers to.
+	ReferenceUnused ReferenceProblem = "unused"
+)
+
+// ReferenceIssue is a broken reference, or an unused object, found by
+// CheckVersionReferences.
+type ReferenceIssue struct {
+	// ActualType is the type of the condition named by Target for
+	// ReferenceTypeMismatch, or of the unused condition for ReferenceUnused.
+	ActualType string
+	// ExpectedType is the type of condition expected by Field, i.e.
+	// "REQUEST", "RESPONSE" or "CACHE". It is empty if Field does not refer
+	// to a condition.
+	ExpectedType string
+	// Field is the referring field, as named by the API, e.g.
+	// "request_condition" or "backends". It is empty for unused objects.
+	Field string
+	// Kind is the kind of the object with the issue, as in ConfigChange,
+	// e.g. "header", "logging/s3" or "rate_limiter".
+	Kind string
+	// Name is the name of the object with the issue.
+	Name string
+	// Problem is the kind of problem.
+	Problem ReferenceProblem
+	// Target is the name of the referred object, or the invalid value for
+	// ReferenceInvalid.
+	Target string
+	// TargetKind is the kind of the referred object, e.g. "condition".
+	TargetKind string
+}
+
+// Broken reports whether the issue is a broken reference, as opposed to an
+// unused object.
+func (ri *ReferenceIssue) Broken() bool {
+	return ri.Problem != ReferenceUnused
+}
+
+// String returns a description of the issue, e.g. `header "h"
+// response_condition: condition "c" is REQUEST, expected RESPONSE`.
+func (ri *ReferenceIssue) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q", ri.Kind, ri.Name)
+	if ri.Field != "" {
+		fmt.Fprintf(&b, " %s", ri.Field)
+	}
+	b.WriteString(": ")
+	switch ri.Problem {
+	case ReferenceMissing:
+		fmt.Fprintf(&b, "%s %q does not exist", ri.TargetKind, ri.Target)
+		if ri.ExpectedType != "" {
+			fmt.Fprintf(&b, " (expected %s)", ri.ExpectedType)
+		}
+	case ReferenceTypeMismatch:
+		fmt.Fprintf(&b, "%s %q is %s, expected %s", ri.TargetKind, ri.Target, ri.ActualType, ri.ExpectedType)
+	case ReferenceInvalid:
+		fmt.Fprintf(&b, "invalid value %q", ri.Target)
+	case ReferenceUnused:
+		switch {
+		case ri.Field != "":
+			fmt.Fprintf(&b, "%q but not referenced by any VCL or snippet", ri.Target)
+		case ri.ActualType != "":
+			fmt.Fprintf(&b, "unused %s", ri.ActualType)
+		default:
+			b.WriteString("unused")
+		}
+	}
+	return b.String()
+}
+
+// ReferenceReport holds the issues found by CheckVersionReferences.
+type ReferenceReport struct {
+	// Issues are the issues found, broken references first.
+	Issues []*ReferenceIssue
+	// ServiceID is the ID of the service.
+	ServiceID string
+	// ServiceVersion is the checked version.
+	ServiceVersion int
+}
+
+// Broken returns the broken references.
+func (r *ReferenceReport) Broken() []*ReferenceIssue {
+	return slices.DeleteFunc(slices.Clone(r.Issues), func(ri *ReferenceIssue) bool { return !ri.Broken() })
+}
+
+// Unused returns the unused objects.
+func (r *ReferenceReport) Unused() []*ReferenceIssue {
+	return slices.DeleteFunc(slices.Clone(r.Issues), (*ReferenceIssue).Broken)
+}
+
+// Err returns an error wrapping ErrBrokenReferences and describing the
+// broken references, or nil if there are none. Unused objects are not
+// errors.
+func (r *ReferenceReport) Err() error {
+	broken := r.Broken()
+	if len(broken) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(broken))
+	for n, ri := range broken {
+		msgs[n] = ri.String()
+	}
+	return fmt.Errorf("%w: %s", ErrBrokenReferences, strings.Join(msgs, "; "))
+}
+
+// String returns a human-readable summary of the report, with one line per
+// issue.
+func (r *ReferenceReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Service %s version %d: %d broken reference(s), %d unused object(s)\n", r.ServiceID, r.ServiceVersion, len(r.Broken()), len(r.Unused()))
+	for _, ri := range r.Issues {
+		fmt.Fprintf(&b, "  %s: %s\n", ri.Problem, ri)
+	}
+	return b.String()
+}
+
+// CheckVersionReferencesInput is used as input to the CheckVersionReferences
+// function.
+type CheckVersionReferencesInput struct {
+	// ServiceID is the ID of the service (required).
+	ServiceID string
+	// ServiceVersion is the specific configuration version (required).
+	ServiceVersion int
+}
+
+// CheckVersionReferences loads the objects of a version and checks the
+// references between them, which are made by name, without waiting for
+// ValidateVersion or ActivateVersion to reject them. The returned report
+// lists:
+//
+//   - conditions referred to by the request_condition, response_condition
+//     and cache_condition fields of any object, which must exist and be of
+//     the REQUEST, RESPONSE and CACHE type respectively;
+//   - health checks referred to by backends, backends referred to by
+//     directors, and response objects and dictionaries referred to by rate
+//     limiters, which must exist;
+//   - the placement of logging endpoints, which must be empty, "none" or
+//     "waf_debug";
+//   - conditions and health checks which nothing refers to, and logging
+//     endpoints placed "none" whose name appears in no custom VCL or
+//     snippet.
+//
+// Use the report's Err to fail on broken references only.
+func (c *Client) CheckVersionReferences(ctx context.Context, i *CheckVersionReferencesInput) (*ReferenceReport, error) {
+	if i.ServiceID == "" {
+		return nil, ErrMissingServiceID
+	}
+	if i.ServiceVersion == 0 {
+		return nil, ErrMissingServiceVersion
+	}
+
+	s, err := c.exportServiceVersion(ctx, &ExportServiceVersionInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}, false)
+	if err != nil {
+		return nil, err
+	}
+	erls, err := c.ListERLs(ctx, &ListERLsInput{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion})
+	if err != nil {
+		return nil, fmt.Errorf("listing rate limiters: %w", err)
+	}
+
+	r := &ReferenceReport{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}
+	checkReferences(r, s, erls)
+	slices.SortStableFunc(r.Issues, func(a, b *ReferenceIssue) int {
+		switch {
+		case a.Broken() == b.Broken():
+			return 0
+		case a.Broken():
+			return -1
+		}
+		return 1
+	})
+	return r, nil
+}
+
+// conditionFields are the fields referring to conditions, with the type of
+// condition they expect.
+var conditionFields = []struct{ field, conditionType string }{
+	{"request_condition", "REQUEST"},
+	{"response_condition", "RESPONSE"},
+	{"cache_condition", "CACHE"},
+}
+
+// loggingPlacements are the valid placements of logging endpoints. The API
+// reports an unset placement as either null or "null".
+var loggingPlacements = []string{"", "null", "none", "waf_debug"}
+
+// checkReferences adds the issues found in the objects of s and erls to r.
+func checkReferences(r *ReferenceReport, s *ServiceVersionSnapshot, erls []*ERL) {
+	cfg := s.Config
+	var (
+		backends        = map[string]bool{}
+		conditions      = map[string]*Condition{}
+		dictionaries    = map[string]bool{}
+		healthChecks    = map[string]bool{}
+		responseObjects = map[string]bool{}
+		used            = map[string]bool{}
+	)
+	for _, b := range cfg.Backends {
+		backends[ToValue(b.Name)] = true
+	}
+	for _, cond := range cfg.Conditions {
+		conditions[ToValue(cond.Name)] = cond
+	}
+	for _, hc := range cfg.HealthChecks {
+		healthChecks[ToValue(hc.Name)] = true
+	}
+	for _, ro := range cfg.ResponseObjects {
+		responseObjects[ToValue(ro.Name)] = true
+	}
+	for _, d := range s.Dictionaries {
+		dictionaries[ToValue(d.Dictionary.Name)] = true
+	}
+	var code []string
+	for _, vcl := range s.VCLs {
+		code = append(code, ToValue(vcl.Content))
+	}
+	for _, snippet := range cfg.Snippets {
+		code = append(code, ToValue(snippet.Content))
+	}
+
+	add := func(ri *ReferenceIssue) { r.Issues = append(r.Issues, ri) }
+	for _, k := range configKinds {
+		objs, _ := k.objects(cfg)
+		for _, obj := range objs {
+			v, name := reflect.ValueOf(obj).Elem(), configObjectName(obj)
+			for _, cf := range conditionFields {
+				target := referenceField(v, cf.field)
+				if target == "" {
+					continue
+				}
+				used["condition/"+target] = true
+				ri := &ReferenceIssue{ExpectedType: cf.conditionType, Field: cf.field, Kind: k.name, Name: name, Target: target, TargetKind: "condition"}
+				cond, ok := conditions[target]
+				switch {
+				case !ok:
+					ri.Problem = ReferenceMissing
+					add(ri)
+				case !strings.EqualFold(ToValue(cond.Type), cf.conditionType):
+					ri.Problem, ri.ActualType = ReferenceTypeMismatch, ToValue(cond.Type)
+					add(ri)
+				}
+			}
+			if target := referenceField(v, "healthcheck"); target != "" {
+				used["healthcheck/"+target] = true
+				if !healthChecks[target] {
+					add(&ReferenceIssue{Field: "healthcheck", Kind: k.name, Name: name, Problem: ReferenceMissing, Target: target, TargetKind: "healthcheck"})
+				}
+			}
+			if !strings.HasPrefix(k.name, "logging/") {
+				continue
+			}
+			placement := referenceField(v, "placement")
+			switch {
+			case !slices.Contains(loggingPlacements, placement):
+				add(&ReferenceIssue{Field: "placement", Kind: k.name, Name: name, Problem: ReferenceInvalid, Target: placement})
+			case placement == "none" && !slices.ContainsFunc(code, func(c string) bool { return strings.Contains(c, name) }):
+				add(&ReferenceIssue{Field: "placement", Kind: k.name, Name: name, Problem: ReferenceUnused, Target: placement})
+			}
+		}
+	}
+
+	for _, d := range cfg.Directors {
+		for _, b := range d.Backends {
+			if !backends[b] {
+				add(&ReferenceIssue{Field: "backends", Kind: "director", Name: ToValue(d.Name), Problem: ReferenceMissing, Target: b, TargetKind: "backend"})
+			}
+		}
+	}
+	for _, erl := range erls {
+		if target := ToValue(erl.ResponseObjectName); target != "" && !responseObjects[target] {
+			add(&ReferenceIssue{Field: "response_object_name", Kind: "rate_limiter", Name: ToValue(erl.Name), Problem: ReferenceMissing, Target: target, TargetKind: "response_object"})
+		}
+		if target := ToValue(erl.URIDictionaryName); target != "" && !dictionaries[target] {
+			add(&ReferenceIssue{Field: "uri_dictionary_name", Kind: "rate_limiter", Name: ToValue(erl.Name), Problem: ReferenceMissing, Target: target, TargetKind: "dictionary"})
+		}
+	}
+
+	for _, cond := range cfg.Conditions {
+		if name := ToValue(cond.Name); !used["condition/"+name] {
+			add(&ReferenceIssue{ActualType: ToValue(cond.Type), Kind: "condition", Name: name, Problem: ReferenceUnused})
+		}
+	}
+	for _, hc := range cfg.HealthChecks {
+		if name := ToValue(hc.Name); !used["healthcheck/"+name] {
+			add(&ReferenceIssue{Kind: "healthcheck", Name: name, Problem: ReferenceUnused})
+		}
+	}
+}
+
+// referenceField returns the value of the string field of the object v
+// named tag by its mapstructure tag, or "" if it has no such field or the
+// field is unset.
+func referenceField(v reflect.Value, tag string) string {
+	f, ok := fieldByTag(v, "mapstructure", tag)
+	if !ok || f.Kind() != reflect.Pointer || f.IsNil() || f.Elem().Kind() != reflect.String {
+		return ""
+	}
+	return f.Elem().String()
+}
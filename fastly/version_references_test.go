@@ -0,0 +1,97 @@
+package fastly_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/fastly/go-fastly/v17/fastly"
+	"github.com/fastly/go-fastly/v17/fastly/fastlytest"
+)
+
+func TestClient_CheckVersionReferences(t *testing.T) {
+	t.Parallel()
+
+	srv := fastlytest.NewServer()
+	t.Cleanup(srv.Close)
+	c, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.TODO()
+
+	svc, err := c.CreateService(ctx, &fastly.CreateServiceInput{Name: fastly.ToPointer("refs")})
+	require.NoError(t, err)
+	id := *svc.ServiceID
+
+	_, err = c.CheckVersionReferences(ctx, &fastly.CheckVersionReferencesInput{ServiceID: id})
+	require.ErrorIs(t, err, fastly.ErrMissingServiceVersion)
+
+	for name, typ := range map[string]string{"is-get": "REQUEST", "is-error": "RESPONSE", "never": "CACHE"} {
+		_, err = c.CreateCondition(ctx, &fastly.CreateConditionInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer(name), Type: fastly.ToPointer(typ), Statement: fastly.ToPointer("true")})
+		require.NoError(t, err)
+	}
+	_, err = c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("h"), RequestCondition: fastly.ToPointer("is-get")})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("origin")})
+	require.NoError(t, err)
+	_, err = c.CreateSyslog(ctx, &fastly.CreateSyslogInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("logs"), ResponseCondition: fastly.ToPointer("is-error")})
+	require.NoError(t, err)
+
+	r, err := c.CheckVersionReferences(ctx, &fastly.CheckVersionReferencesInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.NoError(t, r.Err())
+	require.Equal(t, `Service `+id+` version 1: 0 broken reference(s), 1 unused object(s)
+  unused: condition "never": unused CACHE
+`, r.String())
+
+	// Break every kind of reference.
+	_, err = c.CreateHeader(ctx, &fastly.CreateHeaderInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("h2"), ResponseCondition: fastly.ToPointer("is-get"), CacheCondition: fastly.ToPointer("gone")})
+	require.NoError(t, err)
+	_, err = c.CreateHealthCheck(ctx, &fastly.CreateHealthCheckInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("hc")})
+	require.NoError(t, err)
+	_, err = c.UpdateBackend(ctx, &fastly.UpdateBackendInput{ServiceID: id, ServiceVersion: 1, Name: "origin", HealthCheck: fastly.ToPointer("hc-old")})
+	require.NoError(t, err)
+	_, err = c.CreateDirector(ctx, &fastly.CreateDirectorInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("pool")})
+	require.NoError(t, err)
+	_, err = c.CreateBackend(ctx, &fastly.CreateBackendInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("ghost")})
+	require.NoError(t, err)
+	for _, b := range []string{"origin", "ghost"} {
+		_, err = c.CreateDirectorBackend(ctx, &fastly.CreateDirectorBackendInput{ServiceID: id, ServiceVersion: 1, Director: "pool", Backend: b})
+		require.NoError(t, err)
+	}
+	err = c.DeleteBackend(ctx, &fastly.DeleteBackendInput{ServiceID: id, ServiceVersion: 1, Name: "ghost"})
+	require.NoError(t, err)
+	_, err = c.UpdateSyslog(ctx, &fastly.UpdateSyslogInput{ServiceID: id, ServiceVersion: 1, Name: "logs", Placement: fastly.NewNullable("none")})
+	require.NoError(t, err)
+	_, err = c.CreateSyslog(ctx, &fastly.CreateSyslogInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("audit"), Placement: fastly.ToPointer("somewhere")})
+	require.NoError(t, err)
+	_, err = c.CreateERL(ctx, &fastly.CreateERLInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("limit"), ResponseObjectName: fastly.ToPointer("slow-down"), URIDictionaryName: fastly.ToPointer("uris")})
+	require.NoError(t, err)
+
+	r, err = c.CheckVersionReferences(ctx, &fastly.CheckVersionReferencesInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.ErrorIs(t, r.Err(), fastly.ErrBrokenReferences)
+	require.Equal(t, `Service `+id+` version 1: 7 broken reference(s), 3 unused object(s)
+  missing: backend "origin" healthcheck: healthcheck "hc-old" does not exist
+  type_mismatch: header "h2" response_condition: condition "is-get" is REQUEST, expected RESPONSE
+  missing: header "h2" cache_condition: condition "gone" does not exist (expected CACHE)
+  invalid: logging/syslog "audit" placement: invalid value "somewhere"
+  missing: director "pool" backends: backend "ghost" does not exist
+  missing: rate_limiter "limit" response_object_name: response_object "slow-down" does not exist
+  missing: rate_limiter "limit" uri_dictionary_name: dictionary "uris" does not exist
+  unused: logging/syslog "logs" placement: "none" but not referenced by any VCL or snippet
+  unused: condition "never": unused CACHE
+  unused: healthcheck "hc": unused
+`, r.String())
+
+	broken := r.Broken()
+	require.Equal(t, "CACHE", broken[2].ExpectedType)
+	require.Equal(t, "condition", broken[2].TargetKind)
+
+	// Logging endpoints placed "none" are used by name in custom VCL.
+	_, err = c.CreateSnippet(ctx, &fastly.CreateSnippetInput{ServiceID: id, ServiceVersion: 1, Name: fastly.ToPointer("log"), Type: fastly.ToPointer(fastly.SnippetTypeLog), Content: fastly.ToPointer(`log "syslog " req.service_id " logs :: " req.url;`)})
+	require.NoError(t, err)
+	r, err = c.CheckVersionReferences(ctx, &fastly.CheckVersionReferencesInput{ServiceID: id, ServiceVersion: 1})
+	require.NoError(t, err)
+	require.Len(t, r.Unused(), 2)
+}
@@ -0,0 +1,354 @@
+// Command apigen generates the fastly.API interface, listing every exported
+// method of fastly.Client, and the fastlytest.MockAPI implementation of it.
+//
+// It parses the non-test Go files of the fastly package (excluding its
+// subpackages), collects the exported methods declared on *Client, and
+// writes them sorted by name. Types declared in the fastly package are
+// qualified in the mock, which lives in another package.
+//
+// Usage:
+//
+//	go run ./internal/cmd/apigen -dir ./fastly -out ./fastly/api.go -mock ./fastly/fastlytest/mock_api.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const fastlyImport = "github.com/fastly/go-fastly/v17/fastly"
+
+// method is an exported method of *Client.
+type method struct {
+	name     string
+	params   []param
+	results  []string // types of the results, rendered for the mock
+	variadic bool
+
+	// apiParams and apiResults are the signature rendered for the fastly
+	// package itself.
+	apiParams  string
+	apiResults string
+}
+
+type param struct {
+	name string
+	typ  string // rendered for the mock
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the fastly package")
+	out := flag.String("out", "api.go", "output file for the API interface")
+	mock := flag.String("mock", "fastlytest/mock_api.go", "output file for the mock")
+	flag.Parse()
+
+	paths, err := filepath.Glob(filepath.Join(*dir, "*.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []method
+	apiImports := map[string]bool{}
+	mockImports := map[string]bool{fastlyImport: true}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == filepath.Base(*out) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			log.Fatal(err)
+		}
+		imports := fileImports(f)
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() || !isClientReceiver(fn.Recv) {
+				continue
+			}
+			m, err := newMethod(fset, fn, imports, apiImports, mockImports)
+			if err != nil {
+				log.Fatalf("%s: %s: %v", fset.Position(fn.Pos()), fn.Name.Name, err)
+			}
+			methods = append(methods, m)
+		}
+	}
+	slices.SortFunc(methods, func(a, b method) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	write(*out, renderAPI(methods, apiImports))
+	write(*mock, renderMock(methods, mockImports))
+}
+
+func write(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting %s: %v\n%s", path, err, src)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil { // #nosec G306 -- generated source file
+		log.Fatal(err)
+	}
+}
+
+// fileImports maps the names by which packages are imported in f to their
+// paths.
+func fileImports(f *ast.File) map[string]string {
+	m := map[string]string{}
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		m[name] = path
+	}
+	return m
+}
+
+func isClientReceiver(recv *ast.FieldList) bool {
+	if len(recv.List) != 1 {
+		return false
+	}
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	id, ok := star.X.(*ast.Ident)
+	return ok && id.Name == "Client"
+}
+
+func newMethod(fset *token.FileSet, fn *ast.FuncDecl, imports map[string]string, apiImports, mockImports map[string]bool) (method, error) {
+	m := method{name: fn.Name.Name}
+
+	var apiParams []string
+	for i, field := range fn.Type.Params.List {
+		if _, ok := field.Type.(*ast.Ellipsis); ok {
+			m.variadic = true
+		}
+		api, err := render(fset, field.Type, imports, apiImports, "")
+		if err != nil {
+			return m, err
+		}
+		typ, err := render(fset, field.Type, imports, mockImports, "fastly.")
+		if err != nil {
+			return m, err
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent("_")}
+		}
+		for j, n := range names {
+			name := n.Name
+			if name == "_" || name == "mock" {
+				name = fmt.Sprintf("p%d_%d", i, j)
+			}
+			m.params = append(m.params, param{name: name, typ: typ})
+			apiParams = append(apiParams, name+" "+api)
+		}
+	}
+	m.apiParams = strings.Join(apiParams, ", ")
+
+	var apiResults []string
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			api, err := render(fset, field.Type, imports, apiImports, "")
+			if err != nil {
+				return m, err
+			}
+			typ, err := render(fset, field.Type, imports, mockImports, "fastly.")
+			if err != nil {
+				return m, err
+			}
+			for range max(len(field.Names), 1) {
+				apiResults = append(apiResults, api)
+				m.results = append(m.results, typ)
+			}
+		}
+	}
+	m.apiResults = tuple(apiResults)
+	return m, nil
+}
+
+// render prints the type expression e, prefixing the types declared in the
+// fastly package with qualifier and recording the imports it needs.
+func render(fset *token.FileSet, e ast.Expr, imports map[string]string, needed map[string]bool, qualifier string) (string, error) {
+	var err error
+	e = rewrite(e, func(n ast.Expr) ast.Expr {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			pkg, ok := n.X.(*ast.Ident)
+			if !ok {
+				return n
+			}
+			path, ok := imports[pkg.Name]
+			if !ok {
+				err = fmt.Errorf("unknown package %s", pkg.Name)
+				return n
+			}
+			needed[path] = true
+			return n
+		case *ast.Ident:
+			if types.Universe.Lookup(n.Name) != nil {
+				return n
+			}
+			if !n.IsExported() {
+				err = fmt.Errorf("unexported type %s in signature", n.Name)
+				return n
+			}
+			return ast.NewIdent(qualifier + n.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, e); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// rewrite returns a copy of the type expression e in which identifiers and
+// qualified identifiers are replaced by f. f returns nil for any other node.
+func rewrite(e ast.Expr, f func(ast.Expr) ast.Expr) ast.Expr {
+	if r := f(e); r != nil {
+		return r
+	}
+	switch e := e.(type) {
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: rewrite(e.X, f)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: rewrite(e.Elt, f)}
+	case *ast.MapType:
+		return &ast.MapType{Key: rewrite(e.Key, f), Value: rewrite(e.Value, f)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: rewrite(e.Elt, f)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: rewrite(e.Value, f)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: rewrite(e.X, f), Index: rewrite(e.Index, f)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, idx := range e.Indices {
+			indices[i] = rewrite(idx, f)
+		}
+		return &ast.IndexListExpr{X: rewrite(e.X, f), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: rewriteFields(e.Params, f), Results: rewriteFields(e.Results, f)}
+	case *ast.InterfaceType, *ast.StructType:
+		// Only the empty interface and struct are expected in signatures.
+		return e
+	}
+	return e
+}
+
+func rewriteFields(fl *ast.FieldList, f func(ast.Expr) ast.Expr) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, field := range fl.List {
+		out.List = append(out.List, &ast.Field{Names: field.Names, Type: rewrite(field.Type, f)})
+	}
+	return out
+}
+
+func tuple(types []string) string {
+	switch len(types) {
+	case 0:
+		return ""
+	case 1:
+		return types[0]
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+func importBlock(buf *bytes.Buffer, imports map[string]bool) {
+	paths := make([]string, 0, len(imports))
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	buf.WriteString("import (\n")
+	for _, p := range paths {
+		fmt.Fprintf(buf, "\t%q\n", p)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func renderAPI(methods []method, imports map[string]bool) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/cmd/apigen; DO NOT EDIT.\n\npackage fastly\n\n")
+	importBlock(&buf, imports)
+	buf.WriteString(`// API is the set of operations provided by Client. Code that accepts an API
+// rather than a *Client can be tested with a mock implementation, such as
+// fastlytest.MockAPI, instead of an HTTP server.
+//
+// Methods are added to API as they are added to Client, so implementations
+// outside this module should embed an API to remain source compatible.
+type API interface {
+`)
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t%s(%s) %s\n", m.name, m.apiParams, m.apiResults)
+	}
+	buf.WriteString("}\n\nvar _ API = (*Client)(nil)\n")
+	return buf.Bytes()
+}
+
+func renderMock(methods []method, imports map[string]bool) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/cmd/apigen; DO NOT EDIT.\n\npackage fastlytest\n\n")
+	importBlock(&buf, imports)
+	buf.WriteString(`// MockAPI is an implementation of fastly.API for unit tests. Each method
+// calls the function in the field of the same name with a Func suffix, which
+// the test sets as needed. Calling a method whose function is nil panics.
+type MockAPI struct {
+`)
+	for _, m := range methods {
+		fmt.Fprintf(&buf, "\t%sFunc func(%s) %s\n", m.name, mockParams(m), tuple(m.results))
+	}
+	buf.WriteString("}\n\nvar _ fastly.API = (*MockAPI)(nil)\n")
+
+	for _, m := range methods {
+		args := make([]string, len(m.params))
+		for i, p := range m.params {
+			args[i] = p.name
+		}
+		if m.variadic {
+			args[len(args)-1] += "..."
+		}
+		call := fmt.Sprintf("mock.%sFunc(%s)", m.name, strings.Join(args, ", "))
+		if len(m.results) > 0 {
+			call = "return " + call
+		}
+
+		fmt.Fprintf(&buf, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&buf, "func (mock *MockAPI) %s(%s) %s {\n", m.name, mockParams(m), tuple(m.results))
+		fmt.Fprintf(&buf, "\tif mock.%sFunc == nil {\n\t\tpanic(\"fastlytest: MockAPI.%s called but %sFunc is nil\")\n\t}\n", m.name, m.name, m.name)
+		fmt.Fprintf(&buf, "\t%s\n}\n", call)
+	}
+	return buf.Bytes()
+}
+
+func mockParams(m method) string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.name + " " + p.typ
+	}
+	return strings.Join(params, ", ")
+}
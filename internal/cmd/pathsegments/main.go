@@ -0,0 +1,160 @@
+// Command pathsegments generates the set of literal API path segments used by
+// the fastly package to derive path templates for middleware and
+// instrumentation.
+//
+// It scans the non-test Go files of the fastly package and its subpackages
+// for string literals passed to ToSafeURL (directly or through a
+// "components" slice) and for string literals that look like absolute API
+// paths, and writes the distinct segments to a Go source file.
+//
+// Usage:
+//
+//	go run ./internal/cmd/pathsegments -dir ./fastly -out ./fastly/path_segments.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var pathLiteral = regexp.MustCompile(`^/[A-Za-z0-9_.\-/]+$`)
+
+func main() {
+	dir := flag.String("dir", ".", "root directory of the fastly package")
+	out := flag.String("out", "path_segments.go", "output file")
+	flag.Parse()
+
+	segments := map[string]struct{}{}
+	add := func(s string) {
+		for seg := range strings.SplitSeq(s, "/") {
+			if seg != "" && seg != "." && seg != ".." {
+				segments[seg] = struct{}{}
+			}
+		}
+	}
+
+	err := filepath.WalkDir(*dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "fixtures" || d.Name() == "test_assets" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || path == *out {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				if len(n.Lhs) != 1 || len(n.Rhs) != 1 || !isComponents(n.Lhs[0]) {
+					return true
+				}
+				if cl, ok := n.Rhs[0].(*ast.CompositeLit); ok {
+					for _, elt := range cl.Elts {
+						if s, ok := stringLit(elt); ok {
+							add(s)
+						}
+					}
+				}
+			case *ast.CallExpr:
+				if !isToSafeURL(n.Fun) && !isAppendComponents(n) {
+					return true
+				}
+				for _, arg := range n.Args {
+					if s, ok := stringLit(arg); ok {
+						add(s)
+					}
+				}
+			case *ast.BasicLit:
+				if s, ok := stringLit(n); ok && pathLiteral.MatchString(s) {
+					add(s)
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sorted := make([]string, 0, len(segments))
+	for s := range segments {
+		sorted = append(sorted, s)
+	}
+	slices.Sort(sorted)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by internal/cmd/pathsegments; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package fastly")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// knownPathSegments is the set of literal path segments used by API")
+	fmt.Fprintln(&b, "// operations. Any other segment is treated as a path parameter.")
+	fmt.Fprintln(&b, "var knownPathSegments = map[string]struct{}{")
+	for _, s := range sorted {
+		fmt.Fprintf(&b, "\t%q: {},\n", s)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func isToSafeURL(fun ast.Expr) bool {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name == "ToSafeURL"
+	case *ast.SelectorExpr:
+		return f.Sel.Name == "ToSafeURL"
+	}
+	return false
+}
+
+func isComponents(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == "components"
+}
+
+func isAppendComponents(call *ast.CallExpr) bool {
+	id, ok := call.Fun.(*ast.Ident)
+	return ok && id.Name == "append" && len(call.Args) > 0 && isComponents(call.Args[0])
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
@@ -9,7 +9,7 @@ import (
 // DeleteInput specifies the information needed for the Delete
 // function to perform the operation.
 type DeleteInput struct {
-	Client        *fastly.Client
+	Client        fastly.Requester
 	ProductID     string
 	ServiceID     string
 	URLComponents []string
@@ -10,7 +10,7 @@ import (
 // GetInput specifies the information needed for the Get()
 // function to perform the operation.
 type GetInput struct {
-	Client        *fastly.Client
+	Client        fastly.Requester
 	ProductID     string
 	ServiceID     string
 	URLComponents []string
@@ -13,7 +13,7 @@ import (
 // Because Patch operations accept input, this struct has a type
 // parameter used to specify the type of the input structure.
 type PatchInput[I any] struct {
-	Client        *fastly.Client
+	Client        fastly.Requester
 	ProductID     string
 	ServiceID     string
 	URLComponents []string
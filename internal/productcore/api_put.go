@@ -13,7 +13,7 @@ import (
 // Because Put operations accept input, this struct has a type
 // parameter used to specify the type of the input structure.
 type PutInput[I any] struct {
-	Client        *fastly.Client
+	Client        fastly.Requester
 	ProductID     string
 	ServiceID     string
 	URLComponents []string
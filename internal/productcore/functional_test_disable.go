@@ -18,7 +18,7 @@ type DisableTestInput struct {
 	Phase string
 	// OpFn is the function to be invoked to perform the
 	// disablement
-	OpFn func(context.Context, *fastly.Client, string) error
+	OpFn func(context.Context, fastly.Requester, string) error
 	// ExpectFailure specifies whether this test case is expected
 	// to fail
 	ExpectFailure bool
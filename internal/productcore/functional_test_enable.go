@@ -25,10 +25,10 @@ type EnableTestInput[O products.ProductOutput, I any] struct {
 	Phase string
 	// OpNoInputFn is the function to be invoked to perform the
 	// enable operation, when no input is required (I == NulInput)
-	OpNoInputFn func(context.Context, *fastly.Client, string) (O, error)
+	OpNoInputFn func(context.Context, fastly.Requester, string) (O, error)
 	// OpWithInputFn is the function to be invoked to perform the
 	// enable operation, when input is required (I != NulInput)
-	OpWithInputFn func(context.Context, *fastly.Client, string, I) (O, error)
+	OpWithInputFn func(context.Context, fastly.Requester, string, I) (O, error)
 	// Input is the input to be provided to OpWithInputFn
 	Input I
 	// ProductID identifies the product for which information
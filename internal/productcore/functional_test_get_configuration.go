@@ -25,7 +25,7 @@ type GetConfigurationTestInput[O products.ProductOutput] struct {
 	Phase string
 	// OpFn is the function to be invoked to perform the
 	// get operation
-	OpFn func(context.Context, *fastly.Client, string) (O, error)
+	OpFn func(context.Context, fastly.Requester, string) (O, error)
 	// ProductID identifies the product for which information
 	// should be obtained on the service; note that it is only
 	// used to validate the ProductID in the output from OpFn (if
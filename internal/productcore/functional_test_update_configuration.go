@@ -26,7 +26,7 @@ type UpdateConfigurationTestInput[O products.ProductOutput, I any] struct {
 	Phase string
 	// OpFn is the function to be invoked to perform the
 	// operation
-	OpFn func(context.Context, *fastly.Client, string, I) (O, error)
+	OpFn func(context.Context, fastly.Requester, string, I) (O, error)
 	// Input is the input to be provided to OpFn
 	Input I
 	// ProductID identifies the product for which information